@@ -5,9 +5,10 @@ import (
 	"io"
 	"os"
 	"reflect"
-	"runtime/debug"
 	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"errors"
 
@@ -86,8 +87,41 @@ type BaseApp struct {
 	// height at which to halt the chain and gracefully shutdown
 	haltHeight uint64
 
+	// minimum block time (in Unix seconds) at which to halt the chain and
+	// gracefully shutdown
+	haltTime uint64
+
 	// application's version string
 	appVersion string
+
+	// activation schedules of message routes, keyed by route
+	routeSchedules map[string]sdk.ActivationSchedule
+
+	// flat gas charged for module queries, keyed by query route
+	moduleQueryGas map[string]sdk.Gas
+
+	// hooks run on the successfully delivered transactions once their block is
+	// committed, and the transactions of the current block
+	txHooks      []sdk.TxHook
+	txHookQueue  chan committedTxs
+	deliveredTxs []deliveredTx
+
+	// guards the tx hooks queue against being closed while a block is queued,
+	// the done channel is closed once the queued blocks have been processed
+	txHookMtx    sync.Mutex
+	txHookClosed bool
+	txHookDone   chan struct{}
+
+	// handlers of the panics recovered while running a transaction, tried
+	// before the default ones
+	recoveryHandlers []sdk.RecoveryHandler
+
+	// services streaming the blocks and their writes to the listened stores
+	streamingServices []StreamingService
+
+	// resources released when the application is closed, in reverse order of
+	// registration
+	closers []io.Closer
 }
 
 var _ abci.Application = (*BaseApp)(nil)
@@ -246,6 +280,10 @@ func (app *BaseApp) setHaltHeight(height uint64) {
 	app.haltHeight = height
 }
 
+func (app *BaseApp) setHaltTime(haltTime uint64) {
+	app.haltTime = haltTime
+}
+
 // Router returns the router of the BaseApp.
 func (app *BaseApp) Router() sdk.Router {
 	if app.sealed {
@@ -466,6 +504,9 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) (res abc
 				Value:     []byte(app.appVersion),
 			}
 
+		case "store_usage":
+			return app.queryStoreUsage()
+
 		default:
 			result = sdk.ErrUnknownRequest(fmt.Sprintf("Unknown query: %s", path)).Result()
 		}
@@ -478,7 +519,7 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) (res abc
 		}
 	}
 
-	msg := "Expected second parameter to be either simulate or version, neither was present"
+	msg := "Expected second parameter to be either simulate, version or store_usage, none was present"
 	return sdk.ErrUnknownRequest(msg).QueryResult()
 }
 
@@ -531,9 +572,28 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) (res
 		return sdk.ErrUnknownRequest(fmt.Sprintf("no custom querier found for route %s", path[1])).QueryResult()
 	}
 
-	// cache wrap the commit-multistore for safety
+	// cache wrap the commit-multistore for safety, at the requested height if
+	// it is not the latest one
+	header := app.checkState.ctx.BlockHeader()
+	height := app.LastBlockHeight()
+
+	var cacheMS sdk.CacheMultiStore
+	if req.Height > 0 && req.Height != height {
+		var err error
+		cacheMS, err = app.cms.CacheMultiStoreWithVersion(req.Height)
+		if err != nil {
+			return sdk.ErrUnknownRequest(
+				fmt.Sprintf("failed to load state at height %d; %s (latest height: %d)", req.Height, err, height),
+			).QueryResult()
+		}
+		height = req.Height
+		header.Height = height
+	} else {
+		cacheMS = app.cms.CacheMultiStore()
+	}
+
 	ctx := sdk.NewContext(
-		app.cms.CacheMultiStore(), app.checkState.ctx.BlockHeader(), true, app.logger,
+		cacheMS, header, true, app.logger,
 	).WithMinGasPrices(app.minGasPrices)
 
 	// Passes the rest of the path as an argument to the querier.
@@ -550,8 +610,9 @@ func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) (res
 	}
 
 	return abci.ResponseQuery{
-		Code:  uint32(sdk.CodeOK),
-		Value: resBytes,
+		Code:   uint32(sdk.CodeOK),
+		Value:  resBytes,
+		Height: height,
 	}
 }
 
@@ -609,6 +670,8 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 
 	// set the signed validators for addition to context in deliverTx
 	app.voteInfos = req.LastCommitInfo.GetVotes()
+
+	app.streamBeginBlock(req, res)
 	return
 }
 
@@ -646,10 +709,13 @@ func (app *BaseApp) DeliverTx(txBytes []byte) (res abci.ResponseDeliverTx) {
 	if err != nil {
 		result = err.Result()
 	} else {
+		start := time.Now()
 		result = app.runTx(runTxModeDeliver, txBytes, tx)
+		app.logDeliveredTx(tx, result, time.Since(start))
+		app.recordDeliveredTx(tx, result)
 	}
 
-	return abci.ResponseDeliverTx{
+	res = abci.ResponseDeliverTx{
 		Code:      uint32(result.Code),
 		Codespace: string(result.Codespace),
 		Data:      result.Data,
@@ -658,6 +724,24 @@ func (app *BaseApp) DeliverTx(txBytes []byte) (res abci.ResponseDeliverTx) {
 		GasUsed:   int64(result.GasUsed),   // TODO: Should type accept unsigned ints?
 		Tags:      result.Tags,
 	}
+
+	app.streamDeliverTx(txBytes, res)
+	return res
+}
+
+// logDeliveredTx logs the messages, result code, gas and execution time of a
+// delivered transaction so that operators can profile block processing.
+func (app *BaseApp) logDeliveredTx(tx sdk.Tx, result sdk.Result, elapsed time.Duration) {
+	msgs := tx.GetMsgs()
+	msgTypes := make([]string, len(msgs))
+	for i, msg := range msgs {
+		msgTypes[i] = fmt.Sprintf("%s/%s", msg.Route(), msg.Type())
+	}
+
+	app.logger.Debug(
+		"delivered tx", "msgs", strings.Join(msgTypes, ","), "code", result.Code,
+		"gas_wanted", result.GasWanted, "gas_used", result.GasUsed, "elapsed", elapsed,
+	)
 }
 
 // validateBasicTxMsgs executes basic validator calls for messages.
@@ -704,6 +788,14 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (re
 	for msgIdx, msg := range msgs {
 		// match message route
 		msgRoute := msg.Route()
+		if schedule, ok := app.routeSchedules[msgRoute]; ok && !schedule.IsActive(ctx) {
+			if schedule.Fallback == "" {
+				return sdk.ErrUnknownRequest(fmt.Sprintf(
+					"route %s is not active at height %d", msgRoute, ctx.BlockHeight())).Result()
+			}
+			msgRoute = schedule.Fallback
+		}
+
 		handler := app.router.Route(msgRoute)
 		if handler == nil {
 			return sdk.ErrUnknownRequest("Unrecognized Msg type: " + msgRoute).Result()
@@ -711,21 +803,33 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (re
 
 		var msgResult sdk.Result
 
+		// run each message against its own branch of the tx store, so that the
+		// writes of a failed message are never seen by the following ones
+		msgCache := ctx.MultiStore().CacheMultiStore()
+		gasBefore := ctx.GasMeter().GasConsumed()
+
 		// skip actual execution for CheckTx mode
 		if mode != runTxModeCheck {
-			msgResult = handler(ctx, msg)
+			msgResult = handler(ctx.WithMultiStore(msgCache), msg)
 		}
 
 		// NOTE: GasWanted is determined by ante handler and GasUsed by the GasMeter.
 
+		msgTags := append(sdk.Tags{sdk.MakeTag(sdk.TagAction, msg.Type())}, msgResult.Tags...)
+
 		// Result.Data must be length prefixed in order to separate each result
 		data = append(data, msgResult.Data...)
-		tags = append(tags, sdk.MakeTag(sdk.TagAction, msg.Type()))
-		tags = append(tags, msgResult.Tags...)
+		tags = append(tags, msgTags...)
 
-		idxLog := sdk.ABCIMessageLog{MsgIndex: uint16(msgIdx), Log: msgResult.Log}
+		idxLog := sdk.ABCIMessageLog{
+			MsgIndex: uint16(msgIdx),
+			Log:      msgResult.Log,
+			GasUsed:  ctx.GasMeter().GasConsumed() - gasBefore,
+			Tags:     sdk.TagsToStringTags(msgTags),
+		}
 
-		// stop execution and return on first failed message
+		// stop execution and return on first failed message, the tx store is
+		// then discarded by runTx, rolling back the previous messages
 		if !msgResult.IsOK() {
 			idxLog.Success = false
 			idxLogs = append(idxLogs, idxLog)
@@ -735,6 +839,8 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (re
 			break
 		}
 
+		msgCache.Write()
+
 		idxLog.Success = true
 		idxLogs = append(idxLogs, idxLog)
 	}
@@ -808,17 +914,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 
 	defer func() {
 		if r := recover(); r != nil {
-			switch rType := r.(type) {
-			case sdk.ErrorOutOfGas:
-				log := fmt.Sprintf(
-					"out of gas in location: %v; gasWanted: %d, gasUsed: %d",
-					rType.Descriptor, gasWanted, ctx.GasMeter().GasConsumed(),
-				)
-				result = sdk.ErrOutOfGas(log).Result()
-			default:
-				log := fmt.Sprintf("recovered: %v\nstack:\n%v", r, string(debug.Stack()))
-				result = sdk.ErrInternal(log).Result()
-			}
+			result = app.processRecovery(r, gasWanted, ctx.GasMeter().GasConsumed())
 		}
 
 		result.GasWanted = gasWanted
@@ -914,16 +1010,17 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 		res = app.endBlocker(app.deliverState.ctx, req)
 	}
 
+	app.streamEndBlock(req, res)
 	return
 }
 
 // Commit implements the ABCI interface. It will commit all state that exists in
 // the deliver state's multi-store and includes the resulting commit ID in the
 // returned abci.ResponseCommit. Commit will set the check state based on the
-// latest header and reset the deliver state. Also, if a non-zero halt height is
-// defined in config, Commit will execute a deferred function call to check
-// against that height and gracefully halt if it matches the latest committed
-// height.
+// latest header and reset the deliver state. Also, if a non-zero halt height or
+// halt time is defined in config, Commit will execute a deferred function call
+// to check against them and gracefully halt if the latest committed block
+// reached the halt height or time.
 func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	header := app.deliverState.ctx.BlockHeader()
 
@@ -941,18 +1038,70 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	// empty/reset the deliver state
 	app.deliverState = nil
 
+	res = abci.ResponseCommit{
+		Data: commitID.Hash,
+	}
+	app.streamCommit(res)
+
+	// hand the transactions of the committed block over to the tx hooks
+	app.dispatchTxHooks(header.Height)
+
 	defer func() {
-		if app.haltHeight > 0 && uint64(header.Height) == app.haltHeight {
-			app.logger.Info("halting node per configuration", "height", app.haltHeight)
+		if app.shouldHalt(header) {
+			app.logger.Info("halting node per configuration",
+				"height", header.Height, "halt-height", app.haltHeight, "halt-time", app.haltTime)
+			if err := app.Close(); err != nil {
+				app.logger.Error("failed to close application", "err", err)
+			}
 			os.Exit(0)
 		}
 	}()
 
-	return abci.ResponseCommit{
-		Data: commitID.Hash,
+	return res
+}
+
+// shouldHalt returns true if the node must halt after committing the block
+// with the given header: the block is at the halt height or its time reached
+// the halt time.
+func (app *BaseApp) shouldHalt(header abci.Header) bool {
+	switch {
+	case app.haltHeight > 0 && uint64(header.Height) == app.haltHeight:
+		return true
+
+	case app.haltTime > 0 && header.Time.Unix() >= int64(app.haltTime):
+		return true
+
+	default:
+		return false
 	}
 }
 
+// Close releases all the resources registered through AddCloser in reverse
+// order of registration and finally closes the application database. All the
+// closers are called even if one of them fails, the first error is returned.
+// The tx hooks are stopped once the queued blocks have been processed.
+func (app *BaseApp) Close() error {
+	var firstErr error
+	for i := len(app.closers) - 1; i >= 0; i-- {
+		if err := app.closers[i].Close(); err != nil {
+			app.logger.Error("failed to close application resource", "err", err)
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	app.closers = nil
+
+	app.closeTxHooks()
+
+	if app.db != nil {
+		app.db.Close()
+		app.db = nil
+	}
+
+	return firstErr
+}
+
 // ----------------------------------------------------------------------------
 // State
 
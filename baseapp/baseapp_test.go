@@ -3,10 +3,17 @@ package baseapp
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/store/streaming/file"
 	store "github.com/cosmos/cosmos-sdk/store/types"
 
 	"github.com/stretchr/testify/assert"
@@ -276,6 +283,9 @@ func TestBaseAppOptionSeal(t *testing.T) {
 	require.Panics(t, func() {
 		app.SetFauxMerkleMode()
 	})
+	require.Panics(t, func() {
+		app.AddCloser(nil)
+	})
 }
 
 func TestSetMinGasPrices(t *testing.T) {
@@ -284,6 +294,56 @@ func TestSetMinGasPrices(t *testing.T) {
 	require.Equal(t, minGasPrices, app.minGasPrices)
 }
 
+func TestShouldHalt(t *testing.T) {
+	haltTime := time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	app := newBaseApp(t.Name())
+	require.False(t, app.shouldHalt(abci.Header{Height: 10, Time: haltTime}))
+
+	// the halt height only matches the exact block height
+	app = newBaseApp(t.Name(), SetHaltHeight(10))
+	require.False(t, app.shouldHalt(abci.Header{Height: 9}))
+	require.True(t, app.shouldHalt(abci.Header{Height: 10}))
+	require.False(t, app.shouldHalt(abci.Header{Height: 11}))
+
+	// the halt time matches any block at or after it
+	app = newBaseApp(t.Name(), SetHaltTime(uint64(haltTime.Unix())))
+	require.False(t, app.shouldHalt(abci.Header{Height: 1, Time: haltTime.Add(-time.Second)}))
+	require.True(t, app.shouldHalt(abci.Header{Height: 1, Time: haltTime}))
+	require.True(t, app.shouldHalt(abci.Header{Height: 1, Time: haltTime.Add(time.Hour)}))
+}
+
+type testCloser struct {
+	name   string
+	closed *[]string
+	err    error
+}
+
+func (c testCloser) Close() error {
+	*c.closed = append(*c.closed, c.name)
+	return c.err
+}
+
+func TestClose(t *testing.T) {
+	var closed []string
+	closeErr := errors.New("failed to close")
+
+	app := newBaseApp(t.Name())
+	require.Panics(t, func() { app.AddCloser(nil) })
+	app.AddCloser(testCloser{"first", &closed, nil})
+	app.AddCloser(testCloser{"second", &closed, closeErr})
+	app.AddCloser(testCloser{"third", &closed, nil})
+
+	// all closers are called in reverse order even if one of them fails
+	require.Equal(t, closeErr, app.Close())
+	require.Equal(t, []string{"third", "second", "first"}, closed)
+	require.Nil(t, app.db)
+
+	// closing twice is a no-op
+	require.NoError(t, app.Close())
+	require.Len(t, closed, 3)
+}
+
 func TestInitChainer(t *testing.T) {
 	name := t.Name()
 	// keep the db and logger ourselves so
@@ -685,6 +745,37 @@ func TestMultiMsgDeliverTx(t *testing.T) {
 	require.Equal(t, int64(4), msgCounter)
 	msgCounter2 := getIntFromStore(store, deliverKey2)
 	require.Equal(t, int64(2), msgCounter2)
+
+	// each message reports its own gas and tags
+	logs, err := sdk.ParseABCILogs(res.Log)
+	require.NoError(t, err)
+	require.Len(t, logs, 3)
+	for i, log := range logs {
+		require.Equal(t, uint16(i), log.MsgIndex)
+		require.True(t, log.Success)
+		require.True(t, log.GasUsed > 0)
+		require.Equal(t, sdk.TagAction, log.Tags[0].Key)
+	}
+	require.Equal(t, "counter1", logs[0].Tags[0].Value)
+	require.Equal(t, "counter2", logs[1].Tags[0].Value)
+
+	// a failed message rolls back the previous ones
+	tx = newTxCounter(2, 4)
+	tx.Msgs = append(tx.Msgs, msgCounter{5, true})
+	txBytes, err = codec.MarshalBinaryLengthPrefixed(tx)
+	require.NoError(t, err)
+	res = app.DeliverTx(txBytes)
+	require.False(t, res.IsOK(), fmt.Sprintf("%v", res))
+
+	store = app.deliverState.ctx.KVStore(capKey1)
+	msgCounter = getIntFromStore(store, deliverKey)
+	require.Equal(t, int64(4), msgCounter)
+
+	logs, err = sdk.ParseABCILogs(res.Log)
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+	require.True(t, logs[0].Success)
+	require.False(t, logs[1].Success)
 }
 
 // Interleave calls to Check and Deliver and ensure
@@ -842,6 +933,226 @@ func TestRunInvalidTransaction(t *testing.T) {
 	}
 }
 
+// Test that panics in handlers are recovered into deterministic internal errors
+func TestRecoverHandlerPanic(t *testing.T) {
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			ctx.GasMeter().ConsumeGas(10, "counter-handler")
+			panic("handler panic")
+		})
+	}
+
+	app := setupBaseApp(t, routerOpt)
+
+	header := abci.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	res := app.Deliver(newTxCounter(0, 0))
+	require.Equal(t, sdk.CodeInternal, res.Code, fmt.Sprintf("%v", res))
+	require.Equal(t, sdk.CodespaceRoot, res.Codespace)
+	require.Contains(t, res.Log, "recovered: handler panic")
+	require.NotContains(t, res.Log, "stack")
+	require.Equal(t, uint64(10), res.GasUsed)
+}
+
+type customPanic struct{}
+
+func TestRecoveryHandlers(t *testing.T) {
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			if msg.(msgCounter).FailOnHandler {
+				panic(customPanic{})
+			}
+			panic("handler panic")
+		})
+	}
+	recoveryOpt := func(bapp *BaseApp) {
+		bapp.AddRecoveryHandler(func(r interface{}) (sdk.Result, bool) {
+			if _, ok := r.(customPanic); !ok {
+				return sdk.Result{}, false
+			}
+			return sdk.ErrUnauthorized("custom panic").Result(), true
+		})
+	}
+
+	app := setupBaseApp(t, routerOpt, recoveryOpt)
+	require.Panics(t, func() {
+		app.AddRecoveryHandler(func(interface{}) (sdk.Result, bool) { return sdk.Result{}, false })
+	})
+
+	header := abci.Header{Height: 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	// panics handled by the registered handler
+	tx := newTxCounter(0, 0)
+	tx.setFailOnHandler(true)
+	res := app.Deliver(tx)
+	require.Equal(t, sdk.CodeUnauthorized, res.Code, fmt.Sprintf("%v", res))
+	require.Contains(t, res.Log, "custom panic")
+
+	// other panics fall back to the default handling
+	res = app.Deliver(newTxCounter(1, 0))
+	require.Equal(t, sdk.CodeInternal, res.Code, fmt.Sprintf("%v", res))
+	require.Contains(t, res.Log, "recovered: handler panic")
+}
+
+func TestStreamingService(t *testing.T) {
+	key, value := []byte("hello"), []byte("goodbye")
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			ctx.KVStore(capKey1).Set(key, value)
+			ctx.KVStore(capKey2).Set(key, value)
+			return sdk.Result{}
+		})
+	}
+
+	dir, err := ioutil.TempDir("", "streaming")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	service, err := file.NewStreamingService(dir, capKey1)
+	require.NoError(t, err)
+
+	app := setupBaseApp(t, routerOpt, SetStreamingService(service))
+	app.InitChain(abci.RequestInitChain{})
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	cdc := codec.New()
+	registerTestCodec(cdc)
+	txBytes, err := cdc.MarshalBinaryLengthPrefixed(newTxCounter(0, 0))
+	require.NoError(t, err)
+	res := app.DeliverTx(txBytes)
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	app.Commit()
+
+	bz, err := ioutil.ReadFile(filepath.Join(dir, "block-1"))
+	require.NoError(t, err)
+
+	var types []string
+	var writes []file.Entry
+	for _, line := range strings.Split(strings.TrimSpace(string(bz)), "\n") {
+		var entry file.Entry
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		types = append(types, entry.Type)
+		if entry.Type == file.EntryWrite {
+			writes = append(writes, entry)
+		}
+	}
+
+	// only the writes to the listened store are streamed, once committed
+	require.Equal(t, []string{
+		file.EntryBeginBlock, file.EntryDeliverTx, file.EntryEndBlock, file.EntryWrite, file.EntryCommit,
+	}, types)
+	require.Equal(t, capKey1.Name(), writes[0].StoreKey)
+	require.Equal(t, key, writes[0].Key)
+	require.Equal(t, value, writes[0].Value)
+}
+
+// Test that messages for a route are only handled while the route is active
+func TestRouteActivation(t *testing.T) {
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			return sdk.Result{Log: "active"}
+		})
+		bapp.Router().AddRoute(routeMsgCounter2, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			return sdk.Result{Log: "fallback"}
+		})
+	}
+
+	// without a fallback route the messages are rejected, also in CheckTx
+	activationOpt := func(bapp *BaseApp) {
+		bapp.SetRouteActivation(routeMsgCounter, sdk.ActivationSchedule{StartHeight: 2})
+	}
+	app := setupBaseApp(t, routerOpt, activationOpt)
+
+	res := app.Check(newTxCounter(0, 0))
+	require.Equal(t, sdk.CodeUnknownRequest, res.Code, fmt.Sprintf("%v", res))
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	res = app.Deliver(newTxCounter(0, 0))
+	require.Equal(t, sdk.CodeUnknownRequest, res.Code, fmt.Sprintf("%v", res))
+
+	// with a fallback route the messages are rerouted
+	activationOpt = func(bapp *BaseApp) {
+		bapp.SetRouteActivation(routeMsgCounter, sdk.ActivationSchedule{
+			StartHeight: 2,
+			EndHeight:   4,
+			Fallback:    routeMsgCounter2,
+		})
+	}
+	app = setupBaseApp(t, routerOpt, activationOpt)
+
+	expected := []string{"fallback", "active", "active", "fallback"}
+	for i, log := range expected {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: int64(i) + 1}})
+		res = app.Deliver(newTxCounter(int64(i), 0))
+		require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+		require.Contains(t, res.Log, log)
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+	}
+}
+
+func TestTxHooks(t *testing.T) {
+	type hookCall struct {
+		height  int64
+		counter int64
+		log     string
+	}
+	calls := make(chan hookCall, 10)
+
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			if msg.(msgCounter).FailOnHandler {
+				return sdk.ErrInternal("message handler failure").Result()
+			}
+			return sdk.Result{Log: "ok"}
+		})
+	}
+	hookOpt := func(bapp *BaseApp) {
+		bapp.AddTxHook(func(height int64, tx sdk.Tx, result sdk.Result) {
+			calls <- hookCall{height, tx.(txTest).Counter, result.Log}
+		})
+		bapp.AddTxHook(func(height int64, tx sdk.Tx, result sdk.Result) {
+			panic("faulty hook")
+		})
+	}
+	app := setupBaseApp(t, routerOpt, hookOpt)
+
+	codec := codec.New()
+	registerTestCodec(codec)
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	for i := int64(0); i < 3; i++ {
+		tx := newTxCounter(i, 0)
+		tx.setFailOnHandler(i == 1)
+		res := app.DeliverTx(codec.MustMarshalBinaryLengthPrefixed(tx))
+		require.Equal(t, i != 1, res.IsOK(), fmt.Sprintf("%v", res))
+	}
+	app.EndBlock(abci.RequestEndBlock{})
+
+	// hooks are only run after commit
+	require.Empty(t, calls)
+	app.Commit()
+
+	// only the successful transactions are passed to the hooks, in order
+	for _, counter := range []int64{0, 2} {
+		call := <-calls
+		require.Equal(t, int64(1), call.height)
+		require.Equal(t, counter, call.counter)
+		require.Contains(t, call.log, "ok")
+	}
+
+	require.NoError(t, app.Close())
+
+	// blocks committed after the hooks are closed are dropped
+	app.deliveredTxs = []deliveredTx{{newTxCounter(3, 0), sdk.Result{Log: "ok"}}}
+	require.NotPanics(t, func() { app.dispatchTxHooks(2) })
+	require.Empty(t, calls)
+	require.NoError(t, app.Close())
+}
+
 // Test that transactions exceeding gas limits fail
 func TestTxGasLimits(t *testing.T) {
 	gasGranted := uint64(10)
@@ -1230,6 +1541,123 @@ func TestQuery(t *testing.T) {
 	require.Equal(t, value, res.Value)
 }
 
+func TestCustomQueryHeight(t *testing.T) {
+	key := []byte("hello")
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			ctx.KVStore(capKey1).Set(key, []byte(fmt.Sprintf("%d", ctx.BlockHeight())))
+			return sdk.Result{}
+		})
+	}
+	queryOpt := func(bapp *BaseApp) {
+		bapp.QueryRouter().AddRoute("height", func(ctx sdk.Context, _ []string, _ abci.RequestQuery) ([]byte, sdk.Error) {
+			return ctx.KVStore(capKey1).Get(key), nil
+		})
+	}
+
+	app := setupBaseApp(t, routerOpt, queryOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	for height := int64(1); height <= 3; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		resTx := app.Deliver(newTxCounter(height, 0))
+		require.True(t, resTx.IsOK(), fmt.Sprintf("%v", resTx))
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+	}
+
+	query := abci.RequestQuery{Path: "/custom/height"}
+	res := app.Query(query)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, []byte("3"), res.Value)
+	require.Equal(t, int64(3), res.Height)
+
+	query.Height = 2
+	res = app.Query(query)
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, []byte("2"), res.Value)
+	require.Equal(t, int64(2), res.Height)
+
+	query.Height = 4
+	res = app.Query(query)
+	require.False(t, res.IsOK())
+}
+
+func TestModuleQuerier(t *testing.T) {
+	key, value := []byte("hello"), []byte("goodbye")
+	queryOpt := func(bapp *BaseApp) {
+		bapp.QueryRouter().AddRoute("cheap", func(ctx sdk.Context, _ []string, _ abci.RequestQuery) ([]byte, sdk.Error) {
+			return ctx.KVStore(capKey1).Get(key), nil
+		})
+		bapp.QueryRouter().AddRoute("write", func(ctx sdk.Context, _ []string, _ abci.RequestQuery) ([]byte, sdk.Error) {
+			ctx.KVStore(capKey1).Set(key, []byte("overwritten"))
+			return nil, nil
+		})
+		bapp.SetModuleQueryGas("cheap", 10)
+	}
+
+	app := setupBaseApp(t, queryOpt)
+	querier := app.ModuleQuerier()
+
+	ctx := app.NewContext(true, abci.Header{})
+	ctx.KVStore(capKey1).Set(key, value)
+
+	gasConfig := store.KVGasConfig()
+
+	// the flat route gas and the store read are charged
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(10000))
+	res, err := querier(ctx, []string{"cheap"}, abci.RequestQuery{})
+	require.Nil(t, err)
+	require.Equal(t, value, res)
+	readGas := gasConfig.ReadCostFlat + gasConfig.ReadCostPerByte*sdk.Gas(len(value))
+	require.Equal(t, 10+readGas, ctx.GasMeter().GasConsumed())
+
+	// routes without a flat gas are charged the default, and the writes of
+	// queriers are metered but discarded
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(10000))
+	_, err = querier(ctx, []string{"write"}, abci.RequestQuery{})
+	require.Nil(t, err)
+	writeGas := gasConfig.WriteCostFlat + gasConfig.WriteCostPerByte*sdk.Gas(len("overwritten"))
+	require.Equal(t, DefaultModuleQueryGas+writeGas, ctx.GasMeter().GasConsumed())
+	require.Equal(t, value, ctx.KVStore(capKey1).Get(key))
+
+	// queries run out of gas like any other operation
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(5))
+	require.Panics(t, func() {
+		querier(ctx, []string{"cheap"}, abci.RequestQuery{})
+	})
+
+	_, err = querier(ctx, []string{"unknown"}, abci.RequestQuery{})
+	require.NotNil(t, err)
+}
+
+func TestQueryStoreUsage(t *testing.T) {
+	key, value := []byte("hello"), []byte("goodbye")
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			ctx.KVStore(capKey2).Set(key, value)
+			return sdk.Result{}
+		})
+	}
+
+	app := setupBaseApp(t, SetStoreUsageTracking(), routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	resTx := app.Deliver(newTxCounter(0, 0))
+	require.True(t, resTx.IsOK(), fmt.Sprintf("%v", resTx))
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	res := app.Query(abci.RequestQuery{Path: "/app/store_usage"})
+	require.True(t, res.IsOK(), res.Log)
+
+	var usage map[string]int64
+	require.NoError(t, json.Unmarshal(res.Value, &usage))
+	require.Equal(t, int64(len(key)+len(value)), usage[capKey2.Name()])
+}
+
 // Test p2p filter queries
 func TestP2PQuery(t *testing.T) {
 	addrPeerFilterOpt := func(bapp *BaseApp) {
@@ -0,0 +1,43 @@
+package baseapp
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultModuleQueryGas is the flat gas charged for a module query on a route
+// without a gas set with SetModuleQueryGas.
+const DefaultModuleQueryGas sdk.Gas = 1000
+
+// ModuleQuerier returns a Querier letting modules query each other through the
+// query router while processing transactions and blocks. The first element of
+// the path is the query route, eg. []string{"gov", "proposal"}.
+//
+// Module queries are charged against the gas meter of the context: the flat
+// gas of the route is consumed before the query, and the store reads of the
+// querier are metered as usual. Queriers run on a cache wrapped context whose
+// writes are discarded.
+func (app *BaseApp) ModuleQuerier() sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		if len(path) < 1 || path[0] == "" {
+			return nil, sdk.ErrUnknownRequest("No route for module query specified")
+		}
+
+		querier := app.queryRouter.Route(path[0])
+		if querier == nil {
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("no custom querier found for route %s", path[0]))
+		}
+
+		gas, ok := app.moduleQueryGas[path[0]]
+		if !ok {
+			gas = DefaultModuleQueryGas
+		}
+		ctx.GasMeter().ConsumeGas(gas, "module query")
+
+		cacheCtx, _ := ctx.CacheContext()
+		return querier(cacheCtx, path[1:], req)
+	}
+}
@@ -3,6 +3,7 @@ package baseapp
 
 import (
 	"fmt"
+	"io"
 
 	dbm "github.com/tendermint/tendermint/libs/db"
 
@@ -33,6 +34,18 @@ func SetHaltHeight(height uint64) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.setHaltHeight(height) }
 }
 
+// SetHaltTime returns a BaseApp option function that sets the halt block time.
+func SetHaltTime(haltTime uint64) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setHaltTime(haltTime) }
+}
+
+// SetInterBlockCache returns a BaseApp option function that sets the
+// inter-block cache of the IAVL stores, eg. a
+// cache.NewCommitKVStoreCacheManager(size).
+func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.cms.SetInterBlockCache(cache) }
+}
+
 func (app *BaseApp) SetName(name string) {
 	if app.sealed {
 		panic("SetName() on sealed BaseApp")
@@ -104,6 +117,46 @@ func (app *BaseApp) SetIDPeerFilter(pf sdk.PeerFilter) {
 	app.idPeerFilter = pf
 }
 
+// AddCloser registers a resource to be released when the application is
+// closed.
+func (app *BaseApp) AddCloser(closer io.Closer) {
+	if app.sealed {
+		panic("AddCloser() on sealed BaseApp")
+	}
+	if closer == nil {
+		panic("AddCloser() with a nil closer")
+	}
+	app.closers = append(app.closers, closer)
+}
+
+// SetRouteActivation sets the activation schedule of a message route. Messages
+// for the route are rejected, or dispatched to the schedule's fallback route,
+// at heights and block times where the route is not active.
+func (app *BaseApp) SetRouteActivation(route string, schedule sdk.ActivationSchedule) {
+	if app.sealed {
+		panic("SetRouteActivation() on sealed BaseApp")
+	}
+	if schedule.Fallback == route {
+		panic(fmt.Sprintf("route %s cannot fall back to itself", route))
+	}
+	if app.routeSchedules == nil {
+		app.routeSchedules = make(map[string]sdk.ActivationSchedule)
+	}
+	app.routeSchedules[route] = schedule
+}
+
+// SetModuleQueryGas sets the flat gas charged for the module queries of a
+// query route, in place of DefaultModuleQueryGas.
+func (app *BaseApp) SetModuleQueryGas(route string, gas sdk.Gas) {
+	if app.sealed {
+		panic("SetModuleQueryGas() on sealed BaseApp")
+	}
+	if app.moduleQueryGas == nil {
+		app.moduleQueryGas = make(map[string]sdk.Gas)
+	}
+	app.moduleQueryGas[route] = gas
+}
+
 func (app *BaseApp) SetFauxMerkleMode() {
 	if app.sealed {
 		panic("SetFauxMerkleMode() on sealed BaseApp")
@@ -0,0 +1,44 @@
+package baseapp
+
+import (
+	"fmt"
+	"runtime/debug"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AddRecoveryHandler registers a handler of the panics recovered while running
+// a transaction, eg. to map the panics of a module to its own error codes.
+// Handlers are tried in order of registration, before the default handling of
+// out of gas panics and of any other panic as an internal error.
+func (app *BaseApp) AddRecoveryHandler(handler sdk.RecoveryHandler) {
+	if app.sealed {
+		panic("AddRecoveryHandler() on sealed BaseApp")
+	}
+	app.recoveryHandlers = append(app.recoveryHandlers, handler)
+}
+
+// processRecovery returns the result of a transaction which panicked with the
+// recovered value.
+func (app *BaseApp) processRecovery(r interface{}, gasWanted, gasUsed uint64) sdk.Result {
+	for _, handler := range app.recoveryHandlers {
+		if result, ok := handler(r); ok {
+			return result
+		}
+	}
+
+	switch rType := r.(type) {
+	case sdk.ErrorOutOfGas:
+		log := fmt.Sprintf(
+			"out of gas in location: %v; gasWanted: %d, gasUsed: %d",
+			rType.Descriptor, gasWanted, gasUsed,
+		)
+		return sdk.ErrOutOfGas(log).Result()
+
+	default:
+		// The stack trace differs between nodes and must not leak into the
+		// result, which is part of consensus, so it is only logged.
+		app.logger.Error("recovered panic while running tx", "err", r, "stack", string(debug.Stack()))
+		return sdk.ErrInternal(fmt.Sprintf("recovered: %v", r)).Result()
+	}
+}
@@ -0,0 +1,53 @@
+package baseapp
+
+import (
+	"encoding/json"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// storeUsageTracker is implemented by the multistores able to account the
+// approximate number of bytes used by each of their substores, eg. the root
+// multistore.
+type storeUsageTracker interface {
+	EnableUsageTracking()
+	StoreUsage() map[string]int64
+}
+
+// SetStoreUsageTracking returns an option enabling the accounting of the
+// approximate number of bytes used by each module store, which can then be
+// queried through the "/app/store_usage" path. The usage is accounted from
+// the moment the option is first set for a chain. It panics if the multistore
+// of the app doesn't support usage tracking.
+func SetStoreUsageTracking() func(*BaseApp) {
+	return func(bap *BaseApp) {
+		tracker, ok := bap.cms.(storeUsageTracker)
+		if !ok {
+			panic("multistore doesn't support store usage tracking")
+		}
+		tracker.EnableUsageTracking()
+	}
+}
+
+// queryStoreUsage returns the JSON encoded number of bytes used by each store
+// as of the last commit, by store name.
+func (app *BaseApp) queryStoreUsage() abci.ResponseQuery {
+	tracker, ok := app.cms.(storeUsageTracker)
+	if !ok {
+		return sdk.ErrUnknownRequest("multistore doesn't support store usage tracking").QueryResult()
+	}
+
+	bz, err := json.Marshal(tracker.StoreUsage())
+	if err != nil {
+		return sdk.ErrInternal(err.Error()).QueryResult()
+	}
+
+	return abci.ResponseQuery{
+		Code:      uint32(sdk.CodeOK),
+		Codespace: string(sdk.CodespaceRoot),
+		Height:    app.LastBlockHeight(),
+		Value:     bz,
+	}
+}
@@ -0,0 +1,88 @@
+package baseapp
+
+import (
+	"io"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StreamingService receives the ABCI requests and responses of every block
+// along with the writes of the block to the stores it listens to, eg. to feed
+// an external indexer. The writes of a block are passed on to the listeners
+// on Commit, after EndBlock and before ListenCommit.
+//
+// Errors of a streaming service are logged, they never halt the node.
+type StreamingService interface {
+	// Listeners returns the listeners of the stores to listen to.
+	Listeners() map[sdk.StoreKey][]sdk.WriteListener
+
+	ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error
+	ListenDeliverTx(ctx sdk.Context, txBytes []byte, res abci.ResponseDeliverTx) error
+	ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error
+	ListenCommit(res abci.ResponseCommit) error
+}
+
+// listeningMultiStore is implemented by the multistores able to pass the
+// writes to their substores on to listeners, eg. the root multistore.
+type listeningMultiStore interface {
+	AddListeners(key sdk.StoreKey, listeners []sdk.WriteListener)
+}
+
+// SetStreamingService returns an option registering a streaming service and
+// its store listeners. Services implementing io.Closer are closed with the
+// app. It panics if the multistore of the app doesn't support listeners.
+func SetStreamingService(service StreamingService) func(*BaseApp) {
+	return func(bap *BaseApp) {
+		cms, ok := bap.cms.(listeningMultiStore)
+		if !ok {
+			panic("multistore doesn't support listeners")
+		}
+
+		for key, listeners := range service.Listeners() {
+			cms.AddListeners(key, listeners)
+		}
+
+		if closer, ok := service.(io.Closer); ok {
+			bap.AddCloser(closer)
+		}
+		bap.streamingServices = append(bap.streamingServices, service)
+	}
+}
+
+// streamBeginBlock hands a BeginBlock over to the streaming services.
+func (app *BaseApp) streamBeginBlock(req abci.RequestBeginBlock, res abci.ResponseBeginBlock) {
+	for _, service := range app.streamingServices {
+		if err := service.ListenBeginBlock(app.deliverState.ctx, req, res); err != nil {
+			app.logger.Error("failed to stream BeginBlock", "height", req.Header.Height, "err", err)
+		}
+	}
+}
+
+// streamDeliverTx hands a DeliverTx over to the streaming services.
+func (app *BaseApp) streamDeliverTx(txBytes []byte, res abci.ResponseDeliverTx) {
+	for _, service := range app.streamingServices {
+		if err := service.ListenDeliverTx(app.deliverState.ctx, txBytes, res); err != nil {
+			app.logger.Error("failed to stream DeliverTx", "err", err)
+		}
+	}
+}
+
+// streamEndBlock hands an EndBlock over to the streaming services.
+func (app *BaseApp) streamEndBlock(req abci.RequestEndBlock, res abci.ResponseEndBlock) {
+	for _, service := range app.streamingServices {
+		if err := service.ListenEndBlock(app.deliverState.ctx, req, res); err != nil {
+			app.logger.Error("failed to stream EndBlock", "height", req.Height, "err", err)
+		}
+	}
+}
+
+// streamCommit hands a Commit over to the streaming services.
+func (app *BaseApp) streamCommit(res abci.ResponseCommit) {
+	for _, service := range app.streamingServices {
+		if err := service.ListenCommit(res); err != nil {
+			app.logger.Error("failed to stream Commit", "err", err)
+		}
+	}
+}
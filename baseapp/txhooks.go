@@ -0,0 +1,118 @@
+package baseapp
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// number of committed blocks whose transactions can be waiting for the hooks
+// to run before new blocks are dropped
+const txHookQueueSize = 100
+
+// deliveredTx is a successfully delivered transaction waiting for its block to
+// be committed.
+type deliveredTx struct {
+	tx     sdk.Tx
+	result sdk.Result
+}
+
+// committedTxs are the successfully delivered transactions of a committed
+// block.
+type committedTxs struct {
+	height int64
+	txs    []deliveredTx
+}
+
+// AddTxHook registers a hook called with every successfully delivered
+// transaction once its block is committed. Hooks are run in order of
+// registration on a separate goroutine so that slow hooks, eg. pushing to an
+// external indexer, never block block processing.
+func (app *BaseApp) AddTxHook(hook sdk.TxHook) {
+	if app.sealed {
+		panic("AddTxHook() on sealed BaseApp")
+	}
+	if app.txHookQueue == nil {
+		app.txHookQueue = make(chan committedTxs, txHookQueueSize)
+		app.txHookDone = make(chan struct{})
+		go app.runTxHooks(app.txHookQueue, app.txHookDone)
+	}
+	app.txHooks = append(app.txHooks, hook)
+}
+
+// recordDeliveredTx keeps a successfully delivered transaction until its block
+// is committed.
+func (app *BaseApp) recordDeliveredTx(tx sdk.Tx, result sdk.Result) {
+	if len(app.txHooks) == 0 || !result.IsOK() {
+		return
+	}
+	app.deliveredTxs = append(app.deliveredTxs, deliveredTx{tx, result})
+}
+
+// dispatchTxHooks queues the transactions of the committed block for the hooks.
+// The block is dropped if the hooks are too far behind.
+func (app *BaseApp) dispatchTxHooks(height int64) {
+	if app.txHookQueue == nil {
+		return
+	}
+
+	txs := app.deliveredTxs
+	app.deliveredTxs = nil
+	if len(txs) == 0 {
+		return
+	}
+
+	app.txHookMtx.Lock()
+	defer app.txHookMtx.Unlock()
+
+	if app.txHookClosed {
+		return
+	}
+
+	select {
+	case app.txHookQueue <- committedTxs{height, txs}:
+	default:
+		app.logger.Error("tx hooks queue is full, dropping block", "height", height, "txs", len(txs))
+	}
+}
+
+// closeTxHooks stops accepting new blocks for the hooks and waits for the
+// queued ones to be processed. It is safe to call it multiple times.
+func (app *BaseApp) closeTxHooks() {
+	app.txHookMtx.Lock()
+	if app.txHookQueue == nil || app.txHookClosed {
+		app.txHookMtx.Unlock()
+		return
+	}
+	app.txHookClosed = true
+	close(app.txHookQueue)
+	app.txHookMtx.Unlock()
+
+	<-app.txHookDone
+}
+
+// runTxHooks runs the hooks on the committed transactions until the queue is
+// closed, closing the done channel when it returns.
+func (app *BaseApp) runTxHooks(queue <-chan committedTxs, done chan<- struct{}) {
+	defer close(done)
+
+	for block := range queue {
+		for _, dtx := range block.txs {
+			for _, hook := range app.txHooks {
+				app.runTxHook(hook, block.height, dtx)
+			}
+		}
+	}
+}
+
+// runTxHook runs a single hook, recovering and logging any panic so that a
+// faulty hook doesn't bring down the node.
+func (app *BaseApp) runTxHook(hook sdk.TxHook, height int64, dtx deliveredTx) {
+	defer func() {
+		if r := recover(); r != nil {
+			app.logger.Error("tx hook panicked", "height", height, "err", fmt.Sprintf("%v", r))
+		}
+	}()
+
+	hook(height, dtx.tx, dtx.result)
+}
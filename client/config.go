@@ -6,6 +6,7 @@ import (
 	"os"
 	"path"
 	"strconv"
+	"strings"
 
 	"github.com/tendermint/tendermint/libs/cli"
 
@@ -17,6 +18,12 @@ import (
 const (
 	flagGet = "get"
 
+	// FlagProfile selects the configuration profile of the command, in place
+	// of the active profile set with `config use-profile`
+	FlagProfile = "profile"
+
+	useProfileKey = "use-profile"
+
 	// DefaultKeyPass contains the default key password for genesis transactions
 	DefaultKeyPass = "12345678"
 )
@@ -28,20 +35,46 @@ var configDefaults = map[string]string{
 	"broadcast-mode": "sync",
 }
 
+// profileKeys are the configuration keys which can be set per profile, the
+// settings specific to a network
+var profileKeys = map[string]bool{
+	"chain-id":       true,
+	"node":           true,
+	"broadcast-mode": true,
+	"trust-node":     true,
+	"fees":           true,
+	"gas-prices":     true,
+}
+
 // ConfigCmd returns a CLI command to interactively create an application CLI
 // config file.
 func ConfigCmd(defaultCLIHome string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "config <key> [value]",
 		Short: "Create or query an application CLI configuration file",
-		RunE:  runConfigCmd,
-		Args:  cobra.RangeArgs(0, 2),
+		Long: `Create or query an application CLI configuration file.
+
+The settings of a network (chain-id, node, broadcast-mode, trust-node, fees and
+gas-prices) can be saved in named profiles with the --profile flag, eg.:
+
+$ <appcli> config chain-id testnet-1 --profile testnet
+$ <appcli> config node tcp://testnet.example.com:26657 --profile testnet
+
+The profile is then used with the --profile flag of the commands, or for all
+the commands once made active:
+
+$ <appcli> config use-profile testnet
+`,
+		RunE: runConfigCmd,
+		Args: cobra.RangeArgs(0, 2),
 	}
 
 	cmd.Flags().String(cli.HomeFlag, defaultCLIHome,
 		"set client's home directory for configuration")
 	cmd.Flags().Bool(flagGet, false,
 		"print configuration value or its default if unset")
+	cmd.Flags().String(FlagProfile, "",
+		"get or set the value of the given profile")
 	return cmd
 }
 
@@ -74,15 +107,35 @@ func runConfigCmd(cmd *cobra.Command, args []string) error {
 
 	key := args[0]
 
+	// the keys of a profile are stored under profiles.<name>
+	profile, _ := cmd.Flags().GetString(FlagProfile)
+	treeKey := key
+	if profile != "" {
+		if err := validateProfileName(profile); err != nil {
+			return err
+		}
+		if !profileKeys[key] {
+			return fmt.Errorf("configuration key %q cannot be set per profile", key)
+		}
+		treeKey = profileTreeKey(profile, key)
+	}
+
 	// get config value for a given key
 	if getAction {
 		switch key {
 		case "trace", "trust-node", "indent":
-			fmt.Println(tree.GetDefault(key, false).(bool))
+			fmt.Println(tree.GetDefault(treeKey, tree.GetDefault(key, false)).(bool))
+
+		case useProfileKey:
+			fmt.Println(tree.GetDefault(FlagProfile, "").(string))
 
 		default:
 			if defaultValue, ok := configDefaults[key]; ok {
-				fmt.Println(tree.GetDefault(key, defaultValue).(string))
+				fmt.Println(tree.GetDefault(treeKey, tree.GetDefault(key, defaultValue)).(string))
+				return nil
+			}
+			if profileKeys[key] {
+				fmt.Println(tree.GetDefault(treeKey, tree.GetDefault(key, "")).(string))
 				return nil
 			}
 
@@ -100,8 +153,8 @@ func runConfigCmd(cmd *cobra.Command, args []string) error {
 
 	// set config value for a given key
 	switch key {
-	case "chain-id", "output", "node", "broadcast-mode":
-		tree.Set(key, value)
+	case "chain-id", "output", "node", "broadcast-mode", "fees", "gas-prices":
+		tree.Set(treeKey, value)
 
 	case "trace", "trust-node", "indent":
 		boolVal, err := strconv.ParseBool(value)
@@ -109,7 +162,15 @@ func runConfigCmd(cmd *cobra.Command, args []string) error {
 			return err
 		}
 
-		tree.Set(key, boolVal)
+		tree.Set(treeKey, boolVal)
+
+	case useProfileKey:
+		// an empty profile deactivates the active profile
+		if value != "" && !tree.Has(profilePrefix(value)) {
+			return fmt.Errorf("unknown configuration profile: %q", value)
+		}
+
+		tree.Set(FlagProfile, value)
 
 	default:
 		return errUnknownConfigKey(key)
@@ -163,6 +224,55 @@ func saveConfigFile(cfgFile string, tree *toml.Tree) error {
 	return err
 }
 
+// ApplyConfigProfile applies the settings of the configuration profile given
+// with the --profile flag of the command, or else of the active profile, on
+// top of the settings of the configuration file. Flags set on the command line
+// take precedence over the profile. It is called before running the commands
+// set up by GetCommands and PostCommands, which expects viper to have read the
+// CLI configuration file in the PersistentPreRunE of the root command.
+func ApplyConfigProfile(cmd *cobra.Command) error {
+	name := viper.GetString(FlagProfile)
+	if flag := cmd.Flags().Lookup(FlagProfile); flag != nil && flag.Changed {
+		name = flag.Value.String()
+	}
+	if name == "" {
+		return nil
+	}
+	if err := validateProfileName(name); err != nil {
+		return err
+	}
+
+	if !viper.IsSet(profilePrefix(name)) {
+		return fmt.Errorf("unknown configuration profile: %q", name)
+	}
+
+	for key, value := range viper.GetStringMap(profilePrefix(name)) {
+		if !profileKeys[key] {
+			continue
+		}
+		if flag := cmd.Flags().Lookup(key); flag != nil && flag.Changed {
+			continue
+		}
+		viper.Set(key, value)
+	}
+	return nil
+}
+
+func profilePrefix(profile string) string {
+	return "profiles." + profile
+}
+
+func profileTreeKey(profile, key string) string {
+	return profilePrefix(profile) + "." + key
+}
+
+func validateProfileName(name string) error {
+	if name == "" || strings.ContainsAny(name, ". \t\"'") {
+		return fmt.Errorf("invalid configuration profile name: %q", name)
+	}
+	return nil
+}
+
 func errUnknownConfigKey(key string) error {
 	return fmt.Errorf("unknown configuration key: %q", key)
 }
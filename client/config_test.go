@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -43,3 +44,63 @@ func tmpDir(t *testing.T) (string, func()) {
 	require.NoError(t, err)
 	return dir, func() { _ = os.RemoveAll(dir) }
 }
+
+func Test_configProfiles(t *testing.T) {
+	configHome, cleanup := tmpDir(t)
+	defer cleanup()
+	viper.Set(cli.HomeFlag, configHome)
+	defer viper.Reset()
+
+	cmd := ConfigCmd(configHome)
+	require.NoError(t, cmd.RunE(cmd, []string{"node", "tcp://localhost:26657"}))
+
+	// a profile must exist to be used
+	require.Error(t, cmd.RunE(cmd, []string{"use-profile", "testnet"}))
+
+	require.NoError(t, cmd.Flags().Set(FlagProfile, "testnet"))
+	require.NoError(t, cmd.RunE(cmd, []string{"node", "tcp://testnet:26657"}))
+	require.NoError(t, cmd.RunE(cmd, []string{"chain-id", "testnet-1"}))
+	require.Error(t, cmd.RunE(cmd, []string{"output", "json"}))
+	require.NoError(t, cmd.Flags().Set(FlagProfile, ""))
+
+	require.NoError(t, cmd.RunE(cmd, []string{"use-profile", "testnet"}))
+
+	loadConfig := func() {
+		viper.Reset()
+		viper.SetConfigFile(filepath.Join(configHome, "config", "config.toml"))
+		require.NoError(t, viper.ReadInConfig())
+	}
+
+	// the active profile applies on top of the configuration file
+	loadConfig()
+
+	queryCmd := GetCommands(&cobra.Command{})[0]
+	require.NoError(t, ApplyConfigProfile(queryCmd))
+	require.Equal(t, "tcp://testnet:26657", viper.GetString(FlagNode))
+	require.Equal(t, "testnet-1", viper.GetString(FlagChainID))
+
+	// flags take precedence over the profile
+	loadConfig()
+	queryCmd = GetCommands(&cobra.Command{})[0]
+	require.NoError(t, queryCmd.Flags().Set(FlagNode, "tcp://other:26657"))
+	require.NoError(t, ApplyConfigProfile(queryCmd))
+	require.Equal(t, "tcp://other:26657", viper.GetString(FlagNode))
+
+	// unknown profile given with the flag
+	require.NoError(t, queryCmd.Flags().Set(FlagProfile, "mainnet"))
+	require.Error(t, ApplyConfigProfile(queryCmd))
+
+	// the profile is applied before the commands run
+	loadConfig()
+	var node string
+	queryCmd = GetCommands(&cobra.Command{
+		Use: "query",
+		RunE: func(*cobra.Command, []string) error {
+			node = viper.GetString(FlagNode)
+			return nil
+		},
+	})[0]
+	queryCmd.SetArgs([]string{})
+	require.NoError(t, queryCmd.Execute())
+	require.Equal(t, "tcp://testnet:26657", node)
+}
@@ -56,6 +56,7 @@ type CLIContext struct {
 	FromName      string
 	Indent        bool
 	SkipConfirm   bool
+	AddressCodec  sdk.AddressCodec
 }
 
 // NewCLIContextWithFrom returns a new initialized CLIContext with parameters from the
@@ -104,6 +105,7 @@ func NewCLIContextWithFrom(from string) CLIContext {
 		FromName:      fromName,
 		Indent:        viper.GetBool(client.FlagIndentResponse),
 		SkipConfirm:   viper.GetBool(client.FlagSkipConfirmation),
+		AddressCodec:  sdk.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
 	}
 }
 
@@ -182,6 +184,13 @@ func (ctx CLIContext) WithAccountDecoder(cdc *codec.Codec) CLIContext {
 	return ctx
 }
 
+// WithAddressCodec returns a copy of the context with an updated address codec
+// used to parse and format account addresses.
+func (ctx CLIContext) WithAddressCodec(addressCodec sdk.AddressCodec) CLIContext {
+	ctx.AddressCodec = addressCodec
+	return ctx
+}
+
 // WithOutput returns a copy of the context with an updated output writer (e.g. stdout).
 func (ctx CLIContext) WithOutput(w io.Writer) CLIContext {
 	ctx.Output = w
@@ -69,11 +69,13 @@ func GetCommands(cmds ...*cobra.Command) []*cobra.Command {
 		c.Flags().Bool(FlagTrustNode, false, "Trust connected full node (don't verify proofs for responses)")
 		c.Flags().Bool(FlagUseLedger, false, "Use a connected Ledger device")
 		c.Flags().String(FlagNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for this chain")
+		c.Flags().String(FlagProfile, "", "Configuration profile to use in place of the active profile")
 		viper.BindPFlag(FlagTrustNode, c.Flags().Lookup(FlagTrustNode))
 		viper.BindPFlag(FlagUseLedger, c.Flags().Lookup(FlagUseLedger))
 		viper.BindPFlag(FlagNode, c.Flags().Lookup(FlagNode))
 
 		c.MarkFlagRequired(FlagChainID)
+		applyConfigProfileOnRun(c)
 	}
 	return cmds
 }
@@ -89,6 +91,7 @@ func PostCommands(cmds ...*cobra.Command) []*cobra.Command {
 		c.Flags().String(FlagFees, "", "Fees to pay along with transaction; eg: 10uatom")
 		c.Flags().String(FlagGasPrices, "", "Gas prices to determine the transaction fee (e.g. 10uatom)")
 		c.Flags().String(FlagNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for this chain")
+		c.Flags().String(FlagProfile, "", "Configuration profile to use in place of the active profile")
 		c.Flags().Bool(FlagUseLedger, false, "Use a connected Ledger device")
 		c.Flags().Float64(FlagGasAdjustment, DefaultGasAdjustment, "adjustment factor to be multiplied against the estimate returned by the tx simulation; if the gas limit is set manually this flag is ignored ")
 		c.Flags().StringP(FlagBroadcastMode, "b", BroadcastSync, "Transaction broadcasting mode (sync|async|block)")
@@ -109,10 +112,32 @@ func PostCommands(cmds ...*cobra.Command) []*cobra.Command {
 		viper.BindPFlag(FlagNode, c.Flags().Lookup(FlagNode))
 
 		c.MarkFlagRequired(FlagChainID)
+		applyConfigProfileOnRun(c)
 	}
 	return cmds
 }
 
+// applyConfigProfileOnRun applies the configuration profile before the command
+// runs, once the CLI configuration file has been read by the root command. The
+// existing pre-run function of the command is still called afterwards.
+func applyConfigProfileOnRun(c *cobra.Command) {
+	preRunE, preRun := c.PreRunE, c.PreRun
+	c.PreRun = nil
+	c.PreRunE = func(cmd *cobra.Command, args []string) error {
+		if err := ApplyConfigProfile(cmd); err != nil {
+			return err
+		}
+
+		switch {
+		case preRunE != nil:
+			return preRunE(cmd, args)
+		case preRun != nil:
+			preRun(cmd, args)
+		}
+		return nil
+	}
+}
+
 // RegisterRestServerFlags registers the flags required for rest server
 func RegisterRestServerFlags(cmd *cobra.Command) *cobra.Command {
 	cmd = GetCommands(cmd)[0]
@@ -27,6 +27,9 @@ func Commands() *cobra.Command {
 		deleteKeyCommand(),
 		updateKeyCommand(),
 		parseKeyStringCommand(),
+		client.LineBreak,
+		signDataCommand(),
+		verifyDataCommand(),
 	)
 	return cmd
 }
@@ -11,5 +11,5 @@ func TestCommands(t *testing.T) {
 	assert.NotNil(t, rootCommands)
 
 	// Commands are registered
-	assert.Equal(t, 8, len(rootCommands.Commands()))
+	assert.Equal(t, 11, len(rootCommands.Commands()))
 }
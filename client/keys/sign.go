@@ -0,0 +1,123 @@
+package keys
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// SignedData is the output of sign-data, the signature of arbitrary off-chain
+// data by the key of the signer account.
+type SignedData struct {
+	Signer    sdk.AccAddress    `json:"signer"`
+	Signature auth.StdSignature `json:"signature"`
+}
+
+func signDataCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-data <name> <data>",
+		Short: "Sign arbitrary off-chain data with the given key",
+		Long: `Sign arbitrary off-chain data, eg. to prove the ownership of an account for
+an airdrop claim or a login. The data is signed under a domain separate from
+transactions: the signature can never be used to authorize a transaction.
+
+The signer and signature are printed as JSON, to be checked with verify-data.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: runSignDataCmd,
+	}
+
+	cmd.Flags().Bool(client.FlagIndentResponse, false, "Add indent to JSON response")
+	return cmd
+}
+
+func runSignDataCmd(cmd *cobra.Command, args []string) error {
+	name, data := args[0], []byte(args[1])
+
+	kb, err := NewKeyBaseFromHomeFlag()
+	if err != nil {
+		return err
+	}
+
+	passphrase, err := GetPassphrase(name)
+	if err != nil {
+		return err
+	}
+
+	out, err := signData(kb, name, passphrase, data)
+	if err != nil {
+		return err
+	}
+
+	var bz []byte
+	if viper.GetBool(client.FlagIndentResponse) {
+		bz, err = cdc.MarshalJSONIndent(out, "", "  ")
+	} else {
+		bz, err = cdc.MarshalJSON(out)
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(bz))
+	return nil
+}
+
+// signData signs the off-chain data with the named key.
+func signData(kb keys.Keybase, name, passphrase string, data []byte) (SignedData, error) {
+	info, err := kb.Get(name)
+	if err != nil {
+		return SignedData{}, err
+	}
+
+	signer := info.GetAddress()
+	sig, pubKey, err := kb.Sign(name, passphrase, auth.StdOffchainSignBytes(signer, data))
+	if err != nil {
+		return SignedData{}, err
+	}
+
+	return SignedData{
+		Signer:    signer,
+		Signature: auth.StdSignature{PubKey: pubKey, Signature: sig},
+	}, nil
+}
+
+func verifyDataCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "verify-data <data> <signature-file>",
+		Short: "Verify the signature of arbitrary off-chain data",
+		Long: `Verify that the signature of off-chain data, as printed by sign-data and
+saved to the signature file, was made by the key of the signer account.
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: runVerifyDataCmd,
+	}
+}
+
+func runVerifyDataCmd(cmd *cobra.Command, args []string) error {
+	data := []byte(args[0])
+
+	bz, err := ioutil.ReadFile(args[1])
+	if err != nil {
+		return err
+	}
+
+	var signed SignedData
+	if err := cdc.UnmarshalJSON(bz, &signed); err != nil {
+		return err
+	}
+
+	if err := auth.VerifyOffchainSignature(signed.Signer, data, signed.Signature); err != nil {
+		return err
+	}
+
+	fmt.Printf("Signature of %s verified\n", signed.Signer)
+	return nil
+}
@@ -0,0 +1,40 @@
+package keys
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/tests"
+)
+
+func Test_runVerifyDataCmd(t *testing.T) {
+	kbHome, cleanUp := tests.NewTestCaseDir(t)
+	defer cleanUp()
+	viper.Set(cli.HomeFlag, kbHome)
+
+	kb, err := NewKeyBaseFromHomeFlag()
+	require.NoError(t, err)
+	_, err = kb.CreateAccount("signDataKey", tests.TestMnemonic, "", "12345678", 0, 0)
+	require.NoError(t, err)
+
+	_, err = signData(kb, "signDataKey", "wrong passphrase", []byte("data"))
+	require.Error(t, err)
+
+	signed, err := signData(kb, "signDataKey", "12345678", []byte("data"))
+	require.NoError(t, err)
+	bz, err := cdc.MarshalJSON(signed)
+	require.NoError(t, err)
+
+	sigFile := filepath.Join(kbHome, "signed.json")
+	require.NoError(t, ioutil.WriteFile(sigFile, bz, 0600))
+
+	cmd := verifyDataCommand()
+	require.NoError(t, runVerifyDataCmd(cmd, []string{"data", sigFile}))
+	require.Error(t, runVerifyDataCmd(cmd, []string{"other data", sigFile}))
+	require.Error(t, runVerifyDataCmd(cmd, []string{"data", filepath.Join(kbHome, "missing.json")}))
+}
@@ -50,6 +50,12 @@ func NewRestServer(cdc *codec.Codec) *RestServer {
 	}
 }
 
+// Logger returns the logger of the rest server, eg. to log the requests to
+// deprecated routes
+func (rs *RestServer) Logger() log.Logger {
+	return rs.log
+}
+
 // Start starts the rest server
 func (rs *RestServer) Start(listenAddr string, maxOpen int, readTimeout, writeTimeout uint) (err error) {
 	server.TrapSignal(func() {
@@ -0,0 +1,105 @@
+package modules
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/version"
+)
+
+// QueryModules queries the descriptors of all the modules of the chain
+func QueryModules(cliCtx context.CLIContext) ([]sdk.ModuleDescriptor, error) {
+	res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s", sdk.ModulesQueryRoute), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var descs []sdk.ModuleDescriptor
+	if err := cliCtx.Codec.UnmarshalJSON(res, &descs); err != nil {
+		return nil, err
+	}
+	return descs, nil
+}
+
+// QueryModule queries the descriptor of a module of the chain
+func QueryModule(cliCtx context.CLIContext, name string) (sdk.ModuleDescriptor, error) {
+	var desc sdk.ModuleDescriptor
+	res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", sdk.ModulesQueryRoute, name), nil)
+	if err != nil {
+		return desc, err
+	}
+
+	err = cliCtx.Codec.UnmarshalJSON(res, &desc)
+	return desc, err
+}
+
+// ModulesCommand returns the command querying the module descriptors
+func ModulesCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "modules [name]",
+		Short: "Query the modules of the chain, with their messages, queries and versions",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the descriptors of the modules of the chain, listing their message and
+querier routes, the types of the messages they handle, the paths of the queries
+they serve and their consensus versions, or the descriptor of a single module.
+
+Example:
+$ %s query modules
+$ %s query modules staking
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			if len(args) == 1 {
+				desc, err := QueryModule(cliCtx, args[0])
+				if err != nil {
+					return err
+				}
+				return cliCtx.PrintOutput(desc)
+			}
+
+			descs, err := QueryModules(cliCtx)
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(descs)
+		},
+	}
+	return client.GetCommands(cmd)[0]
+}
+
+// RegisterRoutes registers the module descriptors REST handlers to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+	r.HandleFunc("/modules", modulesHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/modules/{name}", modulesHandlerFn(cdc, cliCtx)).Methods("GET")
+}
+
+func modulesHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s", sdk.ModulesQueryRoute)
+		if name := mux.Vars(r)["name"]; name != "" {
+			route = fmt.Sprintf("%s/%s", route, name)
+		}
+
+		res, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
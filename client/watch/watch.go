@@ -0,0 +1,292 @@
+package watch
+
+import (
+	gocontext "context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+const subscriber = "watch"
+
+// Change is a change of the balance of a watched address, or of the tokens it
+// delegates to a validator
+type Change struct {
+	Height    int64          `json:"height"`
+	Address   sdk.AccAddress `json:"address"`
+	Validator sdk.ValAddress `json:"validator,omitempty"` // set for delegation changes only
+	Before    sdk.Coins      `json:"before"`
+	After     sdk.Coins      `json:"after"`
+}
+
+// IsDelegation returns true if the change is a change of a delegation
+func (c Change) IsDelegation() bool {
+	return !c.Validator.Empty()
+}
+
+// nolint
+func (c Change) String() string {
+	if c.IsDelegation() {
+		return fmt.Sprintf("%d: delegation of %s to %s changed from %s to %s",
+			c.Height, c.Address, c.Validator, c.Before, c.After)
+	}
+	return fmt.Sprintf("%d: balance of %s changed from %s to %s", c.Height, c.Address, c.Before, c.After)
+}
+
+// snapshot is the state of a watched address at some height
+type snapshot struct {
+	balance     sdk.Coins
+	delegations map[string]sdk.Coins // by validator operator address bytes
+}
+
+// diff returns the changes from the previous to the current snapshot of addr
+func diff(height int64, addr sdk.AccAddress, prev, cur snapshot) []Change {
+	var changes []Change
+	if !coinsEqual(prev.balance, cur.balance) {
+		changes = append(changes, Change{height, addr, nil, prev.balance, cur.balance})
+	}
+
+	validators := make(map[string]bool)
+	for val := range prev.delegations {
+		validators[val] = true
+	}
+	for val := range cur.delegations {
+		validators[val] = true
+	}
+
+	sorted := make([]string, 0, len(validators))
+	for val := range validators {
+		sorted = append(sorted, val)
+	}
+	sort.Strings(sorted)
+
+	for _, val := range sorted {
+		before, after := prev.delegations[val], cur.delegations[val]
+		if before == nil {
+			before = sdk.Coins{}
+		}
+		if after == nil {
+			after = sdk.Coins{}
+		}
+		if !coinsEqual(before, after) {
+			changes = append(changes, Change{height, addr, sdk.ValAddress(val), before, after})
+		}
+	}
+	return changes
+}
+
+// coinsEqual returns true if a and b hold the same amounts, unlike
+// Coins.IsEqual it does not panic on different denominations
+func coinsEqual(a, b sdk.Coins) bool {
+	return a.IsAllGTE(b) && b.IsAllGTE(a)
+}
+
+// Watcher tracks the balances and delegations of a set of addresses, such as
+// watch-only accounts, and reports their changes block by block
+type Watcher struct {
+	cliCtx    context.CLIContext
+	addresses []sdk.AccAddress
+	snapshots map[string]snapshot
+	bondDenom string
+}
+
+// NewWatcher creates a new Watcher of the given addresses
+func NewWatcher(cliCtx context.CLIContext, addresses ...sdk.AccAddress) *Watcher {
+	return &Watcher{
+		cliCtx:    cliCtx,
+		addresses: addresses,
+		snapshots: make(map[string]snapshot),
+	}
+}
+
+// Update queries the state of the watched addresses at the given height and
+// returns their changes since the previous update. The first update only
+// records the state of the addresses.
+func (w *Watcher) Update(height int64) ([]Change, error) {
+	var changes []Change
+	for _, addr := range w.addresses {
+		cur, err := w.query(height, addr)
+		if err != nil {
+			return nil, err
+		}
+
+		if prev, ok := w.snapshots[addr.String()]; ok {
+			changes = append(changes, diff(height, addr, prev, cur)...)
+		}
+		w.snapshots[addr.String()] = cur
+	}
+	return changes, nil
+}
+
+// Watch subscribes to the new blocks of the node and calls the handler with
+// the changes of the watched addresses at every block, until the done channel
+// is closed or the handler returns an error.
+func (w *Watcher) Watch(done <-chan struct{}, handler func(Change) error) error {
+	node, err := w.cliCtx.GetNode()
+	if err != nil {
+		return err
+	}
+	if err := node.Start(); err != nil {
+		return err
+	}
+	defer node.Stop() // nolint: errcheck
+
+	ctx, cancel := gocontext.WithCancel(gocontext.Background())
+	defer cancel()
+
+	blocks, err := node.Subscribe(ctx, subscriber, tmtypes.EventQueryNewBlockHeader.String())
+	if err != nil {
+		return err
+	}
+	defer node.UnsubscribeAll(ctx, subscriber) // nolint: errcheck
+
+	for {
+		select {
+		case <-done:
+			return nil
+
+		case event, ok := <-blocks:
+			if !ok {
+				return errors.New("block subscription closed")
+			}
+			data, ok := event.Data.(tmtypes.EventDataNewBlockHeader)
+			if !ok {
+				continue
+			}
+
+			changes, err := w.Update(data.Header.Height)
+			if err != nil {
+				return err
+			}
+			for _, change := range changes {
+				if err := handler(change); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// query returns the balance and delegations of addr at the given height
+func (w *Watcher) query(height int64, addr sdk.AccAddress) (snapshot, error) {
+	cliCtx := w.cliCtx
+	cliCtx.Height = height
+
+	cur := snapshot{balance: sdk.Coins{}, delegations: make(map[string]sdk.Coins)}
+
+	// accounts that do not exist yet have no balance
+	res, err := cliCtx.QueryStore(auth.AddressStoreKey(addr), cliCtx.AccountStore)
+	if err != nil {
+		return cur, err
+	}
+	if len(res) > 0 {
+		if cliCtx.AccDecoder == nil {
+			return cur, errors.New("account decoder required but not provided")
+		}
+		acc, err := cliCtx.AccDecoder(res)
+		if err != nil {
+			return cur, err
+		}
+		cur.balance = acc.GetCoins()
+	}
+
+	bondDenom, err := w.getBondDenom()
+	if err != nil {
+		return cur, err
+	}
+
+	bz, err := cliCtx.Codec.MarshalJSON(staking.NewQueryDelegatorParams(addr))
+	if err != nil {
+		return cur, err
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", staking.QuerierRoute, staking.QueryDelegatorDelegations)
+	res, err = cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return cur, err
+	}
+
+	var delegations staking.DelegationResponses
+	if err := cliCtx.Codec.UnmarshalJSON(res, &delegations); err != nil {
+		return cur, err
+	}
+	for _, del := range delegations {
+		cur.delegations[string(del.ValidatorAddress)] = sdk.NewCoins(sdk.NewCoin(bondDenom, del.Balance))
+	}
+	return cur, nil
+}
+
+// getBondDenom returns the staking bond denomination, queried once
+func (w *Watcher) getBondDenom() (string, error) {
+	if w.bondDenom != "" {
+		return w.bondDenom, nil
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", staking.QuerierRoute, staking.QueryParameters)
+	res, err := w.cliCtx.QueryWithData(route, nil)
+	if err != nil {
+		return "", err
+	}
+
+	var params staking.Params
+	if err := w.cliCtx.Codec.UnmarshalJSON(res, &params); err != nil {
+		return "", err
+	}
+	w.bondDenom = params.BondDenom
+	return w.bondDenom, nil
+}
+
+// WatchCommand returns the command watching the balances and delegations of
+// a set of addresses
+func WatchCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "watch [address...]",
+		Short: "Watch the balance and delegation changes of a set of addresses",
+		Long: strings.TrimSpace(`Subscribe to the new blocks of the node and print every change of the balances
+and delegations of the given addresses, which do not need to be in the keybase:
+
+$ <appcli> watch cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj cosmos1tmlgwrssn8qm3ft5x3uvq0gdjdv9l4drrnu4vh
+`),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).WithAccountDecoder(cdc)
+
+			addresses := make([]sdk.AccAddress, len(args))
+			for i, arg := range args {
+				bz, err := cliCtx.AddressCodec.StringToBytes(arg)
+				if err != nil {
+					return err
+				}
+				addresses[i] = sdk.AccAddress(bz)
+			}
+
+			done := make(chan struct{})
+			sigs := make(chan os.Signal, 1)
+			signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+			go func() {
+				<-sigs
+				close(done)
+			}()
+
+			return NewWatcher(cliCtx, addresses...).Watch(done, func(change Change) error {
+				return cliCtx.PrintOutput(change)
+			})
+		},
+	}
+	return client.GetCommands(cmd)[0]
+}
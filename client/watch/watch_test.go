@@ -0,0 +1,43 @@
+package watch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDiff(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr"))
+	val1, val2, val3 := sdk.ValAddress([]byte("val1")), sdk.ValAddress([]byte("val2")), sdk.ValAddress([]byte("val3"))
+	coins := func(denom string, amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(denom, amount))
+	}
+
+	prev := snapshot{
+		balance: coins("atom", 10),
+		delegations: map[string]sdk.Coins{
+			string(val1): coins("stake", 5),
+			string(val2): coins("stake", 7),
+		},
+	}
+
+	// nothing changed
+	require.Empty(t, diff(3, addr, prev, prev))
+
+	// a different denomination is a change as well
+	cur := snapshot{
+		balance: coins("photon", 10),
+		delegations: map[string]sdk.Coins{
+			string(val2): coins("stake", 8),
+			string(val3): coins("stake", 1),
+		},
+	}
+	require.Equal(t, []Change{
+		{3, addr, nil, coins("atom", 10), coins("photon", 10)},
+		{3, addr, val1, coins("stake", 5), sdk.Coins{}},
+		{3, addr, val2, coins("stake", 7), coins("stake", 8)},
+		{3, addr, val3, sdk.Coins{}, coins("stake", 1)},
+	}, diff(3, addr, prev, cur))
+}
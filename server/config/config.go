@@ -21,11 +21,36 @@ type BaseConfig struct {
 	// HaltHeight contains a non-zero height at which a node will gracefully halt
 	// and shutdown that can be used to assist upgrades and testing.
 	HaltHeight uint64 `mapstructure:"halt-height"`
+
+	// HaltTime contains a non-zero minimum block time (in Unix seconds) at
+	// which a node will gracefully halt and shutdown that can be used to assist
+	// upgrades and testing.
+	HaltTime uint64 `mapstructure:"halt-time"`
+
+	// InterBlockCacheSize is the number of values of each IAVL store cached
+	// in memory across blocks, zero disables the cache.
+	InterBlockCacheSize int `mapstructure:"inter-block-cache-size"`
+}
+
+// WebhookConfig defines a webhook the node posts matching events to
+type WebhookConfig struct {
+	// URL the matching events are posted to.
+	URL string `mapstructure:"url"`
+
+	// Query filtering the events to post, using the Tendermint event query
+	// syntax (e.g. "tm.event = 'Tx' AND action = 'submit_proposal'").
+	Query string `mapstructure:"query"`
+
+	// MaxRetries is the number of times a failed post is retried.
+	MaxRetries uint `mapstructure:"max-retries"`
 }
 
 // Config defines the server's top level configuration
 type Config struct {
 	BaseConfig `mapstructure:",squash"`
+
+	// Webhooks the node posts matching events to.
+	Webhooks []WebhookConfig `mapstructure:"webhooks"`
 }
 
 // SetMinGasPrices sets the validator's minimum gas prices.
@@ -59,8 +84,10 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 func DefaultConfig() *Config {
 	return &Config{
 		BaseConfig{
-			MinGasPrices: defaultMinGasPrices,
-			HaltHeight:   0,
+			MinGasPrices:        defaultMinGasPrices,
+			HaltHeight:          0,
+			HaltTime:            0,
+			InterBlockCacheSize: 0,
 		},
 	}
 }
@@ -1,8 +1,10 @@
 package config
 
 import (
+	"bytes"
 	"testing"
 
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,3 +20,23 @@ func TestSetMinimumFees(t *testing.T) {
 	cfg.SetMinGasPrices(sdk.DecCoins{sdk.NewInt64DecCoin("foo", 5)})
 	require.Equal(t, "5.000000000000000000foo", cfg.MinGasPrices)
 }
+
+func TestWebhooksConfigEscaping(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Webhooks = []WebhookConfig{{
+		URL:        `http://localhost/"hook"`,
+		Query:      `tm.event = "Tx" AND memo = 'a\b'`,
+		MaxRetries: 3,
+	}}
+
+	var buffer bytes.Buffer
+	require.NoError(t, configTemplate.Execute(&buffer, cfg))
+
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(&buffer))
+
+	parsed := DefaultConfig()
+	require.NoError(t, v.Unmarshal(parsed))
+	require.Equal(t, cfg.Webhooks, parsed.Webhooks)
+}
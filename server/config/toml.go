@@ -2,6 +2,7 @@ package config
 
 import (
 	"bytes"
+	"strings"
 	"text/template"
 
 	"github.com/spf13/viper"
@@ -21,13 +22,49 @@ minimum-gas-prices = "{{ .BaseConfig.MinGasPrices }}"
 # HaltHeight contains a non-zero height at which a node will gracefully halt
 # and shutdown that can be used to assist upgrades and testing.
 halt-height = {{ .BaseConfig.HaltHeight }}
-`
+
+# HaltTime contains a non-zero minimum block time (in Unix seconds) at which
+# a node will gracefully halt and shutdown that can be used to assist upgrades
+# and testing.
+halt-time = {{ .BaseConfig.HaltTime }}
+
+# InterBlockCacheSize is the number of values of each IAVL store cached in
+# memory across blocks, so that hot keys are not read from disk every block.
+# Zero disables the cache.
+inter-block-cache-size = {{ .BaseConfig.InterBlockCacheSize }}
+
+##### webhooks config options #####
+
+# Webhooks the node posts the events matching a query to, e.g.:
+#
+# [[webhooks]]
+# url = "https://example.com/proposals"
+# query = "tm.event = 'NewBlock' AND proposal-result = 'proposal-passed'"
+# max-retries = 3
+{{ range .Webhooks }}
+[[webhooks]]
+url = "{{ escape .URL }}"
+query = "{{ escape .Query }}"
+max-retries = {{ .MaxRetries }}
+{{ end }}`
 
 var configTemplate *template.Template
 
+// tomlEscaper escapes the characters which cannot appear unescaped in a TOML
+// basic string.
+var tomlEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\t", `\t`,
+)
+
 func init() {
 	var err error
-	tmpl := template.New("appConfigFileTemplate")
+	tmpl := template.New("appConfigFileTemplate").Funcs(template.FuncMap{
+		"escape": tomlEscaper.Replace,
+	})
 	if configTemplate, err = tmpl.Parse(defaultConfigTemplate); err != nil {
 		panic(err)
 	}
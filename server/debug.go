@@ -0,0 +1,173 @@
+package server
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/tendermint/tendermint/libs/cli"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	flagStore  = "store"
+	flagPrefix = "prefix"
+	flagLimit  = "limit"
+)
+
+// DebugCmd returns the debug commands used to inspect the raw application
+// state of a stopped node. Key/value pairs of stores with a registered
+// decoder are printed in a human readable form, otherwise their raw bytes are
+// printed in hex.
+func DebugCmd(ctx *Context, cdc *codec.Codec, decoders sdk.StoreDecoderRegistry) *cobra.Command {
+	debugCmd := &cobra.Command{
+		Use:   "debug",
+		Short: "Tool for inspecting the raw application state",
+	}
+
+	storeCmd := &cobra.Command{
+		Use:   "store",
+		Short: "Inspect the raw key/value pairs of a store",
+	}
+
+	storeCmd.AddCommand(
+		debugStoreGetCmd(ctx, cdc, decoders),
+		debugStoreIterateCmd(ctx, cdc, decoders),
+	)
+
+	debugCmd.AddCommand(storeCmd)
+	return debugCmd
+}
+
+func debugStoreGetCmd(ctx *Context, cdc *codec.Codec, decoders sdk.StoreDecoderRegistry) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get [key-hex]",
+		Short: "Get the value stored under a key of a store",
+		Long: `Get the value stored under a hex encoded key of a store at a given height.
+
+Example:
+$ debug store get 0x01a3b1 --store slashing --height 100
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := parseHexBytes(args[0])
+			if err != nil {
+				return err
+			}
+
+			storeName := viper.GetString(flagStore)
+			store, db, err := loadDebugStore(ctx, storeName, viper.GetInt64(flagHeight))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			value := store.Get(key)
+			if value == nil {
+				return fmt.Errorf("no value found under key %X in store %s", key, storeName)
+			}
+
+			fmt.Println(decoders.Decode(cdc, storeName, cmn.KVPair{Key: key, Value: value}))
+			return nil
+		},
+	}
+
+	addDebugStoreFlags(cmd)
+	return cmd
+}
+
+func debugStoreIterateCmd(ctx *Context, cdc *codec.Codec, decoders sdk.StoreDecoderRegistry) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "iterate",
+		Short: "Iterate over the key/value pairs of a store",
+		Long: `Iterate over the key/value pairs of a store at a given height, optionally
+restricted to the keys starting with a hex encoded prefix.
+
+Example:
+$ debug store iterate --store slashing --prefix 0x01 --height 100
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			prefix, err := parseHexBytes(viper.GetString(flagPrefix))
+			if err != nil {
+				return err
+			}
+
+			storeName := viper.GetString(flagStore)
+			store, db, err := loadDebugStore(ctx, storeName, viper.GetInt64(flagHeight))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			iterator := sdk.KVStorePrefixIterator(store, prefix)
+			defer iterator.Close()
+
+			limit := viper.GetInt(flagLimit)
+			for i := 0; iterator.Valid() && (limit <= 0 || i < limit); iterator.Next() {
+				kvPair := cmn.KVPair{Key: iterator.Key(), Value: iterator.Value()}
+				fmt.Println(decoders.Decode(cdc, storeName, kvPair))
+				i++
+			}
+
+			return nil
+		},
+	}
+
+	addDebugStoreFlags(cmd)
+	cmd.Flags().String(flagPrefix, "", "Hex encoded prefix of the keys to iterate over")
+	cmd.Flags().Int(flagLimit, 0, "Maximum number of key/value pairs to print (0 means no limit)")
+	return cmd
+}
+
+func addDebugStoreFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagStore, "", "Name of the store to inspect")
+	cmd.Flags().Int64(flagHeight, 0, "Height of the state to inspect (0 means latest height)")
+	cmd.MarkFlagRequired(flagStore)
+}
+
+// loadDebugStore loads the IAVL store with the given name from the application
+// database at the given height. The caller must close the returned database.
+func loadDebugStore(ctx *Context, storeName string, height int64) (sdk.KVStore, dbm.DB, error) {
+	if storeName == "" {
+		return nil, nil, errors.New("a store name must be provided")
+	}
+
+	config := ctx.Config
+	config.SetRoot(viper.GetString(cli.HomeFlag))
+
+	db, err := openDB(config.RootDir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storeDB := dbm.NewPrefixDB(db, rootmulti.StorePrefix(storeName))
+	store, err := iavl.LoadStore(storeDB, sdk.CommitID{Version: height}, storetypes.PruneNothing)
+	if err != nil {
+		db.Close()
+		return nil, nil, fmt.Errorf("failed to load store %s at height %d: %v", storeName, height, err)
+	}
+
+	return store.(sdk.KVStore), db, nil
+}
+
+func parseHexBytes(s string) ([]byte, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "0x")
+	bz, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex string %s: %v", s, err)
+	}
+
+	return bz, nil
+}
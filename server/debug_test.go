@@ -0,0 +1,81 @@
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/cli"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDebugStoreCmds(t *testing.T) {
+	home, err := ioutil.TempDir("", "debug_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+
+	viper.Set(cli.HomeFlag, home)
+	defer viper.Reset()
+
+	// commit a store to the application database
+	db, err := openDB(home)
+	require.NoError(t, err)
+	key := sdk.NewKVStoreKey("store1")
+	cms := rootmulti.NewStore(db)
+	cms.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+	cms.GetKVStore(key).Set([]byte{0x01, 0x02}, []byte("value"))
+	cms.Commit()
+	require.NoError(t, db.Close())
+
+	var decoded []cmn.KVPair
+	decoders := sdk.StoreDecoderRegistry{
+		"store1": func(_ *codec.Codec, kvPair cmn.KVPair) string {
+			decoded = append(decoded, kvPair)
+			return string(kvPair.Value)
+		},
+	}
+
+	ctx := NewDefaultContext()
+	cdc := codec.New()
+	getCmd := debugStoreGetCmd(ctx, cdc, decoders)
+	iterateCmd := debugStoreIterateCmd(ctx, cdc, decoders)
+	viper.Set(flagStore, "store1")
+	viper.Set(flagHeight, 1)
+
+	// the database is closed by every command, so they can run in a row
+	expected := cmn.KVPair{Key: []byte{0x01, 0x02}, Value: []byte("value")}
+	require.NoError(t, getCmd.RunE(getCmd, []string{"0x0102"}))
+	require.NoError(t, getCmd.RunE(getCmd, []string{"0102"}))
+	require.Equal(t, []cmn.KVPair{expected, expected}, decoded)
+
+	require.Error(t, getCmd.RunE(getCmd, []string{"0x03"}))
+	require.Error(t, getCmd.RunE(getCmd, []string{"zz"}))
+
+	decoded = nil
+	viper.Set(flagPrefix, "0x01")
+	require.NoError(t, iterateCmd.RunE(iterateCmd, nil))
+	require.Equal(t, []cmn.KVPair{expected}, decoded)
+
+	// unknown height and missing store
+	viper.Set(flagHeight, 2)
+	require.Error(t, iterateCmd.RunE(iterateCmd, nil))
+	viper.Set(flagStore, "")
+	require.Error(t, iterateCmd.RunE(iterateCmd, nil))
+}
+
+func TestAddCommandsDebug(t *testing.T) {
+	rootCmd := &cobra.Command{}
+	AddCommands(NewDefaultContext(), codec.New(), rootCmd, nil, nil, nil)
+
+	cmd, _, err := rootCmd.Find([]string{"debug", "store", "get"})
+	require.NoError(t, err)
+	require.Equal(t, "get", cmd.Name())
+}
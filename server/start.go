@@ -2,11 +2,13 @@ package server
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/tendermint/tendermint/abci/server"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	tcmd "github.com/tendermint/tendermint/cmd/tendermint/commands"
 	cmn "github.com/tendermint/tendermint/libs/common"
@@ -14,16 +16,25 @@ import (
 	"github.com/tendermint/tendermint/p2p"
 	pvm "github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/proxy"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/cosmos/cosmos-sdk/server/webhooks"
+	"github.com/cosmos/cosmos-sdk/store"
 )
 
 // Tendermint full-node start flags
 const (
-	flagWithTendermint = "with-tendermint"
-	flagAddress        = "address"
-	flagTraceStore     = "trace-store"
-	flagPruning        = "pruning"
-	FlagMinGasPrices   = "minimum-gas-prices"
-	FlagHaltHeight     = "halt-height"
+	flagWithTendermint      = "with-tendermint"
+	flagAddress             = "address"
+	flagTraceStore          = "trace-store"
+	flagPruning             = "pruning"
+	FlagMinGasPrices        = "minimum-gas-prices"
+	FlagHaltHeight          = "halt-height"
+	FlagHaltTime            = "halt-time"
+	FlagInterBlockCacheSize = "inter-block-cache-size"
+	FlagPruningKeepRecent   = "pruning-keep-recent"
+	FlagPruningKeepEvery    = "pruning-keep-every"
+	FlagPruningInterval     = "pruning-interval"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -49,18 +60,53 @@ func StartCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
 	cmd.Flags().Bool(flagWithTendermint, true, "Run abci app embedded in-process with tendermint")
 	cmd.Flags().String(flagAddress, "tcp://0.0.0.0:26658", "Listen address")
 	cmd.Flags().String(flagTraceStore, "", "Enable KVStore tracing to an output file")
-	cmd.Flags().String(flagPruning, "syncable", "Pruning strategy: syncable, nothing, everything")
+	cmd.Flags().String(flagPruning, "default", "Pruning strategy: default (syncable), syncable, nothing, everything, custom")
+	cmd.Flags().Int64(FlagPruningKeepRecent, 0, "Number of recent states to keep with the custom pruning strategy")
+	cmd.Flags().Int64(FlagPruningKeepEvery, 0, "Interval of the states to keep forever with the custom pruning strategy, 0 to keep none")
+	cmd.Flags().Int64(FlagPruningInterval, 1, "Number of commits between the deletions of the old states")
 	cmd.Flags().String(
 		FlagMinGasPrices, "",
 		"Minimum gas prices to accept for transactions; Any fee in a tx must meet this minimum (e.g. 0.01photino;0.0001stake)",
 	)
 	cmd.Flags().Uint64(FlagHaltHeight, 0, "Height at which to gracefully halt the chain and shutdown the node")
+	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
+	cmd.Flags().Int(FlagInterBlockCacheSize, 0, "Number of values of each store cached in memory across blocks, 0 to disable")
 
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
 	return cmd
 }
 
+// GetPruningOptionsFromFlags returns the pruning options set by the pruning
+// flags of the start command.
+func GetPruningOptionsFromFlags() (store.PruningOptions, error) {
+	var opts store.PruningOptions
+
+	switch strategy := viper.GetString(flagPruning); strategy {
+	case "default", "syncable", "nothing", "everything":
+		opts = store.NewPruningOptionsFromString(strategy)
+
+	case "custom":
+		keepRecent, keepEvery := viper.GetInt64(FlagPruningKeepRecent), viper.GetInt64(FlagPruningKeepEvery)
+
+		// keeping no state at all must be asked for explicitly
+		if keepRecent == 0 && keepEvery == 0 {
+			return opts, fmt.Errorf(
+				"the custom pruning strategy requires --%s or --%s, use the everything strategy to keep only the latest state",
+				FlagPruningKeepRecent, FlagPruningKeepEvery,
+			)
+		}
+
+		opts = store.NewPruningOptions(keepRecent, keepEvery)
+
+	default:
+		return opts, fmt.Errorf("unknown pruning strategy %s", strategy)
+	}
+
+	opts = opts.WithInterval(viper.GetInt64(FlagPruningInterval))
+	return opts, opts.Validate()
+}
+
 func startStandAlone(ctx *Context, appCreator AppCreator) error {
 	addr := viper.GetString(flagAddress)
 	home := viper.GetString("home")
@@ -96,6 +142,8 @@ func startStandAlone(ctx *Context, appCreator AppCreator) error {
 		if err != nil {
 			cmn.Exit(err.Error())
 		}
+
+		closeApp(ctx, app)
 	})
 	return nil
 }
@@ -142,12 +190,58 @@ func startInProcess(ctx *Context, appCreator AppCreator) (*node.Node, error) {
 		return nil, err
 	}
 
+	webhooksSvc, err := startWebhooks(ctx, tmNode)
+	if err != nil {
+		return nil, err
+	}
+
 	TrapSignal(func() {
+		if webhooksSvc != nil && webhooksSvc.IsRunning() {
+			_ = webhooksSvc.Stop()
+		}
+
 		if tmNode.IsRunning() {
 			_ = tmNode.Stop()
 		}
+
+		closeApp(ctx, app)
 	})
 
 	// run forever (the node will not be returned)
 	select {}
 }
+
+// startWebhooks starts the webhooks service if any webhook is configured.
+func startWebhooks(ctx *Context, tmNode *node.Node) (*webhooks.Service, error) {
+	appConf, err := config.ParseConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(appConf.Webhooks) == 0 {
+		return nil, nil
+	}
+
+	svc, err := webhooks.NewService(ctx.Logger.With("module", "webhooks"), tmNode.EventBus(), appConf.Webhooks)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := svc.Start(); err != nil {
+		return nil, err
+	}
+
+	return svc, nil
+}
+
+// closeApp releases the application resources if the application supports it.
+func closeApp(ctx *Context, app abci.Application) {
+	closer, ok := app.(io.Closer)
+	if !ok {
+		return
+	}
+
+	if err := closer.Close(); err != nil {
+		ctx.Logger.Error("failed to close application", "err", err)
+	}
+}
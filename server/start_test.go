@@ -0,0 +1,41 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store"
+)
+
+func TestGetPruningOptionsFromFlags(t *testing.T) {
+	defer viper.Reset()
+
+	viper.Set(flagPruning, "nothing")
+	opts, err := GetPruningOptionsFromFlags()
+	require.NoError(t, err)
+	require.Equal(t, store.PruneNothing, opts)
+
+	viper.Set(flagPruning, "custom")
+	viper.Set(FlagPruningKeepRecent, 10)
+	viper.Set(FlagPruningKeepEvery, 100)
+	viper.Set(FlagPruningInterval, 5)
+	opts, err = GetPruningOptionsFromFlags()
+	require.NoError(t, err)
+	require.Equal(t, store.NewPruningOptions(10, 100).WithInterval(5), opts)
+
+	// the custom strategy must keep some states
+	viper.Set(FlagPruningKeepRecent, 0)
+	viper.Set(FlagPruningKeepEvery, 0)
+	_, err = GetPruningOptionsFromFlags()
+	require.Error(t, err)
+
+	viper.Set(FlagPruningKeepRecent, -1)
+	_, err = GetPruningOptionsFromFlags()
+	require.Error(t, err)
+
+	viper.Set(flagPruning, "unknown")
+	_, err = GetPruningOptionsFromFlags()
+	require.Error(t, err)
+}
@@ -0,0 +1,284 @@
+// Package webhooks implements an optional node service posting the events
+// matching operator defined queries to webhook URLs.
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/libs/log"
+	tmpubsub "github.com/tendermint/tendermint/libs/pubsub"
+	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+)
+
+const (
+	subscriber = "webhooks"
+
+	// capacity of the event buffer of each webhook subscription
+	eventBufferCapacity = 100
+
+	// capacity of the queue of events waiting to be posted to each webhook;
+	// events received while the queue is full are dropped
+	deliveryQueueCapacity = 1000
+
+	defaultTimeout = 10 * time.Second
+	defaultBackoff = time.Second
+)
+
+// Event is the payload posted to a webhook for every matching event.
+type Event struct {
+	Query  string            `json:"query"`
+	Height int64             `json:"height"`
+	Tags   map[string]string `json:"tags"`
+}
+
+// Webhook defines a URL the events matching a query are posted to.
+type Webhook struct {
+	URL        string
+	Query      tmpubsub.Query
+	MaxRetries uint
+}
+
+// NewWebhook returns a new webhook from its configuration, returning an error
+// if the URL is empty or the query is invalid.
+func NewWebhook(cfg config.WebhookConfig) (Webhook, error) {
+	if cfg.URL == "" {
+		return Webhook{}, fmt.Errorf("webhook URL cannot be empty")
+	}
+
+	query, err := tmquery.New(cfg.Query)
+	if err != nil {
+		return Webhook{}, fmt.Errorf("invalid webhook query %q: %v", cfg.Query, err)
+	}
+
+	return Webhook{
+		URL:        cfg.URL,
+		Query:      query,
+		MaxRetries: cfg.MaxRetries,
+	}, nil
+}
+
+// Service subscribes to the node events matching the webhook queries and
+// posts them to the webhook URLs, retrying failed posts with an exponential
+// backoff. Events are posted by a separate worker per webhook so that a slow
+// webhook never blocks the event bus.
+type Service struct {
+	cmn.BaseService
+
+	eventBus *tmtypes.EventBus
+	webhooks []Webhook
+	client   *http.Client
+	backoff  time.Duration
+
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewService returns a new webhooks service for the given configuration.
+func NewService(logger log.Logger, eventBus *tmtypes.EventBus, cfgs []config.WebhookConfig) (*Service, error) {
+	webhooks := make([]Webhook, len(cfgs))
+	for i, cfg := range cfgs {
+		webhook, err := NewWebhook(cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		webhooks[i] = webhook
+	}
+
+	svc := &Service{
+		eventBus: eventBus,
+		webhooks: webhooks,
+		client:   &http.Client{Timeout: defaultTimeout},
+		backoff:  defaultBackoff,
+	}
+	svc.BaseService = *cmn.NewBaseService(logger, "Webhooks", svc)
+
+	return svc, nil
+}
+
+// OnStart implements cmn.Service. It subscribes to the events of every
+// webhook and starts posting them.
+func (svc *Service) OnStart() error {
+	svc.ctx, svc.cancel = context.WithCancel(context.Background())
+
+	for i, webhook := range svc.webhooks {
+		sub, err := svc.eventBus.Subscribe(svc.ctx, subscriberName(i), webhook.Query, eventBufferCapacity)
+		if err != nil {
+			svc.cancel()
+			return fmt.Errorf("failed to subscribe to %s: %v", webhook.Query, err)
+		}
+
+		queue := make(chan Event, deliveryQueueCapacity)
+		go svc.deliver(webhook, queue)
+		go svc.run(i, webhook, sub, queue)
+	}
+
+	return nil
+}
+
+// OnStop implements cmn.Service. It cancels all the subscriptions.
+func (svc *Service) OnStop() {
+	svc.cancel()
+
+	for i := range svc.webhooks {
+		if err := svc.eventBus.UnsubscribeAll(context.Background(), subscriberName(i)); err != nil {
+			svc.Logger.Error("failed to unsubscribe", "subscriber", subscriberName(i), "err", err)
+		}
+	}
+}
+
+// subscriberName returns the event bus subscriber name of the i-th webhook.
+func subscriberName(i int) string {
+	return fmt.Sprintf("%s-%d", subscriber, i)
+}
+
+// run reads the events of a webhook subscription and queues them for
+// delivery, resubscribing whenever the subscription gets cancelled.
+func (svc *Service) run(i int, webhook Webhook, sub tmtypes.Subscription, queue chan<- Event) {
+	for {
+		select {
+		case msg := <-sub.Out():
+			event := Event{
+				Query:  webhook.Query.String(),
+				Height: eventHeight(msg.Data()),
+				Tags:   msg.Tags(),
+			}
+
+			select {
+			case queue <- event:
+			default:
+				svc.Logger.Error("webhook delivery queue full, dropping event", "url", webhook.URL, "height", event.Height)
+			}
+
+		case <-sub.Cancelled():
+			if svc.ctx.Err() != nil {
+				return
+			}
+
+			svc.Logger.Error("webhook subscription cancelled, resubscribing", "url", webhook.URL, "err", sub.Err())
+
+			sub = svc.resubscribe(i, webhook)
+			if sub == nil {
+				return
+			}
+
+		case <-svc.ctx.Done():
+			return
+		}
+	}
+}
+
+// resubscribe subscribes again to the events of the i-th webhook, retrying
+// with an exponential backoff until it succeeds or the service is stopped, in
+// which case nil is returned.
+func (svc *Service) resubscribe(i int, webhook Webhook) tmtypes.Subscription {
+	backoff := svc.backoff
+	for {
+		// the subscription may still be registered if it was not cancelled by
+		// the event bus itself
+		_ = svc.eventBus.Unsubscribe(context.Background(), subscriberName(i), webhook.Query)
+
+		sub, err := svc.eventBus.Subscribe(svc.ctx, subscriberName(i), webhook.Query, eventBufferCapacity)
+		if err == nil {
+			return sub
+		}
+
+		svc.Logger.Error("failed to resubscribe", "url", webhook.URL, "err", err)
+
+		select {
+		case <-time.After(backoff):
+			backoff *= 2
+		case <-svc.ctx.Done():
+			return nil
+		}
+	}
+}
+
+// deliver posts the queued events to a webhook until the service is stopped.
+func (svc *Service) deliver(webhook Webhook, queue <-chan Event) {
+	for {
+		select {
+		case event := <-queue:
+			if err := svc.post(webhook, event); err != nil {
+				svc.Logger.Error("failed to post event", "url", webhook.URL, "height", event.Height, "err", err)
+			}
+
+		case <-svc.ctx.Done():
+			return
+		}
+	}
+}
+
+// post posts an event to a webhook, retrying with an exponential backoff
+// until it succeeds, the maximum number of retries is reached or the service
+// is stopped.
+func (svc *Service) post(webhook Webhook, event Event) error {
+	bz, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	backoff := svc.backoff
+	for attempt := uint(0); ; attempt++ {
+		err = svc.postOnce(webhook.URL, bz)
+		if err == nil || attempt >= webhook.MaxRetries {
+			return err
+		}
+
+		svc.Logger.Debug("retrying event post", "url", webhook.URL, "attempt", attempt+1, "err", err)
+
+		select {
+		case <-time.After(backoff):
+			backoff *= 2
+		case <-svc.ctx.Done():
+			return svc.ctx.Err()
+		}
+	}
+}
+
+func (svc *Service) postOnce(url string, bz []byte) error {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(bz))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := svc.client.Do(req.WithContext(svc.ctx))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response status: %s", res.Status)
+	}
+
+	return nil
+}
+
+// eventHeight returns the height of the block an event belongs to.
+func eventHeight(data interface{}) int64 {
+	switch data := data.(type) {
+	case tmtypes.EventDataNewBlock:
+		return data.Block.Height
+
+	case tmtypes.EventDataNewBlockHeader:
+		return data.Header.Height
+
+	case tmtypes.EventDataTx:
+		return data.Height
+
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,110 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/server/config"
+)
+
+func TestNewWebhook(t *testing.T) {
+	_, err := NewWebhook(config.WebhookConfig{Query: "tm.event = 'Tx'"})
+	require.Error(t, err)
+
+	_, err = NewWebhook(config.WebhookConfig{URL: "http://localhost", Query: "tm.event = "})
+	require.Error(t, err)
+
+	webhook, err := NewWebhook(config.WebhookConfig{URL: "http://localhost", Query: "tm.event = 'Tx'", MaxRetries: 2})
+	require.NoError(t, err)
+	require.Equal(t, "tm.event = 'Tx'", webhook.Query.String())
+	require.Equal(t, uint(2), webhook.MaxRetries)
+}
+
+func TestPostRetries(t *testing.T) {
+	var received []Event
+	failures := 2
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failures > 0 {
+			failures--
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		var event Event
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		received = append(received, event)
+	}))
+	defer srv.Close()
+
+	cfgs := []config.WebhookConfig{{URL: srv.URL, Query: "tm.event = 'Tx'", MaxRetries: 1}}
+	svc, err := NewService(log.NewNopLogger(), tmtypes.NewEventBus(), cfgs)
+	require.NoError(t, err)
+
+	svc.backoff = time.Millisecond
+	svc.ctx, svc.cancel = context.WithCancel(context.Background())
+	defer svc.cancel()
+
+	event := Event{Query: "tm.event = 'Tx'", Height: 10, Tags: map[string]string{"action": "send"}}
+
+	// both attempts fail
+	require.Error(t, svc.post(svc.webhooks[0], event))
+	require.Empty(t, received)
+
+	// the first attempt succeeds
+	require.NoError(t, svc.post(svc.webhooks[0], event))
+	require.Equal(t, []Event{event}, received)
+}
+
+func TestResubscribe(t *testing.T) {
+	received := make(chan Event, eventBufferCapacity)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event Event
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&event))
+		received <- event
+	}))
+	defer srv.Close()
+
+	eventBus := tmtypes.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	defer eventBus.Stop()
+
+	cfgs := []config.WebhookConfig{{URL: srv.URL, Query: "tm.event = 'Tx'"}}
+	svc, err := NewService(log.NewNopLogger(), eventBus, cfgs)
+	require.NoError(t, err)
+
+	svc.backoff = time.Millisecond
+	require.NoError(t, svc.Start())
+	defer svc.Stop()
+
+	// publishes txs until one of them is posted to the webhook
+	waitForPost := func() {
+		for height := int64(1); height <= 100; height++ {
+			require.NoError(t, eventBus.PublishEventTx(tmtypes.EventDataTx{TxResult: tmtypes.TxResult{Height: height}}))
+
+			select {
+			case event := <-received:
+				require.True(t, event.Height <= height)
+				return
+			case <-time.After(10 * time.Millisecond):
+			}
+		}
+
+		t.Fatal("no event posted to the webhook")
+	}
+
+	waitForPost()
+
+	// cancel the subscription, the service must subscribe again
+	require.NoError(t, eventBus.UnsubscribeAll(context.Background(), subscriberName(0)))
+	waitForPost()
+}
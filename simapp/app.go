@@ -10,15 +10,24 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/genaccounts"
+	authsim "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/budget"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/gov"
+	govsim "github.com/cosmos/cosmos-sdk/x/gov/simulation"
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/performance"
+	"github.com/cosmos/cosmos-sdk/x/quarantine"
+	"github.com/cosmos/cosmos-sdk/x/sanction"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
+	slashingsim "github.com/cosmos/cosmos-sdk/x/slashing/simulation"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingsim "github.com/cosmos/cosmos-sdk/x/staking/simulation"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	cmn "github.com/tendermint/tendermint/libs/common"
@@ -39,6 +48,14 @@ var (
 	// non-dependant module elements, such as codec registration
 	// and genesis verification.
 	ModuleBasics sdk.ModuleBasicManager
+
+	// module accounts, their addresses can't receive coins from the send
+	// messages
+	moduleAccounts = []string{
+		auth.FeeCollectorName,
+		distr.ModuleName,
+		quarantine.EscrowName,
+	}
 )
 
 func init() {
@@ -53,6 +70,10 @@ func init() {
 		gov.AppModuleBasic{},
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
+		budget.AppModuleBasic{},
+		quarantine.AppModuleBasic{},
+		sanction.AppModuleBasic{},
+		upgrade.AppModuleBasic{},
 		slashing.AppModuleBasic{},
 	)
 }
@@ -66,6 +87,17 @@ func MakeCodec() *codec.Codec {
 	return cdc
 }
 
+// StoreDecoders returns the registry of the decoders used to print the
+// key/value pairs of the application stores in a human readable form.
+func StoreDecoders() sdk.StoreDecoderRegistry {
+	return sdk.StoreDecoderRegistry{
+		auth.StoreKey:     authsim.DecodeStore,
+		staking.StoreKey:  stakingsim.DecodeStore,
+		slashing.StoreKey: slashingsim.DecodeStore,
+		gov.StoreKey:      govsim.DecodeStore,
+	}
+}
+
 // Extended ABCI application
 type SimApp struct {
 	*bam.BaseApp
@@ -86,6 +118,10 @@ type SimApp struct {
 	keyFeeCollection *sdk.KVStoreKey
 	keyParams        *sdk.KVStoreKey
 	tkeyParams       *sdk.TransientStoreKey
+	keyBudget        *sdk.KVStoreKey
+	keyQuarantine    *sdk.KVStoreKey
+	keySanction      *sdk.KVStoreKey
+	keyUpgrade       *sdk.KVStoreKey
 
 	// keepers
 	accountKeeper       auth.AccountKeeper
@@ -98,6 +134,11 @@ type SimApp struct {
 	govKeeper           gov.Keeper
 	crisisKeeper        crisis.Keeper
 	paramsKeeper        params.Keeper
+	budgetKeeper        budget.Keeper
+	performanceKeeper   performance.Keeper
+	quarantineKeeper    quarantine.Keeper
+	sanctionKeeper      sanction.Keeper
+	upgradeKeeper       upgrade.Keeper
 
 	// the module manager
 	mm *sdk.ModuleManager
@@ -129,6 +170,10 @@ func NewSimApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bo
 		keyFeeCollection: sdk.NewKVStoreKey(auth.FeeStoreKey),
 		keyParams:        sdk.NewKVStoreKey(params.StoreKey),
 		tkeyParams:       sdk.NewTransientStoreKey(params.TStoreKey),
+		keyBudget:        sdk.NewKVStoreKey(budget.StoreKey),
+		keyQuarantine:    sdk.NewKVStoreKey(quarantine.StoreKey),
+		keySanction:      sdk.NewKVStoreKey(sanction.StoreKey),
+		keyUpgrade:       sdk.NewKVStoreKey(upgrade.StoreKey),
 	}
 
 	// init params keeper and subspaces
@@ -141,10 +186,21 @@ func NewSimApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bo
 	slashingSubspace := app.paramsKeeper.Subspace(slashing.DefaultParamspace)
 	govSubspace := app.paramsKeeper.Subspace(gov.DefaultParamspace)
 	crisisSubspace := app.paramsKeeper.Subspace(crisis.DefaultParamspace)
+	sanctionSubspace := app.paramsKeeper.Subspace(sanction.DefaultParamspace)
 
 	// add keepers
-	app.accountKeeper = auth.NewAccountKeeper(app.cdc, app.keyAccount, authSubspace, auth.ProtoBaseAccount)
-	app.bankKeeper = bank.NewBaseKeeper(app.accountKeeper, bankSubspace, bank.DefaultCodespace)
+	app.accountKeeper = auth.NewAccountKeeper(app.cdc, app.keyAccount, authSubspace, auth.ProtoBaseAccount).
+		WithModuleAccounts(moduleAccounts...)
+	bankKeeper := bank.NewBaseKeeper(app.accountKeeper, bankSubspace, bank.DefaultCodespace).
+		WithBlockedAddrs(app.accountKeeper.ModuleAccountAddrs())
+	app.quarantineKeeper = quarantine.NewKeeper(app.cdc, app.keyQuarantine, bankKeeper, quarantine.DefaultCodespace)
+	app.sanctionKeeper = sanction.NewKeeper(app.cdc, app.keySanction, sanctionSubspace, sanction.DefaultCodespace)
+
+	// NOTE: the quarantine keeper above uses the bank keeper without the send
+	// restrictions so that it can release the quarantined funds
+	app.bankKeeper = bankKeeper.
+		WithSendRestriction(app.sanctionKeeper.SendRestriction).
+		WithSendRestriction(app.quarantineKeeper.SendRestriction)
 	app.feeCollectionKeeper = auth.NewFeeCollectionKeeper(app.cdc, app.keyFeeCollection)
 	stakingKeeper := staking.NewKeeper(app.cdc, app.keyStaking, app.tkeyStaking, app.bankKeeper,
 		stakingSubspace, staking.DefaultCodespace)
@@ -155,18 +211,30 @@ func NewSimApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bo
 		slashingSubspace, slashing.DefaultCodespace)
 	app.crisisKeeper = crisis.NewKeeper(crisisSubspace, invCheckPeriod, app.distrKeeper,
 		app.bankKeeper, app.feeCollectionKeeper)
+	app.budgetKeeper = budget.NewKeeper(app.cdc, app.keyBudget, app.distrKeeper, budget.DefaultCodespace)
+	app.upgradeKeeper = upgrade.NewKeeper(app.cdc, app.keyUpgrade, upgrade.DefaultCodespace)
 
 	// register the proposal types
 	govRouter := gov.NewRouter()
 	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
-		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper))
-	app.govKeeper = gov.NewKeeper(app.cdc, app.keyGov, app.paramsKeeper, govSubspace,
+		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
+		AddRoute(budget.RouterKey, budget.NewBudgetProposalHandler(app.budgetKeeper)).
+		AddRoute(sanction.RouterKey, sanction.NewSanctionProposalHandler(app.sanctionKeeper)).
+		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper))
+	govKeeper := gov.NewKeeper(app.cdc, app.keyGov, app.paramsKeeper, govSubspace,
 		app.bankKeeper, &stakingKeeper, gov.DefaultCodespace, govRouter)
 
+	// register the governance hooks
+	app.govKeeper = *govKeeper.SetHooks(app.sanctionKeeper.Hooks())
+
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	app.stakingKeeper = *stakingKeeper.SetHooks(
-		staking.NewMultiStakingHooks(app.distrKeeper.Hooks(), app.slashingKeeper.Hooks()))
+		staking.NewMultiStakingHooks(app.distrKeeper.Hooks(), app.slashingKeeper.Hooks())).
+		SetRedelegationRestriction(app.slashingKeeper.RedelegationRestriction)
+
+	app.performanceKeeper = performance.NewKeeper(app.cdc, app.stakingKeeper, app.slashingKeeper,
+		app.distrKeeper, app.govKeeper)
 
 	app.mm = sdk.NewModuleManager(
 		genaccounts.NewAppModule(app.accountKeeper),
@@ -177,14 +245,22 @@ func NewSimApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bo
 		distr.NewAppModule(app.distrKeeper),
 		gov.NewAppModule(app.govKeeper),
 		mint.NewAppModule(app.mintKeeper),
+		params.NewAppModule(app.paramsKeeper),
 		slashing.NewAppModule(app.slashingKeeper, app.stakingKeeper),
 		staking.NewAppModule(app.stakingKeeper, app.feeCollectionKeeper, app.distrKeeper, app.accountKeeper),
+		budget.NewAppModule(app.budgetKeeper),
+		quarantine.NewAppModule(app.quarantineKeeper),
+		sanction.NewAppModule(app.sanctionKeeper),
+		upgrade.NewAppModule(app.upgradeKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
 	// there is nothing left over in the validator fee pool, so as to keep the
 	// CanWithdrawInvariant invariant.
-	app.mm.SetOrderBeginBlockers(mint.ModuleName, distr.ModuleName, slashing.ModuleName)
+	// Scheduled parameter changes are applied first so that they are in effect
+	// for the whole block, right after any upgrade due at the block height.
+	app.mm.SetOrderBeginBlockers(upgrade.ModuleName, params.ModuleName, mint.ModuleName, distr.ModuleName,
+		slashing.ModuleName)
 
 	app.mm.SetOrderEndBlockers(gov.ModuleName, staking.ModuleName)
 
@@ -192,21 +268,26 @@ func NewSimApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest bo
 	// initialized with tokens from genesis accounts.
 	app.mm.SetOrderInitGenesis(genaccounts.ModuleName, distr.ModuleName,
 		staking.ModuleName, auth.ModuleName, bank.ModuleName, slashing.ModuleName,
-		gov.ModuleName, mint.ModuleName, crisis.ModuleName, genutil.ModuleName)
+		gov.ModuleName, mint.ModuleName, params.ModuleName, budget.ModuleName, quarantine.ModuleName, sanction.ModuleName,
+		upgrade.ModuleName, crisis.ModuleName, genutil.ModuleName)
 
 	app.mm.RegisterInvariants(&app.crisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
+	app.QueryRouter().AddRoute(sdk.ModulesQueryRoute, app.mm.NewDescriptorQuerier())
+	app.QueryRouter().AddRoute(performance.QuerierRoute, performance.NewQuerier(app.performanceKeeper))
 
 	// initialize stores
 	app.MountStores(app.keyMain, app.keyAccount, app.keyStaking, app.keyMint,
 		app.keyDistr, app.keySlashing, app.keyGov, app.keyFeeCollection,
-		app.keyParams, app.tkeyParams, app.tkeyStaking, app.tkeyDistr)
+		app.keyParams, app.keyBudget, app.keyQuarantine, app.keySanction, app.keyUpgrade, app.tkeyParams, app.tkeyStaking,
+		app.tkeyDistr)
 
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper, app.feeCollectionKeeper, auth.DefaultSigVerificationGasConsumer))
 	app.SetEndBlocker(app.EndBlocker)
+	app.AddCloser(app.mm)
 
 	if loadLatest {
 		err := app.LoadLatestVersion(app.keyMain)
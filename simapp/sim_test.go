@@ -176,6 +176,9 @@ func appStateRandomizedFn(r *rand.Rand, accs []simulation.Account, genesisTimest
 			simulation.ModuleParamSimulator["TxSizeCostPerByte"](r).(uint64),
 			simulation.ModuleParamSimulator["SigVerifyCostED25519"](r).(uint64),
 			simulation.ModuleParamSimulator["SigVerifyCostSecp256k1"](r).(uint64),
+			simulation.ModuleParamSimulator["MaxMsgsPerTx"](r).(uint64),
+			simulation.ModuleParamSimulator["MaxTxBytes"](r).(uint64),
+			[]string{},
 		),
 	)
 	fmt.Printf("Selected randomly generated auth parameters:\n\t%+v\n", authGenesis)
@@ -210,6 +213,7 @@ func appStateRandomizedFn(r *rand.Rand, accs []simulation.Account, genesisTimest
 			simulation.ModuleParamSimulator["MaxValidators"](r).(uint16),
 			7,
 			sdk.DefaultBondDenom,
+			false,
 		),
 		nil,
 		nil,
@@ -224,7 +228,7 @@ func appStateRandomizedFn(r *rand.Rand, accs []simulation.Account, genesisTimest
 		simulation.ModuleParamSimulator["SlashFractionDoubleSign"](r).(sdk.Dec),
 		simulation.ModuleParamSimulator["SlashFractionDowntime"](r).(sdk.Dec),
 	)
-	slashingGenesis := slashing.NewGenesisState(slashingParams, nil, nil)
+	slashingGenesis := slashing.NewGenesisState(slashingParams, nil, nil, nil)
 	genesisState[slashing.ModuleName] = cdc.MustMarshalJSON(slashingGenesis)
 	fmt.Printf("Selected randomly generated slashing parameters:\n\t%+v\n", slashingGenesis)
 
@@ -308,7 +312,7 @@ func testAndRunTxs(app *SimApp) []simulation.WeightedOperation {
 		{100, stakingsim.SimulateMsgDelegate(app.accountKeeper, app.stakingKeeper)},
 		{100, stakingsim.SimulateMsgUndelegate(app.accountKeeper, app.stakingKeeper)},
 		{100, stakingsim.SimulateMsgBeginRedelegate(app.accountKeeper, app.stakingKeeper)},
-		{100, slashingsim.SimulateMsgUnjail(app.slashingKeeper)},
+		{100, slashingsim.SimulateMsgUnjail(app.slashingKeeper, app.stakingKeeper)},
 	}
 }
 
@@ -480,8 +484,8 @@ func TestAppImportExport(t *testing.T) {
 		kvA, kvB, count, equal := sdk.DiffKVStores(storeA, storeB, prefixes)
 		fmt.Printf("Compared %d key/value pairs between %s and %s\n", count, storeKeyA, storeKeyB)
 		require.True(t, equal,
-			"unequal stores: %s / %s:\nstore A %X => %X\nstore B %X => %X",
-			storeKeyA, storeKeyB, kvA.Key, kvA.Value, kvB.Key, kvB.Value,
+			"unequal stores: %s / %s:\n%s",
+			storeKeyA, storeKeyB, simulation.GetSimulationLog(storeKeyA.Name(), StoreDecoders(), app.cdc, kvA, kvB),
 		)
 	}
 
@@ -0,0 +1,201 @@
+package cache
+
+import (
+	"container/list"
+	"io"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/errors"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var (
+	_ types.CommitKVStore             = (*CommitKVStoreCache)(nil)
+	_ types.Queryable                 = (*CommitKVStoreCache)(nil)
+	_ types.MultiStorePersistentCache = (*CommitKVStoreCacheManager)(nil)
+)
+
+type (
+	// CommitKVStoreCache implements an inter-block, write-through cache over a
+	// CommitKVStore, keeping the most recently used values, including the
+	// absent ones, in memory across blocks. Writes go through to the parent
+	// store right away, so the cached values never have to be invalidated
+	// on Commit. Iterators are served by the parent store.
+	CommitKVStoreCache struct {
+		types.CommitKVStore
+
+		mtx     sync.Mutex
+		size    int
+		entries map[string]*list.Element
+		lru     *list.List
+	}
+
+	// CommitKVStoreCacheManager maintains the inter-block caches of the
+	// stores of a multi-store, all of the same size.
+	CommitKVStoreCacheManager struct {
+		mtx    sync.Mutex
+		size   int
+		caches map[string]*CommitKVStoreCache
+	}
+
+	cacheEntry struct {
+		key   string
+		value []byte
+	}
+)
+
+// NewCommitKVStoreCache returns a cache of at most size values over the given
+// store
+func NewCommitKVStoreCache(store types.CommitKVStore, size int) *CommitKVStoreCache {
+	if size <= 0 {
+		panic("inter-block cache size must be positive")
+	}
+
+	return &CommitKVStoreCache{
+		CommitKVStore: store,
+		size:          size,
+		entries:       make(map[string]*list.Element),
+		lru:           list.New(),
+	}
+}
+
+// NewCommitKVStoreCacheManager returns a manager of inter-block caches of at
+// most size values each
+func NewCommitKVStoreCacheManager(size int) *CommitKVStoreCacheManager {
+	if size <= 0 {
+		panic("inter-block cache size must be positive")
+	}
+
+	return &CommitKVStoreCacheManager{
+		size:   size,
+		caches: make(map[string]*CommitKVStoreCache),
+	}
+}
+
+// GetStoreCache returns the cache over the store with the given key, creating
+// it if needed. A new cache is created whenever the store is reloaded.
+func (cmgr *CommitKVStoreCacheManager) GetStoreCache(key types.StoreKey, store types.CommitKVStore) types.CommitKVStore {
+	cmgr.mtx.Lock()
+	defer cmgr.mtx.Unlock()
+
+	c, ok := cmgr.caches[key.Name()]
+	if !ok || c.CommitKVStore != store {
+		c = NewCommitKVStoreCache(store, cmgr.size)
+		cmgr.caches[key.Name()] = c
+	}
+	return c
+}
+
+// Unwrap returns the store underlying the cache of the store with the given
+// key, or nil if there is no such cache
+func (cmgr *CommitKVStoreCacheManager) Unwrap(key types.StoreKey) types.CommitKVStore {
+	cmgr.mtx.Lock()
+	defer cmgr.mtx.Unlock()
+
+	if c, ok := cmgr.caches[key.Name()]; ok {
+		return c.CommitKVStore
+	}
+	return nil
+}
+
+// Reset drops all the caches, eg. when the multi-store loads another version
+func (cmgr *CommitKVStoreCacheManager) Reset() {
+	cmgr.mtx.Lock()
+	defer cmgr.mtx.Unlock()
+
+	cmgr.caches = make(map[string]*CommitKVStoreCache)
+}
+
+// Get returns the value of the key, from the cache if present or else from
+// the parent store, caching it.
+func (ckv *CommitKVStoreCache) Get(key []byte) []byte {
+	types.AssertValidKey(key)
+
+	ckv.mtx.Lock()
+	defer ckv.mtx.Unlock()
+
+	if elem, ok := ckv.entries[string(key)]; ok {
+		ckv.lru.MoveToFront(elem)
+		return elem.Value.(*cacheEntry).value
+	}
+
+	value := ckv.CommitKVStore.Get(key)
+	ckv.add(string(key), value)
+	return value
+}
+
+// Has returns true if the key has a value.
+func (ckv *CommitKVStoreCache) Has(key []byte) bool {
+	return ckv.Get(key) != nil
+}
+
+// Set sets the value of the key in the parent store and in the cache.
+func (ckv *CommitKVStoreCache) Set(key, value []byte) {
+	types.AssertValidKey(key)
+	types.AssertValidValue(value)
+
+	ckv.mtx.Lock()
+	defer ckv.mtx.Unlock()
+
+	ckv.CommitKVStore.Set(key, value)
+	ckv.add(string(key), value)
+}
+
+// Delete deletes the key from the parent store and caches its absence.
+func (ckv *CommitKVStoreCache) Delete(key []byte) {
+	ckv.mtx.Lock()
+	defer ckv.mtx.Unlock()
+
+	ckv.CommitKVStore.Delete(key)
+	ckv.add(string(key), nil)
+}
+
+// CacheWrap implements the CacheWrapper interface, wrapping the cache rather
+// than the parent store.
+func (ckv *CommitKVStoreCache) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(ckv)
+}
+
+// CacheWrapWithTrace implements the CacheWrapper interface.
+func (ckv *CommitKVStoreCache) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(ckv, w, tc))
+}
+
+// Query implements the Queryable interface, queries are served by the parent
+// store as they may target past versions.
+func (ckv *CommitKVStoreCache) Query(req abci.RequestQuery) abci.ResponseQuery {
+	queryable, ok := ckv.CommitKVStore.(types.Queryable)
+	if !ok {
+		return errors.ErrUnknownRequest("store doesn't support queries").QueryResult()
+	}
+	return queryable.Query(req)
+}
+
+// Len returns the number of cached values.
+func (ckv *CommitKVStoreCache) Len() int {
+	ckv.mtx.Lock()
+	defer ckv.mtx.Unlock()
+
+	return ckv.lru.Len()
+}
+
+// add caches the value of the key, evicting the least recently used value if
+// the cache is full. It must be called with the lock held.
+func (ckv *CommitKVStoreCache) add(key string, value []byte) {
+	if elem, ok := ckv.entries[key]; ok {
+		elem.Value.(*cacheEntry).value = value
+		ckv.lru.MoveToFront(elem)
+		return
+	}
+
+	ckv.entries[key] = ckv.lru.PushFront(&cacheEntry{key: key, value: value})
+	if ckv.lru.Len() > ckv.size {
+		oldest := ckv.lru.Back()
+		ckv.lru.Remove(oldest)
+		delete(ckv.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
@@ -0,0 +1,104 @@
+package cache
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func newParent(t testing.TB) types.CommitKVStore {
+	store, err := iavl.LoadStore(dbm.NewMemDB(), types.CommitID{}, types.PruneNothing)
+	require.NoError(t, err)
+	return store.(types.CommitKVStore)
+}
+
+func TestCommitKVStoreCacheWriteThrough(t *testing.T) {
+	parent := newParent(t)
+	store := NewCommitKVStoreCache(parent, 10)
+
+	key, value := []byte("key"), []byte("value")
+	require.Nil(t, store.Get(key))
+	require.False(t, store.Has(key))
+	require.Equal(t, 1, store.Len())
+
+	store.Set(key, value)
+	require.Equal(t, value, store.Get(key))
+	require.Equal(t, value, parent.Get(key))
+
+	cstore := store.CacheWrap().(types.KVStore)
+	cstore.Set(key, []byte("other"))
+	require.Equal(t, value, store.Get(key))
+	cstore.(types.CacheKVStore).Write()
+	require.Equal(t, []byte("other"), store.Get(key))
+	require.Equal(t, []byte("other"), parent.Get(key))
+
+	store.Delete(key)
+	require.Nil(t, store.Get(key))
+	require.Nil(t, parent.Get(key))
+
+	// committing does not invalidate the cached values
+	store.Set(key, value)
+	store.Commit()
+	require.Equal(t, value, store.Get(key))
+}
+
+func TestCommitKVStoreCacheEviction(t *testing.T) {
+	store := NewCommitKVStoreCache(newParent(t), 2)
+
+	for i := 0; i < 5; i++ {
+		store.Set([]byte(fmt.Sprintf("key%d", i)), []byte("value"))
+	}
+	require.Equal(t, 2, store.Len())
+
+	// evicted values are still read from the parent store
+	require.Equal(t, []byte("value"), store.Get([]byte("key0")))
+	require.Equal(t, 2, store.Len())
+}
+
+func TestCommitKVStoreCacheManager(t *testing.T) {
+	require.Panics(t, func() { NewCommitKVStoreCacheManager(0) })
+
+	mngr := NewCommitKVStoreCacheManager(10)
+	key := types.NewKVStoreKey("store")
+	parent := newParent(t)
+
+	require.Nil(t, mngr.Unwrap(key))
+	store := mngr.GetStoreCache(key, parent)
+	require.Equal(t, store, mngr.GetStoreCache(key, parent))
+	require.Equal(t, parent, mngr.Unwrap(key))
+
+	// a reloaded store gets a new cache
+	other := newParent(t)
+	require.NotEqual(t, store, mngr.GetStoreCache(key, other))
+	require.Equal(t, other, mngr.Unwrap(key))
+
+	mngr.Reset()
+	require.Nil(t, mngr.Unwrap(key))
+}
+
+func benchmarkGet(b *testing.B, store types.KVStore) {
+	keys := make([][]byte, 1000)
+	for i := range keys {
+		keys[i] = []byte(fmt.Sprintf("key%d", i))
+		store.Set(keys[i], []byte("value"))
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		store.Get(keys[i%len(keys)])
+	}
+}
+
+func BenchmarkIAVLStoreGet(b *testing.B) {
+	benchmarkGet(b, newParent(b))
+}
+
+func BenchmarkCommitKVStoreCacheGet(b *testing.B) {
+	benchmarkGet(b, NewCommitKVStoreCache(newParent(b), 1000))
+}
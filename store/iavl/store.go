@@ -55,6 +55,17 @@ type Store struct {
 	// By default this value should be set the same across all nodes,
 	// so that nodes can know the waypoints their peers store.
 	storeEvery int64
+
+	// Every how many commits the released versions are deleted. The versions
+	// waiting for deletion are recomputed from the tree when the pruning
+	// options are set, so that none is left behind if the node stops before.
+	// A value of 0 or 1 means delete them on every commit.
+	pruneInterval int64
+	pruneVersions []int64
+
+	// Whether the store is a read-only view of a past version of the tree,
+	// see GetImmutable.
+	immutable bool
 }
 
 // CONTRACT: tree should be fully loaded.
@@ -68,8 +79,29 @@ func UnsafeNewStore(tree *iavl.MutableTree, numRecent int64, storeEvery int64) *
 	return st
 }
 
+// GetImmutable returns a read-only store of the given version of the tree,
+// sharing the nodes of the live tree instead of loading the version anew. It is
+// meant for queries at past heights, any write panics.
+func (st *Store) GetImmutable(version int64) (*Store, error) {
+	if !st.VersionExists(version) {
+		return nil, fmt.Errorf("version %d does not exist", version)
+	}
+
+	tree, err := st.tree.GetImmutable(version)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{
+		tree:      &iavl.MutableTree{ImmutableTree: tree},
+		immutable: true,
+	}, nil
+}
+
 // Implements Committer.
 func (st *Store) Commit() types.CommitID {
+	st.assertMutable()
+
 	// Save a new version.
 	hash, version, err := st.tree.SaveVersion()
 	if err != nil {
@@ -82,13 +114,14 @@ func (st *Store) Commit() types.CommitID {
 	if st.numRecent < previous {
 		toRelease := previous - st.numRecent
 		if st.storeEvery == 0 || toRelease%st.storeEvery != 0 {
-			err := st.tree.DeleteVersion(toRelease)
-			if err != nil && err.(cmn.Error).Data() != iavl.ErrVersionDoesNotExist {
-				panic(err)
-			}
+			st.pruneVersions = append(st.pruneVersions, toRelease)
 		}
 	}
 
+	if st.pruneInterval <= 1 || version%st.pruneInterval == 0 {
+		st.deleteVersions()
+	}
+
 	return types.CommitID{
 		Version: version,
 		Hash:    hash,
@@ -107,6 +140,34 @@ func (st *Store) LastCommitID() types.CommitID {
 func (st *Store) SetPruning(opt types.PruningOptions) {
 	st.numRecent = opt.KeepRecent()
 	st.storeEvery = opt.KeepEvery()
+	st.pruneInterval = opt.Interval()
+	st.pruneVersions = st.releasedVersions()
+}
+
+// releasedVersions returns the versions of the tree which are released by the
+// pruning options but have not been deleted yet.
+func (st *Store) releasedVersions() []int64 {
+	var versions []int64
+	for version := int64(1); version < st.tree.Version()-st.numRecent; version++ {
+		if st.storeEvery != 0 && version%st.storeEvery == 0 {
+			continue
+		}
+		if st.tree.VersionExists(version) {
+			versions = append(versions, version)
+		}
+	}
+	return versions
+}
+
+// deleteVersions deletes the released versions.
+func (st *Store) deleteVersions() {
+	for _, version := range st.pruneVersions {
+		err := st.tree.DeleteVersion(version)
+		if err != nil && err.(cmn.Error).Data() != iavl.ErrVersionDoesNotExist {
+			panic(err)
+		}
+	}
+	st.pruneVersions = nil
 }
 
 // VersionExists returns whether or not a given version is stored.
@@ -129,8 +190,16 @@ func (st *Store) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.Ca
 	return cachekv.NewStore(tracekv.NewStore(st, w, tc))
 }
 
+// assertMutable panics if the store is a read-only view of a past version.
+func (st *Store) assertMutable() {
+	if st.immutable {
+		panic("cannot write to an immutable IAVL store")
+	}
+}
+
 // Implements types.KVStore.
 func (st *Store) Set(key, value []byte) {
+	st.assertMutable()
 	types.AssertValidValue(value)
 	st.tree.Set(key, value)
 }
@@ -148,6 +217,7 @@ func (st *Store) Has(key []byte) (exists bool) {
 
 // Implements types.KVStore.
 func (st *Store) Delete(key []byte) {
+	st.assertMutable()
 	st.tree.Remove(key)
 }
 
@@ -381,6 +381,83 @@ func testPruning(t *testing.T, numRecent int64, storeEvery int64, states []prune
 	}
 }
 
+func TestIAVLPruningInterval(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree := iavl.NewMutableTree(db, cacheSize)
+	iavlStore := UnsafeNewStore(tree, int64(0), int64(0))
+	iavlStore.SetPruning(types.NewPruningOptions(2, 0).WithInterval(3))
+
+	// the released versions are kept until the interval is reached
+	for i := 0; i < 5; i++ {
+		nextVersion(iavlStore)
+	}
+	for ver := int64(1); ver <= 5; ver++ {
+		require.True(t, iavlStore.VersionExists(ver), "missing version %d", ver)
+	}
+
+	nextVersion(iavlStore)
+	for ver := int64(1); ver <= 3; ver++ {
+		require.False(t, iavlStore.VersionExists(ver), "unpruned version %d", ver)
+	}
+	for ver := int64(4); ver <= 6; ver++ {
+		require.True(t, iavlStore.VersionExists(ver), "missing version %d", ver)
+	}
+}
+
+func TestIAVLPruningIntervalRestart(t *testing.T) {
+	db := dbm.NewMemDB()
+	opts := types.NewPruningOptions(2, 0).WithInterval(3)
+
+	tree := iavl.NewMutableTree(db, cacheSize)
+	iavlStore := UnsafeNewStore(tree, int64(0), int64(0))
+	iavlStore.SetPruning(opts)
+	for i := 0; i < 5; i++ {
+		nextVersion(iavlStore)
+	}
+
+	// the versions released before the restart are deleted at the next interval
+	store, err := LoadStore(db, iavlStore.LastCommitID(), opts)
+	require.NoError(t, err)
+	iavlStore = store.(*Store)
+	require.Equal(t, []int64{1, 2}, iavlStore.pruneVersions)
+
+	nextVersion(iavlStore)
+	for ver := int64(1); ver <= 3; ver++ {
+		require.False(t, iavlStore.VersionExists(ver), "unpruned version %d", ver)
+	}
+	for ver := int64(4); ver <= 6; ver++ {
+		require.True(t, iavlStore.VersionExists(ver), "missing version %d", ver)
+	}
+}
+
+func TestIAVLGetImmutable(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree := iavl.NewMutableTree(db, cacheSize)
+	iavlStore := UnsafeNewStore(tree, int64(0), int64(0))
+
+	k, v := []byte("key"), []byte("value")
+	iavlStore.Set(k, v)
+	cid := iavlStore.Commit()
+
+	iavlStore.Set(k, []byte("updated"))
+	iavlStore.Commit()
+
+	_, err := iavlStore.GetImmutable(cid.Version + 2)
+	require.Error(t, err)
+
+	// the past version is read without affecting the live tree
+	immutable, err := iavlStore.GetImmutable(cid.Version)
+	require.NoError(t, err)
+	require.Equal(t, v, immutable.Get(k))
+	require.Equal(t, cid, immutable.LastCommitID())
+	require.Equal(t, []byte("updated"), iavlStore.Get(k))
+
+	// and is read-only
+	require.Panics(t, func() { immutable.Set(k, v) })
+	require.Panics(t, func() { immutable.Delete(k) })
+	require.Panics(t, func() { immutable.Commit() })
+}
+
 func TestIAVLNoPrune(t *testing.T) {
 	db := dbm.NewMemDB()
 	tree := iavl.NewMutableTree(db, cacheSize)
@@ -0,0 +1,66 @@
+package listenkv_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+type write struct {
+	storeKey types.StoreKey
+	key      string
+	value    string
+	delete   bool
+}
+
+type recordingListener struct {
+	writes []write
+}
+
+func (rl *recordingListener) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) {
+	rl.writes = append(rl.writes, write{storeKey, string(key), string(value), delete})
+}
+
+func newListenKVStore(storeKey types.StoreKey, listener *recordingListener) *listenkv.Store {
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	return listenkv.NewStore(parent, storeKey, []types.WriteListener{listener})
+}
+
+func TestListenKVStoreWrites(t *testing.T) {
+	storeKey := types.NewKVStoreKey("store")
+	listener := &recordingListener{}
+	store := newListenKVStore(storeKey, listener)
+
+	store.Set([]byte("key1"), []byte("value1"))
+	store.Delete([]byte("key1"))
+	require.Nil(t, store.Get([]byte("key1")))
+
+	// reads are not passed on
+	store.Has([]byte("key2"))
+
+	require.Equal(t, []write{
+		{storeKey, "key1", "value1", false},
+		{storeKey, "key1", "", true},
+	}, listener.writes)
+}
+
+func TestListenKVStoreCacheWrap(t *testing.T) {
+	storeKey := types.NewKVStoreKey("store")
+	listener := &recordingListener{}
+	store := newListenKVStore(storeKey, listener)
+
+	cache := store.CacheWrap().(types.CacheKVStore)
+	cache.Set([]byte("key1"), []byte("value1"))
+	require.Empty(t, listener.writes)
+
+	// the writes of the cache are passed on when it is written
+	cache.Write()
+	require.Equal(t, []write{{storeKey, "key1", "value1", false}}, listener.writes)
+	require.Equal(t, []byte("value1"), store.Get([]byte("key1")))
+}
@@ -30,6 +30,9 @@ type (
 	Gas              = stypes.Gas
 	GasMeter         = types.GasMeter
 	GasConfig        = stypes.GasConfig
+
+	MultiStorePersistentCache = types.MultiStorePersistentCache
+	WriteListener             = types.WriteListener
 )
 
 // nolint - reexport
@@ -37,4 +40,5 @@ var (
 	PruneNothing    = types.PruneNothing
 	PruneEverything = types.PruneEverything
 	PruneSyncable   = types.PruneSyncable
+	PruneDefault    = types.PruneDefault
 )
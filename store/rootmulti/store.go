@@ -10,10 +10,12 @@ import (
 	"github.com/tendermint/tendermint/crypto/tmhash"
 	dbm "github.com/tendermint/tendermint/libs/db"
 
+	"github.com/cosmos/cosmos-sdk/store/cache"
 	"github.com/cosmos/cosmos-sdk/store/cachemulti"
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
 	"github.com/cosmos/cosmos-sdk/store/errors"
 	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/listenkv"
 	"github.com/cosmos/cosmos-sdk/store/tracekv"
 	"github.com/cosmos/cosmos-sdk/store/transient"
 	"github.com/cosmos/cosmos-sdk/store/types"
@@ -34,6 +36,10 @@ type Store struct {
 	storesParams map[types.StoreKey]storeParams
 	stores       map[types.StoreKey]types.CommitStore
 	keysByName   map[string]types.StoreKey
+	usage        *storeUsage
+
+	interBlockCache types.MultiStorePersistentCache
+	listeners       map[types.StoreKey][]types.WriteListener
 
 	traceWriter  io.Writer
 	traceContext types.TraceContext
@@ -49,6 +55,8 @@ func NewStore(db dbm.DB) *Store {
 		storesParams: make(map[types.StoreKey]storeParams),
 		stores:       make(map[types.StoreKey]types.CommitStore),
 		keysByName:   make(map[string]types.StoreKey),
+		usage:        newStoreUsage(),
+		listeners:    make(map[types.StoreKey][]types.WriteListener),
 	}
 }
 
@@ -60,6 +68,24 @@ func (rs *Store) SetPruning(pruningOpts types.PruningOptions) {
 	}
 }
 
+// Implements CommitMultiStore.
+func (rs *Store) SetInterBlockCache(c types.MultiStorePersistentCache) {
+	rs.interBlockCache = c
+}
+
+// AddListeners adds listeners of the writes to the store with the given key.
+// The writes of the cache multi-stores reach the listeners when the caches are
+// written, eg. the writes of a block on commit.
+func (rs *Store) AddListeners(key types.StoreKey, listeners []types.WriteListener) {
+	rs.listeners[key] = append(rs.listeners[key], listeners...)
+}
+
+// ListeningEnabled returns whether the writes to the store with the given key
+// are listened to.
+func (rs *Store) ListeningEnabled(key types.StoreKey) bool {
+	return len(rs.listeners[key]) != 0
+}
+
 // Implements Store.
 func (rs *Store) GetStoreType() types.StoreType {
 	return types.StoreTypeMulti
@@ -102,6 +128,10 @@ func (rs *Store) LoadLatestVersion() error {
 
 // Implements CommitMultiStore.
 func (rs *Store) LoadVersion(ver int64) error {
+	// the cached values of another version are stale
+	if rs.interBlockCache != nil {
+		rs.interBlockCache.Reset()
+	}
 
 	// Special logic for version 0
 	if ver == 0 {
@@ -115,6 +145,7 @@ func (rs *Store) LoadVersion(ver int64) error {
 		}
 
 		rs.lastCommitID = types.CommitID{}
+		rs.loadUsage()
 		return nil
 	}
 	// Otherwise, version is 1 or greater
@@ -150,6 +181,7 @@ func (rs *Store) LoadVersion(ver int64) error {
 	// Success.
 	rs.lastCommitID = cInfo.CommitID()
 	rs.stores = newStores
+	rs.loadUsage()
 	return nil
 }
 
@@ -200,6 +232,7 @@ func (rs *Store) Commit() types.CommitID {
 	batch := rs.db.NewBatch()
 	setCommitInfo(batch, version, commitInfo)
 	setLatestVersion(batch, version)
+	rs.usage.commit(batch)
 	batch.Write()
 
 	// Prepare for next version.
@@ -229,10 +262,48 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 	stores := make(map[types.StoreKey]types.CacheWrapper)
 	for k, v := range rs.stores {
 		stores[k] = v
+		if kv, ok := v.(types.KVStore); ok {
+			stores[k] = rs.wrapListeners(k, rs.wrapUsage(k, kv))
+		}
 	}
 	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.traceContext)
 }
 
+// Implements CommitMultiStore.
+// The IAVL stores are read-only views of the version sharing the nodes of the
+// live trees, writing the returned store to them panics.
+func (rs *Store) CacheMultiStoreWithVersion(ver int64) (types.CacheMultiStore, error) {
+	if ver <= 0 || ver > rs.lastCommitID.Version {
+		return nil, fmt.Errorf("version %d does not exist, latest version is %d", ver, rs.lastCommitID.Version)
+	}
+
+	stores := make(map[types.StoreKey]types.CacheWrapper)
+	for k, v := range rs.stores {
+		stores[k] = v
+		if v.GetStoreType() != types.StoreTypeIAVL {
+			continue
+		}
+
+		// the inter-block cache only holds the latest version
+		var store types.CommitStore = v
+		if c, ok := store.(*cache.CommitKVStoreCache); ok {
+			store = c.CommitKVStore
+		}
+
+		iavlStore, ok := store.(*iavl.Store)
+		if !ok {
+			return nil, fmt.Errorf("unexpected IAVL store type %T for store %s", store, k.Name())
+		}
+
+		immutable, err := iavlStore.GetImmutable(ver)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load store %s at version %d: %v", k.Name(), ver, err)
+		}
+		stores[k] = immutable
+	}
+	return cachemulti.NewStore(rs.db, stores, rs.keysByName, rs.traceWriter, rs.traceContext), nil
+}
+
 // Implements MultiStore.
 // If the store does not exist, panics.
 func (rs *Store) GetStore(key types.StoreKey) types.Store {
@@ -248,7 +319,7 @@ func (rs *Store) GetStore(key types.StoreKey) types.Store {
 // tracer, otherwise, the original KVStore will be returned.
 // If the store does not exist, panics.
 func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
-	store := rs.stores[key].(types.KVStore)
+	store := rs.wrapListeners(key, rs.wrapUsage(key, rs.stores[key].(types.KVStore)))
 
 	if rs.TracingEnabled() {
 		store = tracekv.NewStore(store, rs.traceWriter, rs.traceContext)
@@ -346,13 +417,25 @@ func parsePath(path string) (storeName string, subpath string, err errors.Error)
 
 //----------------------------------------
 
-func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (store types.CommitStore, err error) {
-	var db dbm.DB
+// wrapListeners wraps the store to pass its writes on to its listeners, if
+// any.
+func (rs *Store) wrapListeners(key types.StoreKey, store types.KVStore) types.KVStore {
+	if !rs.ListeningEnabled(key) {
+		return store
+	}
+	return listenkv.NewStore(store, key, rs.listeners[key])
+}
+
+// storeDB returns the database of a mounted store.
+func (rs *Store) storeDB(params storeParams) dbm.DB {
 	if params.db != nil {
-		db = dbm.NewPrefixDB(params.db, []byte("s/_/"))
-	} else {
-		db = dbm.NewPrefixDB(rs.db, []byte("s/k:"+params.key.Name()+"/"))
+		return dbm.NewPrefixDB(params.db, []byte("s/_/"))
 	}
+	return dbm.NewPrefixDB(rs.db, StorePrefix(params.key.Name()))
+}
+
+func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (store types.CommitStore, err error) {
+	db := rs.storeDB(params)
 	switch params.typ {
 	case types.StoreTypeMulti:
 		panic("recursive MultiStores not yet supported")
@@ -360,6 +443,10 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 		// return NewCommitMultiStore(db, id)
 	case types.StoreTypeIAVL:
 		store, err = iavl.LoadStore(db, id, rs.pruningOpts)
+		if err == nil && rs.interBlockCache != nil {
+			// wrap the store with the inter-block cache
+			store = rs.interBlockCache.GetStoreCache(key, store.(types.CommitKVStore))
+		}
 		return
 	case types.StoreTypeDB:
 		store = commitDBStoreAdapter{dbadapter.Store{db}}
@@ -377,6 +464,12 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 	}
 }
 
+// StorePrefix returns the prefix under which the substore with the given name
+// is persisted in the database of the root multi-store.
+func StorePrefix(name string) []byte {
+	return []byte("s/k:" + name + "/")
+}
+
 func (rs *Store) nameToKey(name string) types.StoreKey {
 	for key := range rs.storesParams {
 		if key.Name() == name {
@@ -100,6 +100,38 @@ func TestMultistoreCommitLoad(t *testing.T) {
 	checkStore(t, store, commitID, commitID)
 }
 
+func TestCacheMultiStoreWithVersion(t *testing.T) {
+	db := dbm.NewMemDB()
+	multi := newMultiStoreWithMounts(db)
+	err := multi.LoadLatestVersion()
+	require.Nil(t, err)
+
+	_, err = multi.CacheMultiStoreWithVersion(1)
+	require.Error(t, err)
+
+	k, v := []byte("wind"), []byte("blows")
+	store1 := multi.getStoreByName("store1").(types.KVStore)
+	store1.Set(k, v)
+	cid := multi.Commit()
+
+	store1.Set(k, []byte("calms"))
+	multi.Commit()
+
+	// the old version is read, leaving the live store untouched
+	cms, err := multi.CacheMultiStoreWithVersion(cid.Version)
+	require.NoError(t, err)
+	kvStore := cms.GetKVStore(multi.keysByName["store1"])
+	require.Equal(t, v, kvStore.Get(k))
+
+	// the old version is read-only
+	kvStore.Set(k, []byte("storms"))
+	require.Panics(t, cms.Write)
+	require.Equal(t, []byte("calms"), store1.Get(k))
+
+	_, err = multi.CacheMultiStoreWithVersion(cid.Version + 2)
+	require.Error(t, err)
+}
+
 func TestParsePath(t *testing.T) {
 	_, _, err := parsePath("foo")
 	require.Error(t, err)
@@ -0,0 +1,173 @@
+package rootmulti
+
+import (
+	"io"
+	"sync"
+
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+const usageKeyPrefix = "s/usage/" // s/usage/<store name>
+
+// storeUsage holds the approximate number of bytes used by the keys and
+// values of every persisted substore. The size changes of the writes flushed
+// to a substore are kept pending and added to its usage on commit.
+type storeUsage struct {
+	mtx     sync.RWMutex
+	enabled bool
+	usage   map[string]int64
+	pending map[string]int64
+}
+
+func newStoreUsage() *storeUsage {
+	return &storeUsage{
+		usage:   make(map[string]int64),
+		pending: make(map[string]int64),
+	}
+}
+
+// load reads the usage of the given stores persisted by the last commit.
+func (su *storeUsage) load(db dbm.DB, names []string) {
+	su.mtx.Lock()
+	defer su.mtx.Unlock()
+
+	su.usage = make(map[string]int64, len(names))
+	su.pending = make(map[string]int64)
+	for _, name := range names {
+		bz := db.Get([]byte(usageKeyPrefix + name))
+		if bz == nil {
+			continue
+		}
+
+		var usage int64
+		cdc.MustUnmarshalBinaryLengthPrefixed(bz, &usage)
+		su.usage[name] = usage
+	}
+}
+
+// add records a pending size change of the named store.
+func (su *storeUsage) add(name string, delta int64) {
+	su.mtx.Lock()
+	su.pending[name] += delta
+	su.mtx.Unlock()
+}
+
+// commit adds the pending size changes to the usage and writes the updated
+// usage to the batch.
+func (su *storeUsage) commit(batch dbm.Batch) {
+	su.mtx.Lock()
+	defer su.mtx.Unlock()
+
+	for name, delta := range su.pending {
+		if delta == 0 {
+			continue
+		}
+
+		su.usage[name] += delta
+		batch.Set([]byte(usageKeyPrefix+name), cdc.MustMarshalBinaryLengthPrefixed(su.usage[name]))
+	}
+	su.pending = make(map[string]int64)
+}
+
+// copy returns a copy of the committed usage.
+func (su *storeUsage) copy() map[string]int64 {
+	su.mtx.RLock()
+	defer su.mtx.RUnlock()
+
+	usage := make(map[string]int64, len(su.usage))
+	for name, bytes := range su.usage {
+		usage[name] = bytes
+	}
+	return usage
+}
+
+// EnableUsageTracking enables the accounting of the approximate number of
+// bytes used by every persisted substore, see StoreUsage. The usage is only
+// tracked from the moment it is enabled on, it does not include the data
+// already stored nor the overhead of the underlying store.
+func (rs *Store) EnableUsageTracking() {
+	rs.usage.mtx.Lock()
+	rs.usage.enabled = true
+	rs.usage.mtx.Unlock()
+}
+
+// StoreUsage returns the approximate number of bytes used by the keys and
+// values of every persisted substore as of the last commit, by store name.
+func (rs *Store) StoreUsage() map[string]int64 {
+	return rs.usage.copy()
+}
+
+// loadUsage loads the usage of the persisted substores.
+func (rs *Store) loadUsage() {
+	names := make([]string, 0, len(rs.stores))
+	for key, store := range rs.stores {
+		if store.GetStoreType() != types.StoreTypeTransient {
+			names = append(names, key.Name())
+		}
+	}
+	rs.usage.load(rs.db, names)
+}
+
+func (rs *Store) usageTrackingEnabled() bool {
+	rs.usage.mtx.RLock()
+	defer rs.usage.mtx.RUnlock()
+	return rs.usage.enabled
+}
+
+// wrapUsage wraps the store to track its usage if usage tracking is enabled
+// and the store is persisted.
+func (rs *Store) wrapUsage(key types.StoreKey, store types.KVStore) types.KVStore {
+	if !rs.usageTrackingEnabled() || store.GetStoreType() == types.StoreTypeTransient {
+		return store
+	}
+	return &usageStore{KVStore: store, name: key.Name(), usage: rs.usage}
+}
+
+//----------------------------------------
+// usageStore
+
+// usageStore records the size changes of the writes to the KVStore it wraps.
+// Overwriting or deleting a key reads its previous value to account for it.
+type usageStore struct {
+	types.KVStore
+	name  string
+	usage *storeUsage
+}
+
+var _ types.KVStore = (*usageStore)(nil)
+
+// Implements KVStore.
+func (us *usageStore) Set(key, value []byte) {
+	delta := int64(len(value))
+	if prev := us.KVStore.Get(key); prev != nil {
+		delta -= int64(len(prev))
+	} else {
+		delta += int64(len(key))
+	}
+
+	us.KVStore.Set(key, value)
+	us.usage.add(us.name, delta)
+}
+
+// Implements KVStore.
+func (us *usageStore) Delete(key []byte) {
+	prev := us.KVStore.Get(key)
+	us.KVStore.Delete(key)
+	if prev != nil {
+		us.usage.add(us.name, -int64(len(key)+len(prev)))
+	}
+}
+
+// Implements CacheWrapper.
+func (us *usageStore) CacheWrap() types.CacheWrap {
+	return cachekv.NewStore(us)
+}
+
+// CacheWrapWithTrace implements the CacheWrapper interface.
+func (us *usageStore) CacheWrapWithTrace(w io.Writer, tc types.TraceContext) types.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(us, w, tc))
+}
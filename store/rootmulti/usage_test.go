@@ -0,0 +1,44 @@
+package rootmulti
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+func TestStoreUsage(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newMultiStoreWithMounts(db)
+	store.EnableUsageTracking()
+	require.Nil(t, store.LoadLatestVersion())
+	key1, key2 := store.nameToKey("store1"), store.nameToKey("store2")
+
+	// writes are accounted once flushed to the committed stores
+	cms := store.CacheMultiStore()
+	cms.GetKVStore(key1).Set([]byte("k1"), []byte("value"))
+	cms.GetKVStore(key2).Set([]byte("k2"), []byte("v"))
+	require.Empty(t, store.StoreUsage())
+	cms.Write()
+	require.Empty(t, store.StoreUsage())
+	store.Commit()
+	require.Equal(t, map[string]int64{"store1": 7, "store2": 3}, store.StoreUsage())
+
+	// overwrites account the size difference, deletes free the key and value
+	cms = store.CacheMultiStore()
+	cms.GetKVStore(key1).Set([]byte("k1"), []byte("v"))
+	cms.GetKVStore(key2).Delete([]byte("k2"))
+	cms.GetKVStore(key2).Delete([]byte("missing"))
+	cms.Write()
+	store.Commit()
+	require.Equal(t, map[string]int64{"store1": 3, "store2": 0}, store.StoreUsage())
+
+	// direct writes are accounted as well
+	store.GetKVStore(key2).Set([]byte("k3"), []byte("v"))
+	store.Commit()
+
+	// the usage is persisted with the commit
+	store = newMultiStoreWithMounts(db)
+	require.Nil(t, store.LoadLatestVersion())
+	require.Equal(t, map[string]int64{"store1": 3, "store2": 3}, store.StoreUsage())
+}
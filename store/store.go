@@ -20,7 +20,7 @@ func NewPruningOptionsFromString(strategy string) (opt PruningOptions) {
 	case "syncable":
 		opt = PruneSyncable
 	default:
-		opt = PruneSyncable
+		opt = PruneDefault
 	}
 	return
 }
@@ -0,0 +1,147 @@
+// Package file implements a streaming service writing the blocks and their
+// store writes to files, one file per block.
+package file
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// entry types
+const (
+	EntryBeginBlock = "begin_block"
+	EntryDeliverTx  = "deliver_tx"
+	EntryEndBlock   = "end_block"
+	EntryWrite      = "write"
+	EntryCommit     = "commit"
+)
+
+// Entry is a line of a block file. ABCI entries hold a request and its
+// response, write entries a write to a listened store.
+type Entry struct {
+	Type     string      `json:"type"`
+	Request  interface{} `json:"request,omitempty"`
+	Response interface{} `json:"response,omitempty"`
+	StoreKey string      `json:"store_key,omitempty"`
+	Key      []byte      `json:"key,omitempty"`
+	Value    []byte      `json:"value,omitempty"`
+	Delete   bool        `json:"delete,omitempty"`
+}
+
+// StreamingService writes every block to a file named block-<height> in its
+// directory as JSON encoded entries, one per line: the BeginBlock, DeliverTx
+// and EndBlock requests and responses, the writes of the block to the
+// listened stores and finally the Commit response. A block file is only
+// created once the block is committed, so that its readers never see a
+// partial block.
+type StreamingService struct {
+	mtx    sync.Mutex
+	dir    string
+	keys   []sdk.StoreKey
+	height int64
+	buf    bytes.Buffer
+	err    error
+}
+
+// NewStreamingService returns a streaming service writing the blocks to the
+// given directory, creating it if needed, and listening to the stores with
+// the given keys.
+func NewStreamingService(dir string, keys ...sdk.StoreKey) (*StreamingService, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+
+	return &StreamingService{dir: dir, keys: keys}, nil
+}
+
+// Listeners implements the baseapp.StreamingService interface.
+func (ss *StreamingService) Listeners() map[sdk.StoreKey][]sdk.WriteListener {
+	listeners := make(map[sdk.StoreKey][]sdk.WriteListener, len(ss.keys))
+	for _, key := range ss.keys {
+		listeners[key] = []sdk.WriteListener{ss}
+	}
+	return listeners
+}
+
+// ListenBeginBlock implements the baseapp.StreamingService interface. It
+// starts a new block.
+func (ss *StreamingService) ListenBeginBlock(_ sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	ss.mtx.Lock()
+	defer ss.mtx.Unlock()
+
+	ss.height = req.Header.Height
+	ss.buf.Reset()
+	ss.err = nil
+	return ss.write(Entry{Type: EntryBeginBlock, Request: req, Response: res})
+}
+
+// ListenDeliverTx implements the baseapp.StreamingService interface.
+func (ss *StreamingService) ListenDeliverTx(_ sdk.Context, txBytes []byte, res abci.ResponseDeliverTx) error {
+	ss.mtx.Lock()
+	defer ss.mtx.Unlock()
+
+	return ss.write(Entry{Type: EntryDeliverTx, Request: txBytes, Response: res})
+}
+
+// ListenEndBlock implements the baseapp.StreamingService interface.
+func (ss *StreamingService) ListenEndBlock(_ sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	ss.mtx.Lock()
+	defer ss.mtx.Unlock()
+
+	return ss.write(Entry{Type: EntryEndBlock, Request: req, Response: res})
+}
+
+// OnWrite implements the sdk.WriteListener interface. The errors are reported
+// on commit.
+func (ss *StreamingService) OnWrite(storeKey sdk.StoreKey, key []byte, value []byte, delete bool) {
+	ss.mtx.Lock()
+	defer ss.mtx.Unlock()
+
+	err := ss.write(Entry{Type: EntryWrite, StoreKey: storeKey.Name(), Key: key, Value: value, Delete: delete})
+	if err != nil && ss.err == nil {
+		ss.err = err
+	}
+}
+
+// ListenCommit implements the baseapp.StreamingService interface. It writes
+// the file of the block.
+func (ss *StreamingService) ListenCommit(res abci.ResponseCommit) error {
+	ss.mtx.Lock()
+	defer ss.mtx.Unlock()
+
+	defer ss.buf.Reset()
+	if ss.err != nil {
+		return fmt.Errorf("failed to stream the writes of block %d: %v", ss.height, ss.err)
+	}
+	if err := ss.write(Entry{Type: EntryCommit, Response: res}); err != nil {
+		return err
+	}
+
+	// write to a temporary file first so that the block file is never partial
+	path := filepath.Join(ss.dir, fmt.Sprintf("block-%d", ss.height))
+	if err := ioutil.WriteFile(path+".tmp", ss.buf.Bytes(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+// write appends an entry to the current block.
+func (ss *StreamingService) write(entry Entry) error {
+	bz, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	ss.buf.Write(bz)
+	ss.buf.WriteByte('\n')
+	return nil
+}
@@ -0,0 +1,142 @@
+package file_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	key1 = types.NewKVStoreKey("store1")
+	key2 = types.NewKVStoreKey("store2")
+)
+
+// newStreamingService returns a streaming service listening to the first of
+// the two stores of the returned multistore
+func newStreamingService(t *testing.T, dir string) (*file.StreamingService, *rootmulti.Store) {
+	service, err := file.NewStreamingService(dir, key1)
+	require.NoError(t, err)
+
+	cms := rootmulti.NewStore(dbm.NewMemDB())
+	cms.MountStoreWithDB(key1, types.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(key2, types.StoreTypeIAVL, nil)
+	for key, listeners := range service.Listeners() {
+		cms.AddListeners(key, listeners)
+	}
+	require.NoError(t, cms.LoadLatestVersion())
+
+	return service, cms
+}
+
+// streamBlock streams a block setting and deleting a key in both stores and
+// returns the commit hash
+func streamBlock(t *testing.T, dir string, service *file.StreamingService, cms *rootmulti.Store,
+	height int64, set, del string) []byte {
+
+	req := abci.RequestBeginBlock{Header: abci.Header{Height: height}}
+	require.NoError(t, service.ListenBeginBlock(sdk.Context{}, req, abci.ResponseBeginBlock{}))
+
+	cache := cms.CacheMultiStore()
+	for _, key := range []types.StoreKey{key1, key2} {
+		store := cache.GetKVStore(key)
+		store.Set([]byte(set), []byte("value"))
+		store.Delete([]byte(del))
+	}
+	require.NoError(t, service.ListenDeliverTx(sdk.Context{}, []byte("tx"), abci.ResponseDeliverTx{}))
+	require.NoError(t, service.ListenEndBlock(sdk.Context{}, abci.RequestEndBlock{Height: height}, abci.ResponseEndBlock{}))
+
+	// the writes of the block reach the listeners when the cache is written
+	cache.Write()
+	commitID := cms.Commit()
+
+	// the file of the block is only created on commit
+	_, err := os.Stat(blockFile(dir, height))
+	require.True(t, os.IsNotExist(err))
+
+	require.NoError(t, service.ListenCommit(abci.ResponseCommit{Data: commitID.Hash}))
+	return commitID.Hash
+}
+
+func blockFile(dir string, height int64) string {
+	return filepath.Join(dir, fmt.Sprintf("block-%d", height))
+}
+
+func readBlock(t *testing.T, dir string, height int64) []file.Entry {
+	bz, err := ioutil.ReadFile(blockFile(dir, height))
+	require.NoError(t, err)
+
+	var entries []file.Entry
+	for _, line := range strings.Split(strings.TrimSpace(string(bz)), "\n") {
+		var entry file.Entry
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestStreamingService(t *testing.T) {
+	dir, err := ioutil.TempDir("", "streaming")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	service, cms := newStreamingService(t, dir)
+
+	hash1 := streamBlock(t, dir, service, cms, 1, "key1", "key0")
+	hash2 := streamBlock(t, dir, service, cms, 2, "key2", "key1")
+
+	// every block is written to its own file, without the writes of the
+	// unlistened store and of the other block
+	for _, tc := range []struct {
+		height   int64
+		set, del string
+		hash     []byte
+	}{
+		{1, "key1", "key0", hash1},
+		{2, "key2", "key1", hash2},
+	} {
+		entries := readBlock(t, dir, tc.height)
+		require.Len(t, entries, 6, "height %d", tc.height)
+
+		var entryTypes []string
+		for _, entry := range entries {
+			entryTypes = append(entryTypes, entry.Type)
+		}
+		require.Equal(t, []string{
+			file.EntryBeginBlock, file.EntryDeliverTx, file.EntryEndBlock,
+			file.EntryWrite, file.EntryWrite, file.EntryCommit,
+		}, entryTypes, "height %d", tc.height)
+
+		// the cache writes the keys in order
+		require.Equal(t, file.Entry{
+			Type: file.EntryWrite, StoreKey: key1.Name(), Key: []byte(tc.del), Delete: true,
+		}, entries[3], "height %d", tc.height)
+		require.Equal(t, file.Entry{
+			Type: file.EntryWrite, StoreKey: key1.Name(), Key: []byte(tc.set), Value: []byte("value"),
+		}, entries[4], "height %d", tc.height)
+
+		// the commit entry holds the commit response
+		res, ok := entries[5].Response.(map[string]interface{})
+		require.True(t, ok, "height %d", tc.height)
+		require.Equal(t, base64.StdEncoding.EncodeToString(tc.hash), res["data"], "height %d", tc.height)
+	}
+
+	// no temporary file is left behind
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+}
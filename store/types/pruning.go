@@ -1,10 +1,14 @@
 package types
 
+import "fmt"
+
 // PruningStrategy specifies how old states will be deleted over time where
-// keepRecent can be used with keepEvery to create a pruning "strategy".
+// keepRecent can be used with keepEvery to create a pruning "strategy". The
+// old states are deleted in batches every interval commits.
 type PruningOptions struct {
 	keepRecent int64
 	keepEvery  int64
+	interval   int64
 }
 
 func NewPruningOptions(keepRecent, keepEvery int64) PruningOptions {
@@ -14,6 +18,13 @@ func NewPruningOptions(keepRecent, keepEvery int64) PruningOptions {
 	}
 }
 
+// WithInterval returns a copy of the options deleting the old states every
+// interval commits. An interval of 0 or 1 deletes them on every commit.
+func (po PruningOptions) WithInterval(interval int64) PruningOptions {
+	po.interval = interval
+	return po
+}
+
 // How much recent state will be kept. Older state will be deleted.
 func (po PruningOptions) KeepRecent() int64 {
 	return po.keepRecent
@@ -24,6 +35,24 @@ func (po PruningOptions) KeepEvery() int64 {
 	return po.keepEvery
 }
 
+// Every how many commits the old states are deleted.
+func (po PruningOptions) Interval() int64 {
+	return po.interval
+}
+
+// Validate returns an error if the options are not consistent.
+func (po PruningOptions) Validate() error {
+	switch {
+	case po.keepRecent < 0:
+		return fmt.Errorf("invalid number of recent states to keep: %d", po.keepRecent)
+	case po.keepEvery < 0:
+		return fmt.Errorf("invalid interval of the states to keep: %d", po.keepEvery)
+	case po.interval < 0:
+		return fmt.Errorf("invalid pruning interval: %d", po.interval)
+	}
+	return nil
+}
+
 // default pruning strategies
 var (
 	// PruneEverything means all saved states will be deleted, storing only the current state
@@ -32,4 +61,6 @@ var (
 	PruneNothing = NewPruningOptions(0, 1)
 	// PruneSyncable means only those states not needed for state syncing will be deleted (keeps last 100 + every 10000th)
 	PruneSyncable = NewPruningOptions(100, 10000)
+	// PruneDefault is the default strategy, PruneSyncable
+	PruneDefault = PruneSyncable
 )
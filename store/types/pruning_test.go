@@ -0,0 +1,15 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruningOptionsValidate(t *testing.T) {
+	require.NoError(t, PruneDefault.Validate())
+	require.NoError(t, NewPruningOptions(10, 0).WithInterval(10).Validate())
+	require.Error(t, NewPruningOptions(-1, 0).Validate())
+	require.Error(t, NewPruningOptions(10, -1).Validate())
+	require.Error(t, NewPruningOptions(10, 0).WithInterval(-1).Validate())
+}
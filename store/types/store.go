@@ -95,6 +95,36 @@ type CommitMultiStore interface {
 	// the next commit after loading must be idempotent (return the
 	// same commit id).  Otherwise the behavior is undefined.
 	LoadVersion(ver int64) error
+
+	// Cache wrap a read-only view of the stores at a persisted version,
+	// eg. to serve queries at a past height.
+	CacheMultiStoreWithVersion(ver int64) (CacheMultiStore, error)
+
+	// Set an inter-block cache for the IAVL stores, to be used when
+	// the stores are loaded.
+	SetInterBlockCache(MultiStorePersistentCache)
+}
+
+// MultiStorePersistentCache defines an inter-block cache of the
+// CommitKVStores of a multi-store, kept across blocks.
+type MultiStorePersistentCache interface {
+	// Wrap and return the provided CommitKVStore with an inter-block
+	// cache.
+	GetStoreCache(key StoreKey, store CommitKVStore) CommitKVStore
+
+	// Return the underlying CommitKVStore of the cache of a StoreKey.
+	Unwrap(key StoreKey) CommitKVStore
+
+	// Reset the entire set of inter-block caches.
+	Reset()
+}
+
+// WriteListener is notified of the writes to a listened KVStore. Listeners
+// must handle their own errors, a write is never rejected by them.
+type WriteListener interface {
+	// OnWrite is called with the key and value of every Set, and with the
+	// key and a nil value of every Delete.
+	OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool)
 }
 
 //---------subsp-------------------------------
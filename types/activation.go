@@ -0,0 +1,31 @@
+package types
+
+import (
+	"time"
+)
+
+// ActivationSchedule defines the block height and time from which a message
+// route is active and, optionally, from which it is deprecated. Zero values
+// are ignored. Messages for a route that is not active are rejected, or
+// dispatched to the Fallback route if one is set.
+type ActivationSchedule struct {
+	StartHeight int64     // route is active from this height on
+	StartTime   time.Time // route is active from this block time on
+	EndHeight   int64     // route is deprecated from this height on
+	EndTime     time.Time // route is deprecated from this block time on
+	Fallback    string    // route handling the messages while not active
+}
+
+// IsActive returns true if the route is active at the height and block time
+// of the given context.
+func (s ActivationSchedule) IsActive(ctx Context) bool {
+	height, blockTime := ctx.BlockHeight(), ctx.BlockHeader().Time
+
+	if height < s.StartHeight || (!s.StartTime.IsZero() && blockTime.Before(s.StartTime)) {
+		return false
+	}
+	if (s.EndHeight > 0 && height >= s.EndHeight) || (!s.EndTime.IsZero() && !blockTime.Before(s.EndTime)) {
+		return false
+	}
+	return true
+}
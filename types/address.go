@@ -635,3 +635,85 @@ func GetFromBech32(bech32str, prefix string) ([]byte, error) {
 
 	return bz, nil
 }
+
+// ----------------------------------------------------------------------------
+// address codec
+// ----------------------------------------------------------------------------
+
+// AddressCodec defines the conversion of addresses between their raw bytes and
+// their string representation, so that chains not using Bech32 addresses can
+// reuse the modules unmodified.
+type AddressCodec interface {
+	StringToBytes(text string) ([]byte, error)
+	BytesToString(bz []byte) (string, error)
+}
+
+var (
+	_ AddressCodec = Bech32Codec{}
+	_ AddressCodec = HexCodec{}
+)
+
+// Bech32Codec encodes addresses using Bech32 with a given human readable
+// prefix.
+type Bech32Codec struct {
+	Bech32Prefix string
+}
+
+// NewBech32Codec returns a new Bech32Codec for a given prefix.
+func NewBech32Codec(prefix string) Bech32Codec {
+	return Bech32Codec{Bech32Prefix: prefix}
+}
+
+// StringToBytes implements the AddressCodec interface.
+func (bc Bech32Codec) StringToBytes(text string) ([]byte, error) {
+	bz, err := GetFromBech32(strings.TrimSpace(text), bc.Bech32Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := VerifyAddressFormat(bz); err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// BytesToString implements the AddressCodec interface.
+func (bc Bech32Codec) BytesToString(bz []byte) (string, error) {
+	if len(bz) == 0 {
+		return "", nil
+	}
+
+	return bech32.ConvertAndEncode(bc.Bech32Prefix, bz)
+}
+
+// HexCodec encodes addresses as 0x prefixed hex strings.
+type HexCodec struct{}
+
+// StringToBytes implements the AddressCodec interface.
+func (HexCodec) StringToBytes(text string) ([]byte, error) {
+	text = strings.TrimPrefix(strings.TrimSpace(text), "0x")
+	if len(text) == 0 {
+		return nil, errors.New("decoding hex address failed: must provide an address")
+	}
+
+	bz, err := hex.DecodeString(text)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := VerifyAddressFormat(bz); err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// BytesToString implements the AddressCodec interface.
+func (HexCodec) BytesToString(bz []byte) (string, error) {
+	if len(bz) == 0 {
+		return "", nil
+	}
+
+	return "0x" + hex.EncodeToString(bz), nil
+}
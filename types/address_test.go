@@ -292,6 +292,52 @@ func TestAddressInterface(t *testing.T) {
 
 }
 
+func TestAddressCodecs(t *testing.T) {
+	var pub ed25519.PubKeyEd25519
+
+	codecs := []types.AddressCodec{
+		types.NewBech32Codec(types.GetConfig().GetBech32AccountAddrPrefix()),
+		types.HexCodec{},
+	}
+
+	for i := 0; i < 100; i++ {
+		rand.Read(pub[:])
+		acc := types.AccAddress(pub.Address())
+
+		for _, cdc := range codecs {
+			str, err := cdc.BytesToString(acc)
+			require.Nil(t, err)
+
+			bz, err := cdc.StringToBytes(str)
+			require.Nil(t, err)
+			require.Equal(t, acc, types.AccAddress(bz))
+		}
+	}
+
+	acc := types.AccAddress(pub.Address())
+	str, err := codecs[0].BytesToString(acc)
+	require.Nil(t, err)
+	require.Equal(t, acc.String(), str)
+
+	str, err = codecs[1].BytesToString(acc)
+	require.Nil(t, err)
+	require.Equal(t, "0x"+hex.EncodeToString(acc), str)
+
+	for _, cdc := range codecs {
+		str, err := cdc.BytesToString(nil)
+		require.Nil(t, err)
+		require.Empty(t, str)
+
+		_, err = cdc.StringToBytes("")
+		require.NotNil(t, err)
+
+		for _, str := range invalidStrs {
+			_, err := cdc.StringToBytes(str)
+			require.NotNil(t, err)
+		}
+	}
+}
+
 func TestCustomAddressVerifier(t *testing.T) {
 	// Create a 10 byte address
 	addr := []byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
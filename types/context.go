@@ -241,6 +241,26 @@ func (c Context) CacheContext() (cc Context, writeCache func()) {
 	return cc, cms.Write
 }
 
+// RunAtomically executes fn against a cache-wrapped branch of the context's
+// multi-store and writes the branch to the underlying store only if fn
+// succeeds, so that multiple keeper calls composed by fn are applied
+// all-or-nothing.
+//
+// The tags returned by fn are buffered together with the state changes: they
+// are returned only if fn succeeds and are discarded with the branch
+// otherwise. If fn panics, nothing is written.
+func RunAtomically(ctx Context, fn func(ctx Context) (Tags, Error)) (Tags, Error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	tags, err := fn(cacheCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	writeCache()
+	return tags, nil
+}
+
 //----------------------------------------
 // thePast
 
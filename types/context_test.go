@@ -89,6 +89,46 @@ func TestCacheContext(t *testing.T) {
 	require.Equal(t, v2, store.Get(k2))
 }
 
+func TestRunAtomically(t *testing.T) {
+	key := types.NewKVStoreKey(t.Name())
+	k1 := []byte("hello")
+	v1 := []byte("world")
+	k2 := []byte("key")
+	v2 := []byte("value")
+
+	ctx := defaultContext(key)
+	store := ctx.KVStore(key)
+
+	// failure discards both the state changes and the tags
+	tags, err := types.RunAtomically(ctx, func(ctx types.Context) (types.Tags, types.Error) {
+		ctx.KVStore(key).Set(k1, v1)
+		return types.NewTags("action", "fail"), types.ErrInternal("failure")
+	})
+	require.Error(t, err)
+	require.Nil(t, tags)
+	require.Nil(t, store.Get(k1))
+
+	// success writes all the state changes and returns the tags
+	tags, err = types.RunAtomically(ctx, func(ctx types.Context) (types.Tags, types.Error) {
+		ctx.KVStore(key).Set(k1, v1)
+		ctx.KVStore(key).Set(k2, v2)
+		return types.NewTags("action", "success"), nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.NewTags("action", "success"), tags)
+	require.Equal(t, v1, store.Get(k1))
+	require.Equal(t, v2, store.Get(k2))
+
+	// panics do not write any state change
+	require.Panics(t, func() {
+		types.RunAtomically(ctx, func(ctx types.Context) (types.Tags, types.Error) {
+			ctx.KVStore(key).Delete(k1)
+			panic("failure")
+		})
+	})
+	require.Equal(t, v1, store.Get(k1))
+}
+
 func TestLogContext(t *testing.T) {
 	key := types.NewKVStoreKey(t.Name())
 	ctx := defaultContext(key)
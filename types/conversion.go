@@ -0,0 +1,109 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ConversionTable holds the exchange rates of a set of denominations against a
+// common unit of account. A rate of 2 for "foo" and 4 for "bar" means that one
+// bar is worth two foo.
+type ConversionTable struct {
+	rates map[string]Dec
+}
+
+// NewConversionTable returns a new ConversionTable from a mapping of
+// denominations to their rate against a common unit of account. It will panic
+// if any denomination is invalid or any rate is not positive.
+func NewConversionTable(rates map[string]Dec) ConversionTable {
+	table := ConversionTable{rates: make(map[string]Dec, len(rates))}
+	for denom, rate := range rates {
+		mustValidateDenom(denom)
+
+		if rate.IsNil() || !rate.IsPositive() {
+			panic(fmt.Sprintf("non-positive conversion rate for %s: %v", denom, rate))
+		}
+
+		table.rates[denom] = rate
+	}
+
+	return table
+}
+
+// Rate returns the rate of the given denomination against the common unit of
+// account and whether the denomination is known to the table.
+func (table ConversionTable) Rate(denom string) (Dec, bool) {
+	rate, ok := table.rates[denom]
+	return rate, ok
+}
+
+// Denoms returns the sorted denominations known to the table.
+func (table ConversionTable) Denoms() []string {
+	denoms := make([]string, 0, len(table.rates))
+	for denom := range table.rates {
+		denoms = append(denoms, denom)
+	}
+
+	sort.Strings(denoms)
+	return denoms
+}
+
+// ConvertDecCoin converts a decimal coin into the target denomination using the
+// rates of the given table. The result is truncated so the converted value
+// never exceeds the original one. An error is returned if either denomination
+// is unknown to the table.
+func ConvertDecCoin(coin DecCoin, targetDenom string, table ConversionTable) (DecCoin, error) {
+	if err := validateDenom(targetDenom); err != nil {
+		return DecCoin{}, err
+	}
+
+	if coin.Denom == targetDenom {
+		return coin, nil
+	}
+
+	srcRate, ok := table.Rate(coin.Denom)
+	if !ok {
+		return DecCoin{}, fmt.Errorf("no conversion rate for denom: %s", coin.Denom)
+	}
+
+	dstRate, ok := table.Rate(targetDenom)
+	if !ok {
+		return DecCoin{}, fmt.Errorf("no conversion rate for denom: %s", targetDenom)
+	}
+
+	amount := coin.Amount.MulTruncate(srcRate).QuoTruncate(dstRate)
+	return DecCoin{Denom: targetDenom, Amount: amount}, nil
+}
+
+// ConvertDecCoins converts every coin of a set of decimal coins into the target
+// denomination and returns their sum. See ConvertDecCoin for details.
+func ConvertDecCoins(coins DecCoins, targetDenom string, table ConversionTable) (DecCoin, error) {
+	if err := validateDenom(targetDenom); err != nil {
+		return DecCoin{}, err
+	}
+
+	sum := DecCoin{Denom: targetDenom, Amount: ZeroDec()}
+	for _, coin := range coins {
+		converted, err := ConvertDecCoin(coin, targetDenom, table)
+		if err != nil {
+			return DecCoin{}, err
+		}
+
+		sum = sum.Add(converted)
+	}
+
+	return sum, nil
+}
+
+// ConvertCoinWithChange converts a coin into the target denomination using the rates of
+// the given table. The result is truncated to an integer amount and the
+// remainder, expressed in the target denomination, is returned as change.
+func ConvertCoinWithChange(coin Coin, targetDenom string, table ConversionTable) (Coin, DecCoin, error) {
+	converted, err := ConvertDecCoin(NewDecCoinFromCoin(coin), targetDenom, table)
+	if err != nil {
+		return Coin{}, DecCoin{}, err
+	}
+
+	truncated, change := converted.TruncateDecimal()
+	return truncated, change, nil
+}
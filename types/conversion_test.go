@@ -0,0 +1,77 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewConversionTable(t *testing.T) {
+	require.Panics(t, func() { NewConversionTable(map[string]Dec{"BAD": OneDec()}) })
+	require.Panics(t, func() { NewConversionTable(map[string]Dec{testDenom1: ZeroDec()}) })
+	require.Panics(t, func() { NewConversionTable(map[string]Dec{testDenom1: NewDec(-1)}) })
+
+	table := NewConversionTable(map[string]Dec{testDenom2: NewDec(4), testDenom1: NewDec(2)})
+	require.Equal(t, []string{testDenom1, testDenom2}, table.Denoms())
+
+	rate, ok := table.Rate(testDenom2)
+	require.True(t, ok)
+	require.Equal(t, NewDec(4), rate)
+
+	_, ok = table.Rate("unknown")
+	require.False(t, ok)
+}
+
+func TestConvertDecCoin(t *testing.T) {
+	table := NewConversionTable(map[string]Dec{testDenom1: NewDec(2), testDenom2: NewDec(4)})
+
+	testCases := []struct {
+		input       DecCoin
+		targetDenom string
+		expected    DecCoin
+		expectErr   bool
+	}{
+		{NewInt64DecCoin(testDenom2, 10), testDenom1, NewInt64DecCoin(testDenom1, 20), false},
+		{NewInt64DecCoin(testDenom1, 10), testDenom2, NewInt64DecCoin(testDenom2, 5), false},
+		{NewInt64DecCoin(testDenom1, 1), testDenom2, NewDecCoinFromDec(testDenom2, NewDecWithPrec(5, 1)), false},
+		{NewInt64DecCoin(testDenom1, 7), testDenom1, NewInt64DecCoin(testDenom1, 7), false},
+		{NewInt64DecCoin("unknown", 1), testDenom1, DecCoin{}, true},
+		{NewInt64DecCoin(testDenom1, 1), "unknown", DecCoin{}, true},
+		{NewInt64DecCoin(testDenom1, 1), "BAD", DecCoin{}, true},
+	}
+
+	for i, tc := range testCases {
+		res, err := ConvertDecCoin(tc.input, tc.targetDenom, table)
+		if tc.expectErr {
+			require.Error(t, err, "expected error for test case #%d", i)
+		} else {
+			require.NoError(t, err, "unexpected error for test case #%d", i)
+			require.Equal(t, tc.expected, res, "unexpected result for test case #%d", i)
+		}
+	}
+}
+
+func TestConvertDecCoins(t *testing.T) {
+	table := NewConversionTable(map[string]Dec{testDenom1: NewDec(2), testDenom2: NewDec(4)})
+
+	coins := DecCoins{NewInt64DecCoin(testDenom1, 10), NewInt64DecCoin(testDenom2, 10)}
+	res, err := ConvertDecCoins(coins, testDenom1, table)
+	require.NoError(t, err)
+	require.Equal(t, NewInt64DecCoin(testDenom1, 30), res)
+
+	res, err = ConvertDecCoins(DecCoins{}, testDenom1, table)
+	require.NoError(t, err)
+	require.True(t, res.IsZero())
+
+	_, err = ConvertDecCoins(DecCoins{NewInt64DecCoin("unknown", 1)}, testDenom1, table)
+	require.Error(t, err)
+}
+
+func TestConvertCoinWithChange(t *testing.T) {
+	table := NewConversionTable(map[string]Dec{testDenom1: NewDec(2), testDenom2: NewDec(4)})
+
+	coin, change, err := ConvertCoinWithChange(NewInt64Coin(testDenom1, 3), testDenom2, table)
+	require.NoError(t, err)
+	require.Equal(t, NewInt64Coin(testDenom2, 1), coin)
+	require.Equal(t, NewDecCoinFromDec(testDenom2, NewDecWithPrec(5, 1)), change)
+}
@@ -290,7 +290,7 @@ func (coins DecCoins) Intersect(coinsB DecCoins) DecCoins {
 	for i, coin := range coins {
 		minCoin := DecCoin{
 			Denom:  coin.Denom,
-			Amount: MinDec(coin.Amount, coinsB.AmountOf(coin.Denom)),
+			Amount: MinDec(coin.Amount, coinsB.AmountOfNoDenomValidation(coin.Denom)),
 		}
 		res[i] = minCoin
 	}
@@ -407,7 +407,13 @@ func (coins DecCoins) Empty() bool {
 // returns the amount of a denom from deccoins
 func (coins DecCoins) AmountOf(denom string) Dec {
 	mustValidateDenom(denom)
+	return coins.AmountOfNoDenomValidation(denom)
+}
 
+// AmountOfNoDenomValidation returns the amount of a denom from deccoins
+// without validating the denomination. It is meant to be used in hot loops
+// where the denomination is already known to be valid.
+func (coins DecCoins) AmountOfNoDenomValidation(denom string) Dec {
 	switch len(coins) {
 	case 0:
 		return ZeroDec()
@@ -424,13 +430,39 @@ func (coins DecCoins) AmountOf(denom string) Dec {
 		coin := coins[midIdx]
 
 		if denom < coin.Denom {
-			return coins[:midIdx].AmountOf(denom)
+			return coins[:midIdx].AmountOfNoDenomValidation(denom)
 		} else if denom == coin.Denom {
 			return coin.Amount
 		} else {
-			return coins[midIdx+1:].AmountOf(denom)
+			return coins[midIdx+1:].AmountOfNoDenomValidation(denom)
+		}
+	}
+}
+
+// AmountsOf returns the amounts of the given denoms from deccoins in a single
+// merge pass over the sorted set. Denoms not present in the set map to zero.
+func (coins DecCoins) AmountsOf(denoms ...string) map[string]Dec {
+	sorted := make([]string, len(denoms))
+	copy(sorted, denoms)
+	sort.Strings(sorted)
+
+	amounts := make(map[string]Dec, len(sorted))
+	i := 0
+	for _, denom := range sorted {
+		mustValidateDenom(denom)
+
+		for i < len(coins) && coins[i].Denom < denom {
+			i++
+		}
+
+		if i < len(coins) && coins[i].Denom == denom {
+			amounts[denom] = coins[i].Amount
+		} else {
+			amounts[denom] = ZeroDec()
 		}
 	}
+
+	return amounts
 }
 
 // IsEqual returns true if the two sets of DecCoins have the same value.
@@ -464,39 +496,52 @@ func (coins DecCoins) IsZero() bool {
 // IsValid asserts the DecCoins are sorted, have positive amount, and Denom
 // does not contain upper case characters.
 func (coins DecCoins) IsValid() bool {
+	return coins.Validate() == nil
+}
+
+// Validate checks that the DecCoins are sorted, have positive amount, with a
+// valid and unique denomination (i.e no duplicates). Otherwise, it returns an
+// error describing the first offending coin.
+func (coins DecCoins) Validate() error {
 	switch len(coins) {
 	case 0:
-		return true
+		return nil
 
 	case 1:
 		if err := validateDenom(coins[0].Denom); err != nil {
-			return false
+			return err
+		}
+		if !coins[0].IsPositive() {
+			return fmt.Errorf("coin %s amount is not positive", coins[0])
 		}
-		return coins[0].IsPositive()
+		return nil
 
 	default:
 		// check single coin case
-		if !(DecCoins{coins[0]}).IsValid() {
-			return false
+		if err := (DecCoins{coins[0]}).Validate(); err != nil {
+			return err
 		}
 
 		lowDenom := coins[0].Denom
 		for _, coin := range coins[1:] {
-			if strings.ToLower(coin.Denom) != coin.Denom {
-				return false
+			if err := validateDenom(coin.Denom); err != nil {
+				return err
+			}
+			if coin.Denom == lowDenom {
+				return fmt.Errorf("duplicate denomination %s", coin.Denom)
 			}
-			if coin.Denom <= lowDenom {
-				return false
+			if coin.Denom < lowDenom {
+				return fmt.Errorf("denomination %s is not sorted", coin.Denom)
 			}
 			if !coin.IsPositive() {
-				return false
+				return fmt.Errorf("coin %s amount is not positive", coin)
 			}
 
 			// we compare each coin against the last denom
 			lowDenom = coin.Denom
 		}
 
-		return true
+		return nil
 	}
 }
 
@@ -600,8 +645,8 @@ func ParseDecCoins(coinsStr string) (DecCoins, error) {
 	coins.Sort()
 
 	// validate coins before returning
-	if !coins.IsValid() {
-		return nil, fmt.Errorf("parsed decimal coins are invalid: %#v", coins)
+	if err := coins.Validate(); err != nil {
+		return nil, errors.Wrap(err, "parsed decimal coins are invalid")
 	}
 
 	return coins, nil
@@ -167,6 +167,32 @@ func TestDecCoinsIsValid(t *testing.T) {
 	}
 }
 
+func TestDecCoinsValidate(t *testing.T) {
+	testCases := []struct {
+		input     DecCoins
+		expectErr bool
+		errMsg    string
+	}{
+		{DecCoins{}, false, ""},
+		{DecCoins{DecCoin{testDenom1, NewDec(5)}, DecCoin{testDenom2, NewDec(100000)}}, false, ""},
+		{DecCoins{DecCoin{testDenom1, NewDec(-5)}}, true, "not positive"},
+		{DecCoins{DecCoin{"AAA", NewDec(5)}}, true, "invalid denom"},
+		{DecCoins{DecCoin{testDenom1, NewDec(5)}, DecCoin{testDenom1, NewDec(5)}}, true, "duplicate"},
+		{DecCoins{DecCoin{testDenom2, NewDec(5)}, DecCoin{testDenom1, NewDec(5)}}, true, "not sorted"},
+		{DecCoins{DecCoin{testDenom1, NewDec(5)}, DecCoin{testDenom2, ZeroDec()}}, true, "not positive"},
+	}
+
+	for i, tc := range testCases {
+		err := tc.input.Validate()
+		if tc.expectErr {
+			require.Error(t, err, "expected error for test case #%d, input: %v", i, tc.input)
+			require.Contains(t, err.Error(), tc.errMsg, "unexpected error for test case #%d", i)
+		} else {
+			require.NoError(t, err, "unexpected error for test case #%d, input: %v", i, tc.input)
+		}
+	}
+}
+
 func TestParseDecCoins(t *testing.T) {
 	testCases := []struct {
 		input          string
@@ -315,3 +341,24 @@ func TestDecCoinsQuoDecTruncate(t *testing.T) {
 		}
 	}
 }
+
+func TestDecCoinsAmountsOf(t *testing.T) {
+	coins := DecCoins{
+		NewInt64DecCoin("bar", 2),
+		NewInt64DecCoin("baz", 3),
+		NewInt64DecCoin("foo", 5),
+	}
+
+	amounts := coins.AmountsOf("foo", "bar", "qux")
+	require.Len(t, amounts, 3)
+	require.True(t, NewDec(5).Equal(amounts["foo"]))
+	require.True(t, NewDec(2).Equal(amounts["bar"]))
+	require.True(t, amounts["qux"].IsZero())
+
+	for _, denom := range []string{"bar", "baz", "foo", "qux"} {
+		require.True(t, coins.AmountOf(denom).Equal(coins.AmountOfNoDenomValidation(denom)))
+	}
+
+	require.Empty(t, DecCoins{}.AmountsOf())
+	require.Panics(t, func() { coins.AmountsOf("foo", "BAR") })
+}
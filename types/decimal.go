@@ -526,6 +526,153 @@ func (d Dec) Ceil() Dec {
 
 //___________________________________________________________________________________
 
+// RoundingMode defines how the digits which do not fit in the result of an
+// operation are discarded. Every mode only depends on the exact value being
+// rounded, so the result is deterministic across platforms.
+type RoundingMode uint8
+
+const (
+	// RoundHalfEven rounds to the nearest value and ties to the even value
+	// (bankers rounding). This is the mode used by Quo and RoundInt.
+	RoundHalfEven RoundingMode = iota
+	// RoundHalfUp rounds to the nearest value and ties away from zero.
+	RoundHalfUp
+	// RoundFloor rounds towards negative infinity.
+	RoundFloor
+	// RoundCeiling rounds towards positive infinity.
+	RoundCeiling
+	// RoundTruncate rounds towards zero. This is the mode used by QuoTruncate
+	// and TruncateInt.
+	RoundTruncate
+)
+
+// String implements the Stringer interface.
+func (mode RoundingMode) String() string {
+	switch mode {
+	case RoundHalfEven:
+		return "half-even"
+	case RoundHalfUp:
+		return "half-up"
+	case RoundFloor:
+		return "floor"
+	case RoundCeiling:
+		return "ceiling"
+	case RoundTruncate:
+		return "truncate"
+	default:
+		return fmt.Sprintf("unknown rounding mode %d", uint8(mode))
+	}
+}
+
+// Remove a Precision amount of rightmost digits and round the result using the
+// given rounding mode.
+//
+// Does not mutate the input.
+func chopPrecisionWithMode(d *big.Int, mode RoundingMode) *big.Int {
+	return quoWithMode(d, precisionReuse, mode)
+}
+
+// quoWithMode divides num by den and rounds the quotient using the given
+// rounding mode. The rounding decision is made on the full remainder of a
+// single division, so no information is lost before rounding.
+//
+// Does not mutate the inputs.
+func quoWithMode(num, den *big.Int, mode RoundingMode) *big.Int {
+	// get the quotient truncated towards zero and the remainder, which has the
+	// sign of num
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Sign() == 0 {
+		return quo
+	}
+
+	// sign of the discarded fraction, i.e. one unit away from zero
+	sign := num.Sign() * den.Sign()
+	away := big.NewInt(int64(sign))
+
+	// compare twice the remainder against the divisor to locate the halfway
+	// point without losing precision
+	half := new(big.Int).Abs(rem)
+	half.Lsh(half, 1)
+	halfCmp := half.Cmp(new(big.Int).Abs(den))
+
+	switch mode {
+	case RoundTruncate:
+		return quo
+
+	case RoundFloor:
+		if sign == -1 {
+			return quo.Sub(quo, oneInt)
+		}
+		return quo
+
+	case RoundCeiling:
+		if sign == 1 {
+			return quo.Add(quo, oneInt)
+		}
+		return quo
+
+	case RoundHalfUp:
+		if halfCmp >= 0 {
+			return quo.Add(quo, away)
+		}
+		return quo
+
+	case RoundHalfEven:
+		switch halfCmp {
+		case -1:
+			return quo
+		case 1:
+			return quo.Add(quo, away)
+		default:
+			if quo.Bit(0) == 0 {
+				return quo
+			}
+			return quo.Add(quo, away)
+		}
+
+	default:
+		panic(fmt.Sprintf("unknown rounding mode %d", uint8(mode)))
+	}
+}
+
+// QuoWithMode returns the quotient of two decimals, rounded using the given
+// rounding mode.
+func (d Dec) QuoWithMode(d2 Dec, mode RoundingMode) Dec {
+	// scale the numerator once so the quotient keeps Precision decimals
+	mul := new(big.Int).Mul(d.Int, precisionReuse)
+
+	quo := quoWithMode(mul, d2.Int, mode)
+	if quo.BitLen() > 255+DecimalPrecisionBits {
+		panic("Int overflow")
+	}
+	return Dec{quo}
+}
+
+// RoundIntWithMode rounds the decimal to an Int using the given rounding mode.
+func (d Dec) RoundIntWithMode(mode RoundingMode) Int {
+	return NewIntFromBigInt(chopPrecisionWithMode(d.Int, mode))
+}
+
+// CeilInt returns the smallest integer that is greater than or equal to the
+// given decimal.
+func (d Dec) CeilInt() Int {
+	return d.RoundIntWithMode(RoundCeiling)
+}
+
+// FloorInt returns the largest integer that is less than or equal to the given
+// decimal.
+func (d Dec) FloorInt() Int {
+	return d.RoundIntWithMode(RoundFloor)
+}
+
+// Floor returns the largest integer value (as a decimal) that is less than or
+// equal to the given decimal.
+func (d Dec) Floor() Dec {
+	return NewDecFromBigInt(chopPrecisionWithMode(d.Int, RoundFloor))
+}
+
+//___________________________________________________________________________________
+
 // reuse nil values
 var (
 	nilAmino string
@@ -422,3 +422,102 @@ func TestDecCeil(t *testing.T) {
 		require.Equal(t, tc.expected, res, "unexpected result for test case %d, input: %v", i, tc.input)
 	}
 }
+
+func TestRoundingModes(t *testing.T) {
+	tests := []struct {
+		d1                                         Dec
+		halfEven, halfUp, floor, ceiling, truncate int64
+	}{
+		{mustNewDecFromStr(t, "0"), 0, 0, 0, 0, 0},
+		{mustNewDecFromStr(t, "2"), 2, 2, 2, 2, 2},
+		{mustNewDecFromStr(t, "2.4"), 2, 2, 2, 3, 2},
+		{mustNewDecFromStr(t, "2.5"), 2, 3, 2, 3, 2},
+		{mustNewDecFromStr(t, "3.5"), 4, 4, 3, 4, 3},
+		{mustNewDecFromStr(t, "2.6"), 3, 3, 2, 3, 2},
+		{mustNewDecFromStr(t, "-2.4"), -2, -2, -3, -2, -2},
+		{mustNewDecFromStr(t, "-2.5"), -2, -3, -3, -2, -2},
+		{mustNewDecFromStr(t, "-3.5"), -4, -4, -4, -3, -3},
+		{mustNewDecFromStr(t, "-2.6"), -3, -3, -3, -2, -2},
+	}
+
+	for tcIndex, tc := range tests {
+		require.Equal(t, tc.halfEven, tc.d1.RoundIntWithMode(RoundHalfEven).Int64(), "half-even tc %d", tcIndex)
+		require.Equal(t, tc.halfUp, tc.d1.RoundIntWithMode(RoundHalfUp).Int64(), "half-up tc %d", tcIndex)
+		require.Equal(t, tc.floor, tc.d1.RoundIntWithMode(RoundFloor).Int64(), "floor tc %d", tcIndex)
+		require.Equal(t, tc.ceiling, tc.d1.RoundIntWithMode(RoundCeiling).Int64(), "ceiling tc %d", tcIndex)
+		require.Equal(t, tc.truncate, tc.d1.RoundIntWithMode(RoundTruncate).Int64(), "truncate tc %d", tcIndex)
+
+		// consistency with the existing rounding functions
+		require.Equal(t, tc.d1.RoundInt64(), tc.halfEven, "round tc %d", tcIndex)
+		require.Equal(t, tc.d1.TruncateInt64(), tc.truncate, "truncate tc %d", tcIndex)
+		require.True(t, tc.d1.Ceil().Equal(NewDec(tc.ceiling)), "ceil tc %d", tcIndex)
+		require.True(t, tc.d1.Floor().Equal(NewDec(tc.floor)), "floor tc %d", tcIndex)
+		require.Equal(t, tc.d1.CeilInt().Int64(), tc.ceiling, "ceil int tc %d", tcIndex)
+		require.Equal(t, tc.d1.FloorInt().Int64(), tc.floor, "floor int tc %d", tcIndex)
+	}
+}
+
+func TestQuoWithMode(t *testing.T) {
+	one, two, three := NewDec(1), NewDec(2), NewDec(3)
+
+	// 1 / 3 = 0.333333333333333333|3
+	require.True(t, one.Quo(three).Equal(one.QuoWithMode(three, RoundHalfEven)))
+	require.True(t, one.QuoTruncate(three).Equal(one.QuoWithMode(three, RoundTruncate)))
+	require.True(t, one.QuoRoundUp(three).Equal(one.QuoWithMode(three, RoundCeiling)))
+
+	// 2 / 3 = 0.666666666666666666|6
+	testCases := []struct {
+		d1       Dec
+		mode     RoundingMode
+		expected Dec
+	}{
+		{two, RoundHalfUp, mustNewDecFromStr(t, "0.666666666666666667")},
+		{two, RoundFloor, mustNewDecFromStr(t, "0.666666666666666666")},
+		{two.Neg(), RoundFloor, mustNewDecFromStr(t, "-0.666666666666666667")},
+		{two.Neg(), RoundCeiling, mustNewDecFromStr(t, "-0.666666666666666666")},
+	}
+
+	for i, tc := range testCases {
+		res := tc.d1.QuoWithMode(three, tc.mode)
+		require.True(t, tc.expected.Equal(res), "unexpected result for test case %d, mode: %s, result: %v", i, tc.mode, res)
+	}
+
+	require.Panics(t, func() { one.QuoWithMode(three, RoundingMode(100)) })
+}
+
+func TestQuoWithModeRemainder(t *testing.T) {
+	smallest := NewDecWithPrec(1, Precision)
+	two, three := NewDec(2), NewDec(3)
+
+	// the rounding decision must take the whole remainder into account
+	tests := []struct {
+		d1, d2                                     Dec
+		halfEven, halfUp, floor, ceiling, truncate Dec
+	}{
+		// 1/3 * 10^-18
+		{smallest, three, ZeroDec(), ZeroDec(), ZeroDec(), smallest, ZeroDec()},
+		{smallest.Neg(), three, ZeroDec(), ZeroDec(), smallest.Neg(), ZeroDec(), ZeroDec()},
+		{smallest, three.Neg(), ZeroDec(), ZeroDec(), smallest.Neg(), ZeroDec(), ZeroDec()},
+		// exact ties
+		{smallest, two, ZeroDec(), smallest, ZeroDec(), smallest, ZeroDec()},
+		{smallest.MulInt64(3), two, smallest.MulInt64(2), smallest.MulInt64(2), smallest, smallest.MulInt64(2), smallest},
+		{smallest.Neg(), two, ZeroDec(), smallest.Neg(), smallest.Neg(), ZeroDec(), ZeroDec()},
+		{smallest.MulInt64(3).Neg(), two, smallest.MulInt64(-2), smallest.MulInt64(-2), smallest.MulInt64(-2), smallest.Neg(), smallest.Neg()},
+		{smallest, two.Neg(), ZeroDec(), smallest.Neg(), smallest.Neg(), ZeroDec(), ZeroDec()},
+		// just above a tie: 10^-18 / (2 - 10^-18)
+		{smallest, two.Sub(smallest), smallest, smallest, ZeroDec(), smallest, ZeroDec()},
+		{smallest.Neg(), two.Sub(smallest), smallest.Neg(), smallest.Neg(), smallest.Neg(), ZeroDec(), ZeroDec()},
+		// just below one unit: 10^-18 / (1 + 10^-18)
+		{smallest, OneDec().Add(smallest), smallest, smallest, ZeroDec(), smallest, ZeroDec()},
+		// exact division
+		{NewDec(6), two.Neg(), NewDec(-3), NewDec(-3), NewDec(-3), NewDec(-3), NewDec(-3)},
+	}
+
+	for tcIndex, tc := range tests {
+		require.True(t, tc.halfEven.Equal(tc.d1.QuoWithMode(tc.d2, RoundHalfEven)), "half-even tc %d", tcIndex)
+		require.True(t, tc.halfUp.Equal(tc.d1.QuoWithMode(tc.d2, RoundHalfUp)), "half-up tc %d", tcIndex)
+		require.True(t, tc.floor.Equal(tc.d1.QuoWithMode(tc.d2, RoundFloor)), "floor tc %d", tcIndex)
+		require.True(t, tc.ceiling.Equal(tc.d1.QuoWithMode(tc.d2, RoundCeiling)), "ceiling tc %d", tcIndex)
+		require.True(t, tc.truncate.Equal(tc.d1.QuoWithMode(tc.d2, RoundTruncate)), "truncate tc %d", tcIndex)
+	}
+}
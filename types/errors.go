@@ -44,6 +44,9 @@ const (
 	CodeTooManySignatures CodeType = 15
 	CodeGasOverflow       CodeType = 16
 	CodeNoSignatures      CodeType = 17
+	CodeTooManyMsgs       CodeType = 18
+	CodeTxTooLarge        CodeType = 19
+	CodeInvalidFeeDenom   CodeType = 20
 
 	// CodespaceRoot is a codespace for error codes in this file only.
 	// Notice that 0 is an "unset" codespace, which can be overridden with
@@ -91,6 +94,12 @@ func CodeToDefaultMsg(code CodeType) string {
 		return "maximum numer of signatures exceeded"
 	case CodeNoSignatures:
 		return "no signatures supplied"
+	case CodeTooManyMsgs:
+		return "maximum number of messages exceeded"
+	case CodeTxTooLarge:
+		return "tx too large"
+	case CodeInvalidFeeDenom:
+		return "fee denomination not accepted"
 	default:
 		return unknownCodeMsg(code)
 	}
@@ -152,6 +161,15 @@ func ErrNoSignatures(msg string) Error {
 func ErrGasOverflow(msg string) Error {
 	return newErrorWithRootCodespace(CodeGasOverflow, msg)
 }
+func ErrTooManyMsgs(msg string) Error {
+	return newErrorWithRootCodespace(CodeTooManyMsgs, msg)
+}
+func ErrTxTooLarge(msg string) Error {
+	return newErrorWithRootCodespace(CodeTxTooLarge, msg)
+}
+func ErrInvalidFeeDenom(msg string) Error {
+	return newErrorWithRootCodespace(CodeInvalidFeeDenom, msg)
+}
 
 //----------------------------------------
 // Error & sdkError
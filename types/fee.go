@@ -0,0 +1,115 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Fee defines the fee paid for the execution of a transaction given a gas
+// limit. The amount is kept in decimal coins so that fees derived from gas
+// prices do not lose precision until they are charged.
+type Fee struct {
+	Amount DecCoins `json:"amount"`
+	Gas    uint64   `json:"gas"`
+}
+
+// NewFee returns a new fee for a given amount and gas limit.
+func NewFee(amount DecCoins, gas uint64) Fee {
+	return Fee{
+		Amount: amount,
+		Gas:    gas,
+	}
+}
+
+// NewFeeFromCoins returns a new fee for a given amount of coins and gas limit.
+func NewFeeFromCoins(amount Coins, gas uint64) Fee {
+	decAmount := make(DecCoins, len(amount))
+	for i, coin := range amount {
+		decAmount[i] = NewDecCoinFromCoin(coin)
+	}
+
+	return NewFee(decAmount, gas)
+}
+
+// NewFeeFromGasPrices returns a new fee derived from gas prices, where for each
+// denomination fee = gasPrice * gasLimit. Denominations with a zero fee are
+// left out.
+func NewFeeFromGasPrices(gasPrices DecCoins, gas uint64) Fee {
+	glDec := NewDec(int64(gas))
+
+	var amount DecCoins
+	for _, gp := range gasPrices {
+		fee := gp.Amount.Mul(glDec)
+		if fee.IsZero() {
+			continue
+		}
+		amount = append(amount, NewDecCoinFromDec(gp.Denom, fee))
+	}
+
+	return NewFee(amount, gas)
+}
+
+// ParseFee parses a fee from either a fees string (e.g. "10stake,1photino") or
+// a gas prices string (e.g. "0.025stake"). It returns an error if both are
+// provided or if any of them is invalid.
+func ParseFee(fees, gasPrices string, gas uint64) (Fee, error) {
+	parsedFees, err := ParseCoins(fees)
+	if err != nil {
+		return Fee{}, fmt.Errorf("invalid fees %s: %v", fees, err)
+	}
+
+	parsedGasPrices, err := ParseDecCoins(gasPrices)
+	if err != nil {
+		return Fee{}, fmt.Errorf("invalid gas prices %s: %v", gasPrices, err)
+	}
+
+	if !parsedGasPrices.IsZero() {
+		if !parsedFees.IsZero() {
+			return Fee{}, errors.New("cannot provide both fees and gas prices")
+		}
+
+		return NewFeeFromGasPrices(parsedGasPrices, gas), nil
+	}
+
+	return NewFeeFromCoins(parsedFees, gas), nil
+}
+
+// Validate returns an error if the fee amount is not a valid set of decimal
+// coins. An empty or zero amount is valid.
+func (fee Fee) Validate() error {
+	if fee.IsZero() {
+		return nil
+	}
+
+	if err := fee.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid fee amount %s: %v", fee.Amount, err)
+	}
+
+	return nil
+}
+
+// IsZero returns true if the fee amount is zero.
+func (fee Fee) IsZero() bool {
+	return fee.Amount.IsZero()
+}
+
+// ToCoins returns the coins to charge for the fee, where for each
+// denomination the amount is rounded up, i.e. coin = ceil(fee). Zero amounts
+// are left out so that the coins are valid.
+func (fee Fee) ToCoins() Coins {
+	var coins Coins
+	for _, coin := range fee.Amount {
+		amount := coin.Amount.Ceil().RoundInt()
+		if amount.IsZero() {
+			continue
+		}
+		coins = append(coins, NewCoin(coin.Denom, amount))
+	}
+
+	return coins
+}
+
+// String implements the Stringer interface.
+func (fee Fee) String() string {
+	return fmt.Sprintf("Fee{Amount: %s, Gas: %d}", fee.Amount, fee.Gas)
+}
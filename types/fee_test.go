@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFee(t *testing.T) {
+	testCases := []struct {
+		fees      string
+		gasPrices string
+		gas       uint64
+		expected  Coins
+		expectErr bool
+	}{
+		{"", "", 100, nil, false},
+		{"10atom", "", 100, Coins{NewInt64Coin(testDenom1, 10)}, false},
+		{"", "0.025atom,0.5muon", 100, Coins{NewInt64Coin(testDenom1, 3), NewInt64Coin(testDenom2, 50)}, false},
+		{"", "0.025atom,0.5muon", 0, nil, false},
+		{"", "0.0atom", 100, nil, true},
+		{"10atom", "0.025atom", 100, nil, true},
+		{"10ATOM", "", 100, nil, true},
+		{"", "1atom", 100, nil, true},
+	}
+
+	for i, tc := range testCases {
+		fee, err := ParseFee(tc.fees, tc.gasPrices, tc.gas)
+		if tc.expectErr {
+			require.Error(t, err, "tc #%d", i)
+			continue
+		}
+
+		require.NoError(t, err, "tc #%d", i)
+		require.NoError(t, fee.Validate(), "tc #%d", i)
+		require.Equal(t, tc.gas, fee.Gas, "tc #%d", i)
+		require.Equal(t, tc.expected, fee.ToCoins(), "tc #%d", i)
+	}
+}
+
+func TestFeeValidate(t *testing.T) {
+	require.NoError(t, NewFee(nil, 100).Validate())
+	require.NoError(t, NewFee(DecCoins{NewInt64DecCoin(testDenom1, 1)}, 100).Validate())
+	require.Error(t, NewFee(DecCoins{NewInt64DecCoin(testDenom2, 1), NewInt64DecCoin(testDenom1, 1)}, 100).Validate())
+}
+
+func TestFeeToCoins(t *testing.T) {
+	// zero amounts are left out of the coins
+	fee := NewFee(DecCoins{NewInt64DecCoin(testDenom1, 0), NewDecCoinFromDec(testDenom2, NewDecWithPrec(15, 1))}, 100)
+	require.Equal(t, Coins{NewInt64Coin(testDenom2, 2)}, fee.ToCoins())
+	require.True(t, fee.ToCoins().IsValid())
+
+	require.Nil(t, NewFee(DecCoins{NewInt64DecCoin(testDenom1, 0)}, 100).ToCoins())
+	require.Nil(t, NewFee(nil, 100).ToCoins())
+}
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// ForEachJSONArrayChunk streams the elements of the array held by the given
+// field of the JSON object data, and calls fn with chunks of at most chunkSize
+// raw elements. It allows a module to process a very large genesis state, eg.
+// millions of accounts, without decoding all its elements at once. A missing
+// or null field holds no elements.
+func ForEachJSONArrayChunk(data json.RawMessage, field string, chunkSize int,
+	fn func(chunk []json.RawMessage) error) error {
+
+	if chunkSize < 1 {
+		return fmt.Errorf("invalid chunk size %d", chunkSize)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		// skip the other fields without decoding them
+		if key, ok := tok.(string); !ok || key != field {
+			var skipped json.RawMessage
+			if err := dec.Decode(&skipped); err != nil {
+				return err
+			}
+			continue
+		}
+
+		tok, err = dec.Token()
+		if err != nil {
+			return err
+		}
+		if tok == nil {
+			continue
+		}
+		if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+			return fmt.Errorf("field %s is not an array", field)
+		}
+
+		chunk := make([]json.RawMessage, 0, chunkSize)
+		for dec.More() {
+			var elem json.RawMessage
+			if err := dec.Decode(&elem); err != nil {
+				return err
+			}
+
+			chunk = append(chunk, elem)
+			if len(chunk) == chunkSize {
+				if err := fn(chunk); err != nil {
+					return err
+				}
+				chunk = make([]json.RawMessage, 0, chunkSize)
+			}
+		}
+		if len(chunk) > 0 {
+			if err := fn(chunk); err != nil {
+				return err
+			}
+		}
+
+		if err := expectDelim(dec, ']'); err != nil {
+			return err
+		}
+	}
+
+	return expectDelim(dec, '}')
+}
+
+func expectDelim(dec *json.Decoder, expected json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != expected {
+		return fmt.Errorf("expected %s, got %v", expected, tok)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestForEachJSONArrayChunk(t *testing.T) {
+	data := json.RawMessage(`{"params":{"items":[9]},"items":[1,{"a":[2]},"3",4,5],"other":null}`)
+
+	var chunks [][]string
+	collect := func(chunk []json.RawMessage) error {
+		strs := make([]string, len(chunk))
+		for i, elem := range chunk {
+			strs[i] = string(elem)
+		}
+		chunks = append(chunks, strs)
+		return nil
+	}
+
+	require.NoError(t, ForEachJSONArrayChunk(data, "items", 2, collect))
+	require.Equal(t, [][]string{{`1`, `{"a":[2]}`}, {`"3"`, `4`}, {`5`}}, chunks)
+
+	// missing and null fields hold no elements
+	chunks = nil
+	require.NoError(t, ForEachJSONArrayChunk(data, "missing", 2, collect))
+	require.NoError(t, ForEachJSONArrayChunk(data, "other", 2, collect))
+	require.Empty(t, chunks)
+
+	// errors
+	require.Error(t, ForEachJSONArrayChunk(data, "items", 0, collect))
+	require.Error(t, ForEachJSONArrayChunk(data, "params", 2, collect))
+	require.Error(t, ForEachJSONArrayChunk(json.RawMessage(`[1]`), "items", 2, collect))
+	require.Error(t, ForEachJSONArrayChunk(json.RawMessage(`{"items":[1,`), "items", 2, collect))
+
+	errStop := errors.New("stop")
+	require.Equal(t, errStop, ForEachJSONArrayChunk(data, "items", 1, func([]json.RawMessage) error {
+		return errStop
+	}))
+}
@@ -6,3 +6,35 @@ type Handler func(ctx Context, msg Msg) Result
 // AnteHandler authenticates transactions, before their internal messages are handled.
 // If newCtx.IsZero(), ctx is used instead.
 type AnteHandler func(ctx Context, tx Tx, simulate bool) (newCtx Context, result Result, abort bool)
+
+// AnteDecorator wraps the next AnteHandler of a chain to perform custom
+// pre- and post-processing. A decorator aborts the chain by returning
+// without calling next.
+type AnteDecorator interface {
+	AnteHandle(ctx Context, tx Tx, simulate bool, next AnteHandler) (newCtx Context, result Result, abort bool)
+}
+
+// ChainAnteDecorators chains the AnteDecorators into a single AnteHandler,
+// calling them in the given order. The last decorator is passed a next
+// AnteHandler that returns the context unchanged with an empty result.
+func ChainAnteDecorators(chain ...AnteDecorator) AnteHandler {
+	if len(chain) == 0 {
+		return func(ctx Context, _ Tx, _ bool) (Context, Result, bool) {
+			return ctx, Result{}, false
+		}
+	}
+
+	next := ChainAnteDecorators(chain[1:]...)
+	return func(ctx Context, tx Tx, simulate bool) (Context, Result, bool) {
+		return chain[0].AnteHandle(ctx, tx, simulate, next)
+	}
+}
+
+// RecoveryHandler maps a value recovered from a panic while running a
+// transaction to the result of the transaction. It returns false if it does
+// not handle the value.
+type RecoveryHandler func(recoveryObj interface{}) (result Result, handled bool)
+
+// TxHook is called with every successfully delivered transaction, its result
+// and the height of its block once the block is committed.
+type TxHook func(height int64, tx Tx, result Result)
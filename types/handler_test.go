@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type recordingDecorator struct {
+	name  string
+	calls *[]string
+	abort bool
+}
+
+func (rd recordingDecorator) AnteHandle(ctx Context, tx Tx, simulate bool, next AnteHandler) (Context, Result, bool) {
+	*rd.calls = append(*rd.calls, rd.name)
+	if rd.abort {
+		return ctx, ErrUnauthorized(rd.name).Result(), true
+	}
+	return next(ctx, tx, simulate)
+}
+
+func TestChainAnteDecorators(t *testing.T) {
+	var calls []string
+
+	_, res, abort := ChainAnteDecorators()(Context{}, nil, false)
+	require.False(t, abort)
+	require.True(t, res.IsOK())
+
+	anteHandler := ChainAnteDecorators(
+		recordingDecorator{"first", &calls, false},
+		recordingDecorator{"second", &calls, false},
+	)
+	_, res, abort = anteHandler(Context{}, nil, false)
+	require.False(t, abort)
+	require.True(t, res.IsOK())
+	require.Equal(t, []string{"first", "second"}, calls)
+
+	// an aborting decorator stops the chain
+	calls = nil
+	anteHandler = ChainAnteDecorators(
+		recordingDecorator{"first", &calls, true},
+		recordingDecorator{"second", &calls, false},
+	)
+	_, res, abort = anteHandler(Context{}, nil, false)
+	require.True(t, abort)
+	require.Equal(t, CodeUnauthorized, res.Code)
+	require.Equal(t, []string{"first"}, calls)
+}
@@ -0,0 +1,17 @@
+package types
+
+// MetricLabels returns a function building the label names of a metric: the
+// names of the constant labels given along with their values ("foo",
+// "fooValue"), followed by the extra label names of the metric. Each call of
+// the returned function gives its own slice, so that it can be passed to the
+// constructor of every metric of a set.
+func MetricLabels(labelsAndValues ...string) func(extra ...string) []string {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+
+	return func(extra ...string) []string {
+		return append(append([]string{}, labels...), extra...)
+	}
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricLabels(t *testing.T) {
+	withLabels := MetricLabels("chain_id", "test-chain", "node", "val0")
+
+	labels := withLabels("module")
+	require.Equal(t, []string{"chain_id", "node", "module"}, labels)
+
+	// each call gets its own copy of the labels
+	other := withLabels("blocker")
+	require.Equal(t, []string{"chain_id", "node", "blocker"}, other)
+	require.Equal(t, []string{"chain_id", "node", "module"}, labels)
+
+	require.Equal(t, []string{"module"}, MetricLabels()("module"))
+}
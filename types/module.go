@@ -23,12 +23,20 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
+// ModulesQueryRoute is the querier route of the module descriptors
+const ModulesQueryRoute = "modules"
+
 // ModuleClient helps modules provide a standard interface for exporting client functionality
 type ModuleClient interface {
 	GetQueryCmd() *cobra.Command
@@ -67,13 +75,32 @@ func (mbm ModuleBasicManager) DefaultGenesis() map[string]json.RawMessage {
 	return genesis
 }
 
-// Provided default genesis information for all modules
+// Validate the genesis information of all modules, returning the errors of
+// every invalid module and of any genesis entry that belongs to no module
 func (mbm ModuleBasicManager) ValidateGenesis(genesis map[string]json.RawMessage) error {
+	var errs []string
+	known := make(map[string]bool)
 	for _, mb := range mbm {
+		known[mb.Name()] = true
 		if err := mb.ValidateGenesis(genesis[mb.Name()]); err != nil {
-			return err
+			errs = append(errs, fmt.Sprintf("%s: %s", mb.Name(), err))
+		}
+	}
+
+	var unknown []string
+	for name := range genesis {
+		if !known[name] {
+			unknown = append(unknown, name)
 		}
 	}
+	sort.Strings(unknown)
+	for _, name := range unknown {
+		errs = append(errs, fmt.Sprintf("%s: unknown module genesis", name))
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid genesis:\n%s", strings.Join(errs, "\n"))
+	}
 	return nil
 }
 
@@ -102,6 +129,50 @@ type AppModule interface {
 	EndBlock(Context, abci.RequestEndBlock) ([]abci.ValidatorUpdate, Tags)
 }
 
+// AppModuleDescriber is implemented by the modules describing the types of the
+// messages they handle and the paths of the queries they serve
+type AppModuleDescriber interface {
+	MsgTypes() []string
+	QueryPaths() []string
+}
+
+// AppModuleVersion is implemented by the modules versioning their state
+// machine, the modules which do not implement it are at version 1
+type AppModuleVersion interface {
+	ConsensusVersion() uint64
+}
+
+// ModuleDescriptor describes an application module, so that generic tooling
+// such as wallets and block explorers can discover the capabilities of a chain
+type ModuleDescriptor struct {
+	Name             string   `json:"name"`
+	Route            string   `json:"route"`         // message route, empty if the module handles no message
+	QuerierRoute     string   `json:"querier_route"` // querier route, empty if the module serves no query
+	MsgTypes         []string `json:"msg_types"`
+	QueryPaths       []string `json:"query_paths"`
+	ConsensusVersion uint64   `json:"consensus_version"`
+}
+
+// NewModuleDescriptor creates the descriptor of an application module
+func NewModuleDescriptor(module AppModule) ModuleDescriptor {
+	desc := ModuleDescriptor{
+		Name:             module.Name(),
+		Route:            module.Route(),
+		QuerierRoute:     module.QuerierRoute(),
+		MsgTypes:         []string{},
+		QueryPaths:       []string{},
+		ConsensusVersion: 1,
+	}
+	if describer, ok := module.(AppModuleDescriber); ok {
+		desc.MsgTypes = describer.MsgTypes()
+		desc.QueryPaths = describer.QueryPaths()
+	}
+	if version, ok := module.(AppModuleVersion); ok {
+		desc.ConsensusVersion = version.ConsensusVersion()
+	}
+	return desc
+}
+
 //___________________________
 // app module
 type GenesisOnlyAppModule struct {
@@ -149,6 +220,9 @@ type ModuleManager struct {
 	OrderExportGenesis []string
 	OrderBeginBlockers []string
 	OrderEndBlockers   []string
+	GenesisSizeLimits  map[string]int
+	BlockerGasBudgets  map[string]uint64
+	Metrics            *ModuleMetrics
 }
 
 // NewModuleManager creates a new ModuleManager object
@@ -157,6 +231,9 @@ func NewModuleManager(modules ...AppModule) *ModuleManager {
 	moduleMap := make(map[string]AppModule)
 	var modulesStr []string
 	for _, module := range modules {
+		if _, ok := moduleMap[module.Name()]; ok {
+			panic(fmt.Sprintf("module %s registered twice", module.Name()))
+		}
 		moduleMap[module.Name()] = module
 		modulesStr = append(modulesStr, module.Name())
 	}
@@ -167,6 +244,9 @@ func NewModuleManager(modules ...AppModule) *ModuleManager {
 		OrderExportGenesis: modulesStr,
 		OrderBeginBlockers: modulesStr,
 		OrderEndBlockers:   modulesStr,
+		GenesisSizeLimits:  make(map[string]int),
+		BlockerGasBudgets:  make(map[string]uint64),
+		Metrics:            NopModuleMetrics(),
 	}
 }
 
@@ -190,6 +270,37 @@ func (mm *ModuleManager) SetOrderEndBlockers(moduleNames ...string) {
 	mm.OrderEndBlockers = moduleNames
 }
 
+// set the maximum size in bytes of the genesis state of a module, larger
+// genesis states are rejected before being decoded by the module
+func (mm *ModuleManager) SetGenesisSizeLimit(moduleName string, maxBytes int) {
+	mm.GenesisSizeLimits[moduleName] = maxBytes
+}
+
+// set the gas budget of each of the begin and end blockers of a module, a
+// blocker exceeding it is logged as an error but still completes
+func (mm *ModuleManager) SetBlockerGasBudget(moduleName string, gas uint64) {
+	mm.BlockerGasBudgets[moduleName] = gas
+}
+
+// set the metrics reporting the time and gas spent in the blockers of each
+// module, eg. PrometheusModuleMetrics
+func (mm *ModuleManager) SetMetrics(metrics *ModuleMetrics) {
+	mm.Metrics = metrics
+}
+
+// ValidateGenesisSizes returns an error if the genesis state of a module
+// exceeds its size limit
+func (mm *ModuleManager) ValidateGenesisSizes(genesisData map[string]json.RawMessage) error {
+	for _, moduleName := range mm.OrderInitGenesis {
+		limit, ok := mm.GenesisSizeLimits[moduleName]
+		if ok && len(genesisData[moduleName]) > limit {
+			return fmt.Errorf("genesis state of module %s is %d bytes, exceeding the limit of %d bytes",
+				moduleName, len(genesisData[moduleName]), limit)
+		}
+	}
+	return nil
+}
+
 // register all module routes and module querier routes
 func (mm *ModuleManager) RegisterInvariants(invarRouter InvariantRouter) {
 	for _, module := range mm.Modules {
@@ -197,25 +308,111 @@ func (mm *ModuleManager) RegisterInvariants(invarRouter InvariantRouter) {
 	}
 }
 
-// register all module routes and module querier routes
+// ValidateGenesis validates the genesis state of all the modules, in their
+// genesis initialization order, before initializing any of them. It returns
+// the errors of every invalid module, or the error of the first module whose
+// genesis state exceeds its size limit. Missing genesis states are not
+// validated, as they are not initialized either.
+func (mm *ModuleManager) ValidateGenesis(genesisData map[string]json.RawMessage) error {
+	if err := mm.ValidateGenesisSizes(genesisData); err != nil {
+		return err
+	}
+
+	var errs []string
+	for _, moduleName := range mm.OrderInitGenesis {
+		if genesisData[moduleName] == nil {
+			continue
+		}
+		if err := mm.Modules[moduleName].ValidateGenesis(genesisData[moduleName]); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", moduleName, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid genesis:\n%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// register all module routes and module querier routes, panics if two modules
+// share a route or a querier route
 func (mm *ModuleManager) RegisterRoutes(router Router, queryRouter QueryRouter) {
+	routes := make(map[string]string)
+	querierRoutes := make(map[string]string)
+	for _, moduleName := range mm.moduleNames() {
+		module := mm.Modules[moduleName]
+		if route := module.Route(); route != "" {
+			if other, ok := routes[route]; ok {
+				panic(fmt.Sprintf("route %s of module %s already registered by module %s", route, moduleName, other))
+			}
+			routes[route] = moduleName
+			router.AddRoute(route, module.NewHandler())
+		}
+		if route := module.QuerierRoute(); route != "" {
+			if other, ok := querierRoutes[route]; ok {
+				panic(fmt.Sprintf("querier route %s of module %s already registered by module %s", route, moduleName, other))
+			}
+			querierRoutes[route] = moduleName
+			queryRouter.AddRoute(route, module.NewQuerierHandler())
+		}
+	}
+}
+
+// moduleNames returns the names of all the modules, sorted so that iterating
+// over them is deterministic
+func (mm *ModuleManager) moduleNames() []string {
+	names := make([]string, 0, len(mm.Modules))
+	for name := range mm.Modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Descriptors returns the descriptors of all the modules, sorted by name
+func (mm *ModuleManager) Descriptors() []ModuleDescriptor {
+	descs := make([]ModuleDescriptor, 0, len(mm.Modules))
 	for _, module := range mm.Modules {
-		if module.Route() != "" {
-			router.AddRoute(module.Route(), module.NewHandler())
+		descs = append(descs, NewModuleDescriptor(module))
+	}
+	sort.Slice(descs, func(i, j int) bool { return descs[i].Name < descs[j].Name })
+	return descs
+}
+
+// NewDescriptorQuerier returns the querier of the module descriptors, to be
+// registered at the ModulesQueryRoute. It serves the descriptors of all the
+// modules at its root path, and the descriptor of a module at its name.
+func (mm *ModuleManager) NewDescriptorQuerier() Querier {
+	return func(_ Context, path []string, _ abci.RequestQuery) ([]byte, Error) {
+		var res interface{} = mm.Descriptors()
+		if len(path) > 0 && path[0] != "" {
+			module, ok := mm.Modules[path[0]]
+			if !ok {
+				return nil, ErrUnknownRequest(fmt.Sprintf("unknown module %s", path[0]))
+			}
+			res = NewModuleDescriptor(module)
 		}
-		if module.QuerierRoute() != "" {
-			queryRouter.AddRoute(module.QuerierRoute(), module.NewQuerierHandler())
+
+		bz, err := codec.MarshalJSONIndent(codec.Cdc, res)
+		if err != nil {
+			return nil, ErrInternal(AppendMsgToErr("could not marshal result to JSON", err.Error()))
 		}
+		return bz, nil
 	}
 }
 
 // perform init genesis functionality for modules
 func (mm *ModuleManager) InitGenesis(ctx Context, genesisData map[string]json.RawMessage) abci.ResponseInitChain {
+	if err := mm.ValidateGenesis(genesisData); err != nil {
+		panic(err)
+	}
+
 	var validatorUpdates []abci.ValidatorUpdate
 	for _, moduleName := range mm.OrderInitGenesis {
 		if genesisData[moduleName] == nil {
 			continue
 		}
+		ctx.Logger().Info("initializing module genesis", "module", moduleName, "bytes", len(genesisData[moduleName]))
 		moduleValUpdates := mm.Modules[moduleName].InitGenesis(ctx, genesisData[moduleName])
 
 		// use these validator updates if provided, the module manager assumes
@@ -245,7 +442,9 @@ func (mm *ModuleManager) ExportGenesis(ctx Context) map[string]json.RawMessage {
 func (mm *ModuleManager) BeginBlock(ctx Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
 	tags := EmptyTags()
 	for _, moduleName := range mm.OrderBeginBlockers {
+		start, startGas := time.Now(), ctx.GasMeter().GasConsumed()
 		moduleTags := mm.Modules[moduleName].BeginBlock(ctx, req)
+		mm.recordBlocker(ctx, moduleName, beginBlocker, start, startGas)
 		tags = tags.AppendTags(moduleTags)
 	}
 
@@ -259,7 +458,9 @@ func (mm *ModuleManager) EndBlock(ctx Context, req abci.RequestEndBlock) abci.Re
 	validatorUpdates := []abci.ValidatorUpdate{}
 	tags := EmptyTags()
 	for _, moduleName := range mm.OrderEndBlockers {
+		start, startGas := time.Now(), ctx.GasMeter().GasConsumed()
 		moduleValUpdates, moduleTags := mm.Modules[moduleName].EndBlock(ctx, req)
+		mm.recordBlocker(ctx, moduleName, endBlocker, start, startGas)
 		tags = tags.AppendTags(moduleTags)
 
 		// use these validator updates if provided, the module manager assumes
@@ -278,4 +479,39 @@ func (mm *ModuleManager) EndBlock(ctx Context, req abci.RequestEndBlock) abci.Re
 	}
 }
 
+// recordBlocker reports the time and gas spent in the blocker of a module
+// since the given start, logging an error if it exceeded its gas budget
+func (mm *ModuleManager) recordBlocker(ctx Context, moduleName, blocker string, start time.Time, startGas uint64) {
+	duration := time.Since(start)
+	gas := ctx.GasMeter().GasConsumed() - startGas
+
+	mm.Metrics.BlockerDuration.With("module", moduleName, "blocker", blocker).Observe(duration.Seconds())
+	mm.Metrics.BlockerGas.With("module", moduleName, "blocker", blocker).Observe(float64(gas))
+
+	if budget, ok := mm.BlockerGasBudgets[moduleName]; ok && gas > budget {
+		mm.Metrics.GasBudgetExceeded.With("module", moduleName, "blocker", blocker).Add(1)
+		ctx.Logger().Error(fmt.Sprintf("%s of module %s consumed %d gas, exceeding its budget of %d gas",
+			blocker, moduleName, gas, budget), "height", ctx.BlockHeight(), "duration", duration)
+	}
+}
+
+// Close releases the resources of all the modules implementing io.Closer, in
+// reverse order of genesis initialization. All the modules are closed even if
+// one of them fails, the first error is returned.
+func (mm *ModuleManager) Close() error {
+	var firstErr error
+	for i := len(mm.OrderInitGenesis) - 1; i >= 0; i-- {
+		closer, ok := mm.Modules[mm.OrderInitGenesis[i]].(io.Closer)
+		if !ok {
+			continue
+		}
+
+		if err := closer.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
 // DONTCOVER
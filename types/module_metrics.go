@@ -0,0 +1,67 @@
+package types
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	prometheus "github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+// ModuleMetricsSubsystem is a subsystem shared by all metrics exposed by the
+// module manager.
+const ModuleMetricsSubsystem = "module"
+
+// blockers labelling the module metrics
+const (
+	beginBlocker = "begin_block"
+	endBlocker   = "end_block"
+)
+
+// ModuleMetrics contains the metrics exposed by the module manager. They are
+// labelled by module name and blocker, ie. "begin_block" or "end_block".
+type ModuleMetrics struct {
+	// Time spent in the blocker of a module, in seconds.
+	BlockerDuration metrics.Histogram
+	// Gas consumed by the blocker of a module.
+	BlockerGas metrics.Histogram
+	// Number of times the blocker of a module exceeded its gas budget.
+	GasBudgetExceeded metrics.Counter
+}
+
+// PrometheusModuleMetrics returns ModuleMetrics built using the Prometheus
+// client library. Optionally, labels can be provided along with their values
+// ("foo", "fooValue").
+func PrometheusModuleMetrics(namespace string, labelsAndValues ...string) *ModuleMetrics {
+	withLabels := MetricLabels(labelsAndValues...)
+
+	return &ModuleMetrics{
+		BlockerDuration: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: ModuleMetricsSubsystem,
+			Name:      "blocker_duration_seconds",
+			Help:      "Time spent in the blocker of a module.",
+		}, withLabels("module", "blocker")).With(labelsAndValues...),
+		BlockerGas: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: ModuleMetricsSubsystem,
+			Name:      "blocker_gas",
+			Help:      "Gas consumed by the blocker of a module.",
+			Buckets:   stdprometheus.ExponentialBuckets(1000, 4, 10),
+		}, withLabels("module", "blocker")).With(labelsAndValues...),
+		GasBudgetExceeded: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: ModuleMetricsSubsystem,
+			Name:      "gas_budget_exceeded",
+			Help:      "Number of times the blocker of a module exceeded its gas budget.",
+		}, withLabels("module", "blocker")).With(labelsAndValues...),
+	}
+}
+
+// NopModuleMetrics returns no-op ModuleMetrics.
+func NopModuleMetrics() *ModuleMetrics {
+	return &ModuleMetrics{
+		BlockerDuration:   discard.NewHistogram(),
+		BlockerGas:        discard.NewHistogram(),
+		GasBudgetExceeded: discard.NewCounter(),
+	}
+}
@@ -1,10 +1,17 @@
 package types
 
 import (
+	"encoding/json"
+	"errors"
 	"testing"
 
+	"github.com/go-kit/kit/metrics/generic"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
 )
 
 func TestSetOrderBeginBlockers(t *testing.T) {
@@ -14,3 +21,204 @@ func TestSetOrderBeginBlockers(t *testing.T) {
 	require.Equal(t, 3, len(obb))
 	assert.Equal(t, []string{"a", "b", "c"}, obb)
 }
+
+type closableModule struct {
+	GenesisOnlyAppModule
+	name   string
+	closed *[]string
+}
+
+func (m closableModule) Name() string { return m.name }
+
+func (m closableModule) Close() error {
+	*m.closed = append(*m.closed, m.name)
+	return nil
+}
+
+type nonClosableModule struct {
+	GenesisOnlyAppModule
+	name string
+}
+
+func (m nonClosableModule) Name() string { return m.name }
+
+func TestModuleManagerClose(t *testing.T) {
+	var closed []string
+	mm := NewModuleManager(
+		closableModule{name: "a", closed: &closed},
+		nonClosableModule{name: "b"},
+		closableModule{name: "c", closed: &closed},
+	)
+
+	require.NoError(t, mm.Close())
+	require.Equal(t, []string{"c", "a"}, closed)
+}
+
+func TestModuleManagerGenesisSizeLimits(t *testing.T) {
+	mm := NewModuleManager(nonClosableModule{name: "a"}, nonClosableModule{name: "b"})
+	mm.SetGenesisSizeLimit("a", 4)
+
+	genesis := map[string]json.RawMessage{"a": json.RawMessage(`[12]`), "b": json.RawMessage(`[1234]`)}
+	require.NoError(t, mm.ValidateGenesisSizes(genesis))
+
+	genesis["a"] = json.RawMessage(`[123]`)
+	require.Error(t, mm.ValidateGenesisSizes(genesis))
+}
+
+type validatingModuleBasic struct {
+	name string
+}
+
+func (mb validatingModuleBasic) Name() string                 { return mb.name }
+func (validatingModuleBasic) RegisterCodec(_ *codec.Codec)    {}
+func (validatingModuleBasic) DefaultGenesis() json.RawMessage { return json.RawMessage(`{}`) }
+
+func (validatingModuleBasic) ValidateGenesis(bz json.RawMessage) error {
+	if string(bz) != `{}` {
+		return errors.New("invalid genesis")
+	}
+	return nil
+}
+
+type describedModule struct {
+	nonClosableModule
+}
+
+func (describedModule) Route() string            { return "described" }
+func (describedModule) MsgTypes() []string       { return []string{"msg"} }
+func (describedModule) QueryPaths() []string     { return []string{"query"} }
+func (describedModule) ConsensusVersion() uint64 { return 2 }
+
+func TestModuleManagerDescriptors(t *testing.T) {
+	mm := NewModuleManager(describedModule{nonClosableModule{name: "b"}}, nonClosableModule{name: "a"})
+
+	expected := []ModuleDescriptor{
+		{Name: "a", MsgTypes: []string{}, QueryPaths: []string{}, ConsensusVersion: 1},
+		{Name: "b", Route: "described", MsgTypes: []string{"msg"}, QueryPaths: []string{"query"}, ConsensusVersion: 2},
+	}
+	require.Equal(t, expected, mm.Descriptors())
+
+	querier := mm.NewDescriptorQuerier()
+	bz, err := querier(Context{}, []string{}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var descs []ModuleDescriptor
+	require.NoError(t, codec.Cdc.UnmarshalJSON(bz, &descs))
+	require.Len(t, descs, 2)
+	require.Equal(t, "a", descs[0].Name)
+	require.Equal(t, expected[1], descs[1])
+
+	bz, err = querier(Context{}, []string{"b"}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var desc ModuleDescriptor
+	require.NoError(t, codec.Cdc.UnmarshalJSON(bz, &desc))
+	require.Equal(t, expected[1], desc)
+
+	_, err = querier(Context{}, []string{"c"}, abci.RequestQuery{})
+	require.Equal(t, CodeUnknownRequest, err.Code())
+}
+
+type testRouter map[string]Handler
+
+func (r testRouter) AddRoute(path string, h Handler) Router { r[path] = h; return r }
+func (r testRouter) Route(path string) Handler              { return r[path] }
+
+type testQueryRouter map[string]Querier
+
+func (r testQueryRouter) AddRoute(path string, q Querier) QueryRouter { r[path] = q; return r }
+func (r testQueryRouter) Route(path string) Querier                   { return r[path] }
+
+func TestModuleManagerDuplicates(t *testing.T) {
+	require.Panics(t, func() {
+		NewModuleManager(nonClosableModule{name: "a"}, nonClosableModule{name: "a"})
+	})
+
+	router, queryRouter := testRouter{}, testQueryRouter{}
+	NewModuleManager(describedModule{nonClosableModule{name: "a"}}, nonClosableModule{name: "b"}).
+		RegisterRoutes(router, queryRouter)
+	require.Len(t, router, 1)
+	require.Empty(t, queryRouter)
+
+	mm := NewModuleManager(describedModule{nonClosableModule{name: "a"}}, describedModule{nonClosableModule{name: "b"}})
+	require.Panics(t, func() { mm.RegisterRoutes(testRouter{}, testQueryRouter{}) })
+}
+
+type validatingModule struct {
+	validatingModuleBasic
+}
+
+func (validatingModule) InitGenesis(_ Context, _ json.RawMessage) []abci.ValidatorUpdate { return nil }
+func (validatingModule) ExportGenesis(_ Context) json.RawMessage                         { return nil }
+
+func TestModuleManagerValidateGenesis(t *testing.T) {
+	mm := NewModuleManager(
+		NewGenesisOnlyAppModule(validatingModule{validatingModuleBasic{"a"}}),
+		NewGenesisOnlyAppModule(validatingModule{validatingModuleBasic{"b"}}),
+		NewGenesisOnlyAppModule(validatingModule{validatingModuleBasic{"c"}}),
+	)
+	mm.SetGenesisSizeLimit("a", 2)
+
+	// missing genesis states are not validated
+	genesis := map[string]json.RawMessage{"a": json.RawMessage(`{}`), "b": json.RawMessage(`{}`)}
+	require.NoError(t, mm.ValidateGenesis(genesis))
+
+	genesis["b"] = json.RawMessage(`[]`)
+	genesis["c"] = json.RawMessage(`[]`)
+	err := mm.ValidateGenesis(genesis)
+	require.Error(t, err)
+	require.Equal(t, "invalid genesis:\nb: invalid genesis\nc: invalid genesis", err.Error())
+
+	genesis["a"] = json.RawMessage(`{ }`)
+	require.Contains(t, mm.ValidateGenesis(genesis).Error(), "exceeding the limit")
+	require.Panics(t, func() { mm.InitGenesis(Context{}, genesis) })
+}
+
+func TestModuleBasicManagerValidateGenesis(t *testing.T) {
+	mbm := NewModuleBasicManager(validatingModuleBasic{"a"}, validatingModuleBasic{"b"})
+	require.NoError(t, mbm.ValidateGenesis(mbm.DefaultGenesis()))
+
+	// the errors of all the invalid modules and unknown entries are returned
+	genesis := map[string]json.RawMessage{
+		"a": json.RawMessage(`{"foo":1}`),
+		"b": json.RawMessage(`{"foo":1}`),
+		"c": json.RawMessage(`{}`),
+	}
+	err := mbm.ValidateGenesis(genesis)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "a: invalid genesis")
+	require.Contains(t, err.Error(), "b: invalid genesis")
+	require.Contains(t, err.Error(), "c: unknown module genesis")
+}
+
+type gasModule struct {
+	nonClosableModule
+	gas uint64
+}
+
+func (m gasModule) BeginBlock(ctx Context, _ abci.RequestBeginBlock) Tags {
+	ctx.GasMeter().ConsumeGas(m.gas, "begin block")
+	return EmptyTags()
+}
+
+func TestModuleManagerBlockerGasBudgets(t *testing.T) {
+	mm := NewModuleManager(
+		gasModule{nonClosableModule{name: "a"}, 100},
+		gasModule{nonClosableModule{name: "b"}, 300},
+	)
+	mm.SetBlockerGasBudget("a", 200)
+	mm.SetBlockerGasBudget("b", 200)
+	metrics := &ModuleMetrics{
+		BlockerDuration:   generic.NewHistogram("blocker_duration_seconds", 50),
+		BlockerGas:        generic.NewHistogram("blocker_gas", 50),
+		GasBudgetExceeded: generic.NewCounter("gas_budget_exceeded"),
+	}
+	mm.SetMetrics(metrics)
+
+	// the blockers exceeding their budget still complete
+	ctx := NewContext(nil, abci.Header{}, false, log.NewNopLogger())
+	mm.BeginBlock(ctx, abci.RequestBeginBlock{})
+	require.Equal(t, uint64(400), ctx.GasMeter().GasConsumed())
+	require.Equal(t, float64(1), metrics.GasBudgetExceeded.(*generic.Counter).Value())
+
+	mm.EndBlock(ctx, abci.RequestEndBlock{})
+	require.Equal(t, float64(1), metrics.GasBudgetExceeded.(*generic.Counter).Value())
+}
@@ -0,0 +1,69 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// REST API versions
+const (
+	VersionV1Beta1 = "v1beta1"
+	VersionV1      = "v1"
+)
+
+// VersionedHandler is the handler of a route under an API version. Routes of
+// deprecated versions are still served, but every request to them is logged
+// and their responses carry deprecation warning headers.
+type VersionedHandler struct {
+	Version    string
+	Handler    http.HandlerFunc
+	Deprecated bool
+}
+
+// RegisterVersionedRoute registers the handlers of a route under the prefix
+// of their version, eg. /v1beta1/bank/balances/{address} and
+// /v1/bank/balances/{address}, so that both shapes of a changed response are
+// served while clients migrate to the new version.
+func RegisterVersionedRoute(r *mux.Router, logger log.Logger, path, method string, handlers ...VersionedHandler) {
+	// deprecated routes point to the latest non deprecated version
+	var successor string
+	for _, vh := range handlers {
+		if !vh.Deprecated {
+			successor = VersionedPath(vh.Version, path)
+		}
+	}
+
+	for _, vh := range handlers {
+		handler := vh.Handler
+		if vh.Deprecated {
+			handler = DeprecationHandler(logger, handler, successor)
+		}
+		r.HandleFunc(VersionedPath(vh.Version, path), handler).Methods(method)
+	}
+}
+
+// VersionedPath returns the path of a route under an API version.
+func VersionedPath(version, path string) string {
+	return fmt.Sprintf("/%s%s", version, path)
+}
+
+// DeprecationHandler wraps the handler of a deprecated route. It logs every
+// request and sets the Deprecation and Warning headers of the response,
+// pointing to the successor route if any.
+func DeprecationHandler(logger log.Logger, handler http.HandlerFunc, successor string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		warning := fmt.Sprintf("route %s is deprecated", r.URL.Path)
+		if successor != "" {
+			warning = fmt.Sprintf("%s, use %s instead", warning, successor)
+		}
+
+		logger.Info("deprecated route requested", "path", r.URL.Path, "successor", successor)
+
+		w.Header().Set("Deprecation", "true")
+		w.Header().Set("Warning", fmt.Sprintf("299 - %q", warning))
+		handler(w, r)
+	}
+}
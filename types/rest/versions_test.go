@@ -0,0 +1,45 @@
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func TestRegisterVersionedRoute(t *testing.T) {
+	r := mux.NewRouter()
+	RegisterVersionedRoute(r, log.NewNopLogger(), "/foo/{id}", "GET",
+		VersionedHandler{
+			Version:    VersionV1Beta1,
+			Deprecated: true,
+			Handler:    func(w http.ResponseWriter, r *http.Request) { w.Write([]byte("old")) }, // nolint: errcheck
+		},
+		VersionedHandler{
+			Version: VersionV1,
+			Handler: func(w http.ResponseWriter, r *http.Request) { w.Write([]byte("new")) }, // nolint: errcheck
+		},
+	)
+
+	// the deprecated version is still served, with warning headers
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, mustNewRequest(t, "GET", "/v1beta1/foo/1", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "old", w.Body.String())
+	require.Equal(t, "true", w.Header().Get("Deprecation"))
+	require.Contains(t, w.Header().Get("Warning"), "use /v1/foo/{id} instead")
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, mustNewRequest(t, "GET", "/v1/foo/1", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "new", w.Body.String())
+	require.Empty(t, w.Header().Get("Deprecation"))
+	require.Empty(t, w.Header().Get("Warning"))
+
+	w = httptest.NewRecorder()
+	r.ServeHTTP(w, mustNewRequest(t, "GET", "/foo/1", nil))
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
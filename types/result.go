@@ -44,11 +44,14 @@ func (res Result) IsOK() bool {
 // ABCIMessageLogs represents a slice of ABCIMessageLog.
 type ABCIMessageLogs []ABCIMessageLog
 
-// ABCIMessageLog defines a structure containing an indexed tx ABCI message log.
+// ABCIMessageLog defines a structure containing an indexed tx ABCI message log,
+// along with the gas used and the tags of the message.
 type ABCIMessageLog struct {
-	MsgIndex uint16 `json:"msg_index"`
-	Success  bool   `json:"success"`
-	Log      string `json:"log"`
+	MsgIndex uint16     `json:"msg_index"`
+	Success  bool       `json:"success"`
+	Log      string     `json:"log"`
+	GasUsed  uint64     `json:"gas_used,omitempty"`
+	Tags     StringTags `json:"tags,omitempty"`
 }
 
 // String implements the fmt.Stringer interface for the ABCIMessageLogs type.
@@ -0,0 +1,142 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ----------------------------------------------------------------------------
+// Signed Decimal Coins
+
+// SignedDecCoins is a set of decimal coins which, unlike DecCoins, may hold
+// negative amounts. It is meant to be used by modules performing double-entry
+// accounting, where every debit must be matched by a credit, before settling
+// the result into regular DecCoins.
+//
+// CONTRACT: SignedDecCoins are sorted by denomination and never hold a zero
+// amount.
+type SignedDecCoins []DecCoin
+
+// NewSignedDecCoins returns a new set of signed decimal coins from a set of
+// decimal coins.
+func NewSignedDecCoins(coins DecCoins) SignedDecCoins {
+	return SignedDecCoins(removeZeroDecCoins(append(DecCoins{}, coins...).Sort()))
+}
+
+// String implements the Stringer interface for SignedDecCoins.
+func (coins SignedDecCoins) String() string {
+	return DecCoins(coins).String()
+}
+
+// Validate checks that the signed coins are sorted, have a non-zero amount and
+// a valid and unique denomination.
+func (coins SignedDecCoins) Validate() error {
+	lowDenom := ""
+	for i, coin := range coins {
+		if err := validateDenom(coin.Denom); err != nil {
+			return err
+		}
+		if i > 0 && coin.Denom == lowDenom {
+			return fmt.Errorf("duplicate denomination %s", coin.Denom)
+		}
+		if i > 0 && coin.Denom < lowDenom {
+			return fmt.Errorf("denomination %s is not sorted", coin.Denom)
+		}
+		if coin.IsZero() {
+			return fmt.Errorf("coin %s amount is zero", coin)
+		}
+
+		lowDenom = coin.Denom
+	}
+
+	return nil
+}
+
+// Add adds two sets of signed decimal coins. Denominations whose amounts
+// cancel out are removed from the result.
+func (coins SignedDecCoins) Add(coinsB SignedDecCoins) SignedDecCoins {
+	return SignedDecCoins(DecCoins(coins).safeAdd(DecCoins(coinsB)))
+}
+
+// Sub subtracts a set of signed decimal coins from another. Unlike
+// DecCoins.Sub, it never panics on a negative result.
+func (coins SignedDecCoins) Sub(coinsB SignedDecCoins) SignedDecCoins {
+	return SignedDecCoins(DecCoins(coins).safeAdd(DecCoins(coinsB).negative()))
+}
+
+// Credit adds a set of decimal coins to the signed coins.
+func (coins SignedDecCoins) Credit(amount DecCoins) SignedDecCoins {
+	return coins.Add(NewSignedDecCoins(amount))
+}
+
+// Debit subtracts a set of decimal coins from the signed coins.
+func (coins SignedDecCoins) Debit(amount DecCoins) SignedDecCoins {
+	return coins.Sub(NewSignedDecCoins(amount))
+}
+
+// AmountOf returns the signed amount of a denom.
+func (coins SignedDecCoins) AmountOf(denom string) Dec {
+	mustValidateDenom(denom)
+
+	for _, coin := range coins {
+		if coin.Denom == denom {
+			return coin.Amount
+		}
+	}
+
+	return ZeroDec()
+}
+
+// IsAnyNegative returns true if at least one denomination has a negative
+// amount.
+func (coins SignedDecCoins) IsAnyNegative() bool {
+	return DecCoins(coins).IsAnyNegative()
+}
+
+// IsBalanced returns true if every denomination nets to zero.
+func (coins SignedDecCoins) IsBalanced() bool {
+	return DecCoins(coins).IsZero()
+}
+
+// ValidateBalanced returns an error listing the denominations which do not net
+// to zero.
+func (coins SignedDecCoins) ValidateBalanced() error {
+	if coins.IsBalanced() {
+		return nil
+	}
+
+	unbalanced := make([]string, 0, len(coins))
+	for _, coin := range coins {
+		if !coin.IsZero() {
+			unbalanced = append(unbalanced, coin.String())
+		}
+	}
+
+	return fmt.Errorf("signed decimal coins are not balanced: %s", strings.Join(unbalanced, ","))
+}
+
+// Settle splits the signed coins into the credited (positive) and debited
+// (negative) decimal coins, both expressed as positive amounts.
+func (coins SignedDecCoins) Settle() (credits, debits DecCoins) {
+	for _, coin := range coins {
+		switch {
+		case coin.IsPositive():
+			credits = append(credits, coin)
+		case coin.IsNegative():
+			debits = append(debits, DecCoin{Denom: coin.Denom, Amount: coin.Amount.Neg()})
+		}
+	}
+
+	return credits, debits
+}
+
+// ToDecCoins converts the signed coins into regular decimal coins. An error is
+// returned if any denomination has a negative amount.
+func (coins SignedDecCoins) ToDecCoins() (DecCoins, error) {
+	credits, debits := coins.Settle()
+	if len(debits) > 0 {
+		return nil, fmt.Errorf("cannot settle negative decimal coins: %s", debits)
+	}
+
+	return credits, nil
+}
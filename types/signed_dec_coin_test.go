@@ -0,0 +1,67 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSignedDecCoins(t *testing.T) {
+	coins := NewSignedDecCoins(DecCoins{NewInt64DecCoin(testDenom2, 1), NewInt64DecCoin(testDenom1, 0)})
+	require.Equal(t, SignedDecCoins{NewInt64DecCoin(testDenom2, 1)}, coins)
+	require.NoError(t, coins.Validate())
+}
+
+func TestSignedDecCoinsValidate(t *testing.T) {
+	testCases := []struct {
+		input     SignedDecCoins
+		expectErr bool
+	}{
+		{SignedDecCoins{}, false},
+		{SignedDecCoins{DecCoin{testDenom1, NewDec(-5)}, DecCoin{testDenom2, NewDec(5)}}, false},
+		{SignedDecCoins{DecCoin{testDenom1, ZeroDec()}}, true},
+		{SignedDecCoins{DecCoin{"AAA", NewDec(-5)}}, true},
+		{SignedDecCoins{DecCoin{testDenom2, NewDec(-5)}, DecCoin{testDenom1, NewDec(5)}}, true},
+		{SignedDecCoins{DecCoin{testDenom1, NewDec(-5)}, DecCoin{testDenom1, NewDec(5)}}, true},
+	}
+
+	for i, tc := range testCases {
+		err := tc.input.Validate()
+		if tc.expectErr {
+			require.Error(t, err, "expected error for test case #%d, input: %v", i, tc.input)
+		} else {
+			require.NoError(t, err, "unexpected error for test case #%d, input: %v", i, tc.input)
+		}
+	}
+}
+
+func TestSignedDecCoinsArithmetic(t *testing.T) {
+	var ledger SignedDecCoins
+
+	ledger = ledger.Debit(DecCoins{NewInt64DecCoin(testDenom1, 10)})
+	require.True(t, ledger.IsAnyNegative())
+	require.Equal(t, NewDec(-10), ledger.AmountOf(testDenom1))
+	require.False(t, ledger.IsBalanced())
+	require.Error(t, ledger.ValidateBalanced())
+
+	ledger = ledger.Credit(DecCoins{NewInt64DecCoin(testDenom1, 4), NewInt64DecCoin(testDenom2, 3)})
+	require.Equal(t, NewDec(-6), ledger.AmountOf(testDenom1))
+	require.Equal(t, NewDec(3), ledger.AmountOf(testDenom2))
+
+	credits, debits := ledger.Settle()
+	require.Equal(t, DecCoins{NewInt64DecCoin(testDenom2, 3)}, credits)
+	require.Equal(t, DecCoins{NewInt64DecCoin(testDenom1, 6)}, debits)
+
+	_, err := ledger.ToDecCoins()
+	require.Error(t, err)
+
+	ledger = ledger.Credit(DecCoins{NewInt64DecCoin(testDenom1, 6)})
+	coins, err := ledger.ToDecCoins()
+	require.NoError(t, err)
+	require.Equal(t, DecCoins{NewInt64DecCoin(testDenom2, 3)}, coins)
+
+	ledger = ledger.Sub(SignedDecCoins{NewInt64DecCoin(testDenom2, 3)})
+	require.True(t, ledger.IsBalanced())
+	require.NoError(t, ledger.ValidateBalanced())
+	require.Empty(t, ledger)
+}
@@ -1,8 +1,11 @@
 package types
 
 import (
+	"fmt"
+
 	cmn "github.com/tendermint/tendermint/libs/common"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/types"
 )
 
@@ -22,6 +25,9 @@ type (
 	CommitMultiStore = types.CommitMultiStore
 	KVStore          = types.KVStore
 	Iterator         = types.Iterator
+
+	MultiStorePersistentCache = types.MultiStorePersistentCache
+	WriteListener             = types.WriteListener
 )
 
 // Iterator over all the keys with a certain prefix in ascending order
@@ -41,6 +47,34 @@ func DiffKVStores(a KVStore, b KVStore, prefixesToSkip [][]byte) (kvA cmn.KVPair
 	return types.DiffKVStores(a, b, prefixesToSkip)
 }
 
+// StoreDecoder decodes a key/value pair of a module's store into a human
+// readable string.
+type StoreDecoder func(cdc *codec.Codec, kvPair cmn.KVPair) string
+
+// StoreDecoderRegistry maps store names to the decoder of their key/value
+// pairs.
+type StoreDecoderRegistry map[string]StoreDecoder
+
+// Decode returns the human readable representation of a key/value pair of the
+// given store. If no decoder is registered for the store, or if the decoder
+// panics on the pair, the raw key and value are returned in hex.
+func (sdr StoreDecoderRegistry) Decode(cdc *codec.Codec, storeName string, kvPair cmn.KVPair) (res string) {
+	decoder, ok := sdr[storeName]
+	if !ok {
+		return fmt.Sprintf("%X => %X", kvPair.Key, kvPair.Value)
+	}
+
+	// decoders panic on the keys they don't know, eg. written by another
+	// version of the module
+	defer func() {
+		if r := recover(); r != nil {
+			res = fmt.Sprintf("%X => %X", kvPair.Key, kvPair.Value)
+		}
+	}()
+
+	return decoder(cdc, kvPair)
+}
+
 // nolint - reexport
 type (
 	CacheKVStore  = types.CacheKVStore
@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
 )
 
 func TestPrefixEndBytes(t *testing.T) {
@@ -36,3 +39,21 @@ func TestCommitID(t *testing.T) {
 	}
 	require.False(t, nonempty.IsZero())
 }
+
+func TestStoreDecoderRegistryDecode(t *testing.T) {
+	decoders := StoreDecoderRegistry{
+		"known": func(_ *codec.Codec, kvPair cmn.KVPair) string {
+			if len(kvPair.Key) == 0 {
+				panic("empty key")
+			}
+			return string(kvPair.Key)
+		},
+	}
+	cdc := codec.New()
+
+	require.Equal(t, "key", decoders.Decode(cdc, "known", cmn.KVPair{Key: []byte("key")}))
+
+	// unknown stores and keys fall back to the raw key and value in hex
+	require.Equal(t, "6B6579 => 0102", decoders.Decode(cdc, "other", cmn.KVPair{Key: []byte("key"), Value: []byte{0x01, 0x02}}))
+	require.Equal(t, " => 0102", decoders.Decode(cdc, "known", cmn.KVPair{Value: []byte{0x01, 0x02}}))
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/tendermint/tendermint/crypto/ed25519"
@@ -34,115 +35,307 @@ type SignatureVerificationGasConsumer = func(meter sdk.GasMeter, sig []byte, pub
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & account numbers, and deducts fees from the first
-// signer.
+// signer. It chains the decorators of this package, applications that need
+// additional checks can chain them in the same way with their own decorators.
 func NewAnteHandler(ak AccountKeeper, fck FeeCollectionKeeper, sigGasConsumer SignatureVerificationGasConsumer) sdk.AnteHandler {
-	return func(
-		ctx sdk.Context, tx sdk.Tx, simulate bool,
-	) (newCtx sdk.Context, res sdk.Result, abort bool) {
-
-		// all transactions must be of type auth.StdTx
-		stdTx, ok := tx.(StdTx)
-		if !ok {
-			// Set a gas meter with limit 0 as to prevent an infinite gas meter attack
-			// during runTx.
-			newCtx = SetGasMeter(simulate, ctx, 0)
-			return newCtx, sdk.ErrInternal("tx must be StdTx").Result(), true
-		}
+	return sdk.ChainAnteDecorators(
+		NewSetUpContextDecorator(), // must be the first decorator
+		NewMempoolFeeDecorator(),
+		NewValidateBasicDecorator(ak),
+		NewConsumeTxSizeGasDecorator(ak),
+		NewValidateMemoDecorator(ak),
+		NewDeductFeeDecorator(ak, fck),
+		NewSigVerificationDecorator(ak, sigGasConsumer),
+	)
+}
 
-		params := ak.GetParams(ctx)
+// SetUpContextDecorator sets the gas meter of the transaction from its fee and
+// recovers from out of gas panics of the following decorators, reporting the
+// gas used. It must be the first decorator of the chain, the others expect a
+// StdTx.
+type SetUpContextDecorator struct{}
 
-		// Ensure that the provided fees meet a minimum threshold for the validator,
-		// if this is a CheckTx. This is only for local mempool purposes, and thus
-		// is only ran on check tx.
-		if ctx.IsCheckTx() && !simulate {
-			res := EnsureSufficientMempoolFees(ctx, stdTx.Fee)
-			if !res.IsOK() {
-				return newCtx, res, true
+// NewSetUpContextDecorator returns a new SetUpContextDecorator.
+func NewSetUpContextDecorator() SetUpContextDecorator {
+	return SetUpContextDecorator{}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (sud SetUpContextDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (newCtx sdk.Context, res sdk.Result, abort bool) {
+
+	// all transactions must be of type auth.StdTx
+	stdTx, ok := tx.(StdTx)
+	if !ok {
+		// Set a gas meter with limit 0 as to prevent an infinite gas meter attack
+		// during runTx.
+		newCtx = SetGasMeter(simulate, ctx, 0)
+		return newCtx, sdk.ErrInternal("tx must be StdTx").Result(), true
+	}
+
+	newCtx = SetGasMeter(simulate, ctx, stdTx.Fee.Gas)
+
+	// AnteHandlers must have their own defer/recover in order for the BaseApp
+	// to know how much gas was used! This is because the GasMeter is created in
+	// the AnteHandler, but if it panics the context won't be set properly in
+	// runTx's recover call.
+	defer func() {
+		if r := recover(); r != nil {
+			switch rType := r.(type) {
+			case sdk.ErrorOutOfGas:
+				log := fmt.Sprintf(
+					"out of gas in location: %v; gasWanted: %d, gasUsed: %d",
+					rType.Descriptor, stdTx.Fee.Gas, newCtx.GasMeter().GasConsumed(),
+				)
+				res = sdk.ErrOutOfGas(log).Result()
+
+				res.GasWanted = stdTx.Fee.Gas
+				res.GasUsed = newCtx.GasMeter().GasConsumed()
+				abort = true
+			default:
+				panic(r)
 			}
 		}
+	}()
 
-		newCtx = SetGasMeter(simulate, ctx, stdTx.Fee.Gas)
-
-		// AnteHandlers must have their own defer/recover in order for the BaseApp
-		// to know how much gas was used! This is because the GasMeter is created in
-		// the AnteHandler, but if it panics the context won't be set properly in
-		// runTx's recover call.
-		defer func() {
-			if r := recover(); r != nil {
-				switch rType := r.(type) {
-				case sdk.ErrorOutOfGas:
-					log := fmt.Sprintf(
-						"out of gas in location: %v; gasWanted: %d, gasUsed: %d",
-						rType.Descriptor, stdTx.Fee.Gas, newCtx.GasMeter().GasConsumed(),
-					)
-					res = sdk.ErrOutOfGas(log).Result()
-
-					res.GasWanted = stdTx.Fee.Gas
-					res.GasUsed = newCtx.GasMeter().GasConsumed()
-					abort = true
-				default:
-					panic(r)
-				}
-			}
-		}()
+	newCtx, res, abort = next(newCtx, tx, simulate)
+	if !abort {
+		res.GasWanted = stdTx.Fee.Gas
+	}
+	return newCtx, res, abort
+}
 
-		if err := tx.ValidateBasic(); err != nil {
-			return newCtx, err.Result(), true
-		}
+// MempoolFeeDecorator ensures that the provided fees meet a minimum threshold
+// for the validator, if this is a CheckTx. This is only for local mempool
+// purposes, and thus is only ran on check tx.
+type MempoolFeeDecorator struct{}
 
-		newCtx.GasMeter().ConsumeGas(params.TxSizeCostPerByte*sdk.Gas(len(newCtx.TxBytes())), "txSize")
+// NewMempoolFeeDecorator returns a new MempoolFeeDecorator.
+func NewMempoolFeeDecorator() MempoolFeeDecorator {
+	return MempoolFeeDecorator{}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (mfd MempoolFeeDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (newCtx sdk.Context, res sdk.Result, abort bool) {
 
-		if res := ValidateMemo(stdTx, params); !res.IsOK() {
-			return newCtx, res, true
+	if ctx.IsCheckTx() && !simulate {
+		if res := EnsureSufficientMempoolFees(ctx, tx.(StdTx).Fee); !res.IsOK() {
+			return ctx, res, true
 		}
+	}
 
-		// stdSigs contains the sequence number, account number, and signatures.
-		// When simulating, this would just be a 0-length slice.
-		signerAddrs := stdTx.GetSigners()
-		signerAccs := make([]Account, len(signerAddrs))
-		isGenesis := ctx.BlockHeight() == 0
+	return next(ctx, tx, simulate)
+}
 
-		// fetch first signer, who's going to pay the fees
-		signerAccs[0], res = GetSignerAcc(newCtx, ak, signerAddrs[0])
-		if !res.IsOK() {
-			return newCtx, res, true
-		}
+// ValidateBasicDecorator runs the stateless checks of the transaction and
+// validates it against the chain-wide message and size limits.
+type ValidateBasicDecorator struct {
+	ak AccountKeeper
+}
 
-		if !stdTx.Fee.Amount.IsZero() {
-			signerAccs[0], res = DeductFees(ctx.BlockHeader().Time, signerAccs[0], stdTx.Fee)
-			if !res.IsOK() {
-				return newCtx, res, true
-			}
+// NewValidateBasicDecorator returns a new ValidateBasicDecorator.
+func NewValidateBasicDecorator(ak AccountKeeper) ValidateBasicDecorator {
+	return ValidateBasicDecorator{ak: ak}
+}
 
-			fck.AddCollectedFees(newCtx, stdTx.Fee.Amount)
-		}
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (vbd ValidateBasicDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (newCtx sdk.Context, res sdk.Result, abort bool) {
 
-		// stdSigs contains the sequence number, account number, and signatures.
-		// When simulating, this would just be a 0-length slice.
-		stdSigs := stdTx.GetSignatures()
-
-		for i := 0; i < len(stdSigs); i++ {
-			// skip the fee payer, account is cached and fees were deducted already
-			if i != 0 {
-				signerAccs[i], res = GetSignerAcc(newCtx, ak, signerAddrs[i])
-				if !res.IsOK() {
-					return newCtx, res, true
-				}
-			}
+	if err := tx.ValidateBasic(); err != nil {
+		return ctx, err.Result(), true
+	}
+
+	if res := ValidateTxLimits(tx.(StdTx), ctx.TxBytes(), getParams(ctx, vbd.ak)); !res.IsOK() {
+		return ctx, res, true
+	}
 
-			// check signature, return account with incremented nonce
-			signBytes := GetSignBytes(newCtx.ChainID(), stdTx, signerAccs[i], isGenesis)
-			signerAccs[i], res = processSig(newCtx, signerAccs[i], stdSigs[i], signBytes, simulate, params, sigGasConsumer)
+	return next(ctx, tx, simulate)
+}
+
+// ConsumeTxSizeGasDecorator consumes gas proportionally to the encoded size of
+// the transaction.
+type ConsumeTxSizeGasDecorator struct {
+	ak AccountKeeper
+}
+
+// NewConsumeTxSizeGasDecorator returns a new ConsumeTxSizeGasDecorator.
+func NewConsumeTxSizeGasDecorator(ak AccountKeeper) ConsumeTxSizeGasDecorator {
+	return ConsumeTxSizeGasDecorator{ak: ak}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (cgd ConsumeTxSizeGasDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (newCtx sdk.Context, res sdk.Result, abort bool) {
+
+	params := getParams(ctx, cgd.ak)
+	ctx.GasMeter().ConsumeGas(params.TxSizeCostPerByte*sdk.Gas(len(ctx.TxBytes())), "txSize")
+
+	return next(ctx, tx, simulate)
+}
+
+// ValidateMemoDecorator validates the memo size of the transaction.
+type ValidateMemoDecorator struct {
+	ak AccountKeeper
+}
+
+// NewValidateMemoDecorator returns a new ValidateMemoDecorator.
+func NewValidateMemoDecorator(ak AccountKeeper) ValidateMemoDecorator {
+	return ValidateMemoDecorator{ak: ak}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (vmd ValidateMemoDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (newCtx sdk.Context, res sdk.Result, abort bool) {
+
+	if res := ValidateMemo(tx.(StdTx), getParams(ctx, vmd.ak)); !res.IsOK() {
+		return ctx, res, true
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// feePayerKey is the context key of the fee payer deducted by the
+// DeductFeeDecorator.
+type feePayerKey struct{}
+
+// feePayer holds the account of the fee payer until it is stored by the
+// DeductFeeDecorator, once the following decorators are done with it. This
+// way the fee payer is loaded and stored only once per transaction.
+type feePayer struct {
+	acc Account
+}
+
+// getFeePayer returns the fee payer passed forward by the DeductFeeDecorator,
+// if any, for the given address.
+func getFeePayer(ctx sdk.Context, addr sdk.AccAddress) (*feePayer, bool) {
+	payer, ok := ctx.Value(feePayerKey{}).(*feePayer)
+	if !ok || !payer.acc.GetAddress().Equals(addr) {
+		return nil, false
+	}
+	return payer, true
+}
+
+// DeductFeeDecorator validates the fee denominations and deducts the fees from
+// the first signer, adding them to the collected fees. The fee payer account
+// is passed forward to the following decorators through the context and stored
+// once they succeed.
+type DeductFeeDecorator struct {
+	ak  AccountKeeper
+	fck FeeCollectionKeeper
+}
+
+// NewDeductFeeDecorator returns a new DeductFeeDecorator.
+func NewDeductFeeDecorator(ak AccountKeeper, fck FeeCollectionKeeper) DeductFeeDecorator {
+	return DeductFeeDecorator{ak: ak, fck: fck}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (dfd DeductFeeDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (newCtx sdk.Context, res sdk.Result, abort bool) {
+
+	stdTx := tx.(StdTx)
+
+	if res := ValidateFeeDenoms(stdTx.Fee, getParams(ctx, dfd.ak)); !res.IsOK() {
+		return ctx, res, true
+	}
+
+	if stdTx.Fee.Amount.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	// the first signer pays the fees
+	payerAcc, res := GetSignerAcc(ctx, dfd.ak, stdTx.GetSigners()[0])
+	if !res.IsOK() {
+		return ctx, res, true
+	}
+
+	payerAcc, res = DeductFees(ctx.BlockHeader().Time, payerAcc, stdTx.Fee)
+	if !res.IsOK() {
+		return ctx, res, true
+	}
+
+	dfd.fck.AddCollectedFees(ctx, stdTx.Fee.Amount)
+
+	payer := &feePayer{acc: payerAcc}
+	newCtx, res, abort = next(ctx.WithValue(feePayerKey{}, payer), tx, simulate)
+	if abort {
+		return newCtx, res, abort
+	}
+
+	dfd.ak.SetAccount(newCtx, payer.acc)
+	return newCtx, res, abort
+}
+
+// SigVerificationDecorator checks the signatures and account numbers of the
+// signers and increments their sequence numbers. The fee payer passed forward
+// by the DeductFeeDecorator is updated in place rather than loaded and stored
+// again.
+type SigVerificationDecorator struct {
+	ak             AccountKeeper
+	sigGasConsumer SignatureVerificationGasConsumer
+}
+
+// NewSigVerificationDecorator returns a new SigVerificationDecorator.
+func NewSigVerificationDecorator(ak AccountKeeper, sigGasConsumer SignatureVerificationGasConsumer) SigVerificationDecorator {
+	return SigVerificationDecorator{ak: ak, sigGasConsumer: sigGasConsumer}
+}
+
+// AnteHandle implements the sdk.AnteDecorator interface.
+func (svd SigVerificationDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler,
+) (newCtx sdk.Context, res sdk.Result, abort bool) {
+
+	stdTx := tx.(StdTx)
+	params := getParams(ctx, svd.ak)
+	signerAddrs := stdTx.GetSigners()
+	isGenesis := ctx.BlockHeight() == 0
+
+	// stdSigs contains the sequence number, account number, and signatures.
+	// When simulating, this would just be a 0-length slice.
+	stdSigs := stdTx.GetSignatures()
+
+	for i := 0; i < len(stdSigs); i++ {
+		var signerAcc Account
+
+		payer, isPayer := getFeePayer(ctx, signerAddrs[i])
+		if isPayer {
+			signerAcc = payer.acc
+		} else {
+			signerAcc, res = GetSignerAcc(ctx, svd.ak, signerAddrs[i])
 			if !res.IsOK() {
-				return newCtx, res, true
+				return ctx, res, true
 			}
+		}
 
-			ak.SetAccount(newCtx, signerAccs[i])
+		// check signature, return account with incremented nonce
+		signBytes := GetSignBytes(ctx.ChainID(), stdTx, signerAcc, isGenesis)
+		signerAcc, res = processSig(ctx, signerAcc, stdSigs[i], signBytes, simulate, params, svd.sigGasConsumer)
+		if !res.IsOK() {
+			return ctx, res, true
 		}
 
-		// TODO: tx tags (?)
-		return newCtx, sdk.Result{GasWanted: stdTx.Fee.Gas}, false // continue...
+		if isPayer {
+			payer.acc = signerAcc
+			continue
+		}
+		svd.ak.SetAccount(ctx, signerAcc)
 	}
+
+	return next(ctx, tx, simulate)
+}
+
+// getParams returns the auth parameters without charging the read to the gas
+// meter of the transaction.
+func getParams(ctx sdk.Context, ak AccountKeeper) Params {
+	return ak.GetParams(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()))
 }
 
 // GetSignerAcc returns an account for a given address that is expected to sign
@@ -169,6 +362,61 @@ func ValidateMemo(stdTx StdTx, params Params) sdk.Result {
 	return sdk.Result{}
 }
 
+// ValidateFeeDenoms validates that the fee of a transaction is only paid in
+// the denominations accepted by the chain, if it restricts them.
+func ValidateFeeDenoms(fee StdFee, params Params) sdk.Result {
+	if len(params.FeeDenoms) == 0 {
+		return sdk.Result{}
+	}
+
+	for _, coin := range fee.Amount {
+		accepted := false
+		for _, denom := range params.FeeDenoms {
+			if coin.Denom == denom {
+				accepted = true
+				break
+			}
+		}
+
+		if !accepted {
+			return sdk.ErrInvalidFeeDenom(
+				fmt.Sprintf(
+					"fee denomination %s is not accepted, accepted denominations are: %s",
+					coin.Denom, strings.Join(params.FeeDenoms, ", "),
+				),
+			).Result()
+		}
+	}
+
+	return sdk.Result{}
+}
+
+// ValidateTxLimits validates the number of messages and the encoded size of a
+// transaction against the chain-wide limits.
+func ValidateTxLimits(stdTx StdTx, txBytes []byte, params Params) sdk.Result {
+	numMsgs := len(stdTx.GetMsgs())
+	if uint64(numMsgs) > params.MaxMsgsPerTx {
+		return sdk.ErrTooManyMsgs(
+			fmt.Sprintf(
+				"maximum number of messages is %d but received %d messages",
+				params.MaxMsgsPerTx, numMsgs,
+			),
+		).Result()
+	}
+
+	txSize := len(txBytes)
+	if uint64(txSize) > params.MaxTxBytes {
+		return sdk.ErrTxTooLarge(
+			fmt.Sprintf(
+				"maximum tx size is %d bytes but received %d bytes",
+				params.MaxTxBytes, txSize,
+			),
+		).Result()
+	}
+
+	return sdk.Result{}
+}
+
 // verify the signature and increment the sequence. If the account doesn't have
 // a pubkey, set it.
 func processSig(
@@ -346,15 +594,9 @@ func DeductFees(blockTime time.Time, acc Account, fee StdFee) (Account, sdk.Resu
 func EnsureSufficientMempoolFees(ctx sdk.Context, stdFee StdFee) sdk.Result {
 	minGasPrices := ctx.MinGasPrices()
 	if !minGasPrices.IsZero() {
-		requiredFees := make(sdk.Coins, len(minGasPrices))
-
 		// Determine the required fees by multiplying each required minimum gas
 		// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
-		glDec := sdk.NewDec(int64(stdFee.Gas))
-		for i, gp := range minGasPrices {
-			fee := gp.Amount.Mul(glDec)
-			requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
-		}
+		requiredFees := sdk.NewFeeFromGasPrices(minGasPrices, stdFee.Gas).ToCoins()
 
 		if !stdFee.Amount.IsAnyGTE(requiredFees) {
 			return sdk.ErrInsufficientFee(
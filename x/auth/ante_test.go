@@ -707,6 +707,74 @@ func TestAnteHandlerSigLimitExceeded(t *testing.T) {
 	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeTooManySignatures)
 }
 
+func TestAnteHandlerTxLimits(t *testing.T) {
+	// setup
+	input := setupTestInput()
+	anteHandler := NewAnteHandler(input.ak, input.fck, DefaultSigVerificationGasConsumer)
+	ctx := input.ctx.WithBlockHeight(1)
+
+	params := input.ak.GetParams(ctx)
+	params.MaxMsgsPerTx = 1
+	params.MaxTxBytes = 100
+	input.ak.SetParams(ctx, params)
+
+	// keys and addresses
+	priv1, _, addr1 := keyPubAddr()
+
+	// set the accounts
+	acc1 := input.ak.NewAccountWithAddress(ctx, addr1)
+	acc1.SetCoins(newCoins())
+	input.ak.SetAccount(ctx, acc1)
+
+	var tx sdk.Tx
+	msg := newTestMsg(addr1)
+	privs, accnums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	fee := newStdFee()
+
+	// too many messages
+	tx = newTestTx(ctx, []sdk.Msg{msg, msg}, privs, accnums, seqs, fee)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeTooManyMsgs)
+
+	// tx too large
+	tx = newTestTx(ctx, []sdk.Msg{msg}, privs, accnums, seqs, fee)
+	checkInvalidTx(t, anteHandler, ctx.WithTxBytes(make([]byte, 101)), tx, false, sdk.CodeTxTooLarge)
+
+	// tx within the limits
+	checkValidTx(t, anteHandler, ctx.WithTxBytes(make([]byte, 100)), tx, false)
+}
+
+func TestAnteHandlerFeeDenoms(t *testing.T) {
+	// setup
+	input := setupTestInput()
+	anteHandler := NewAnteHandler(input.ak, input.fck, DefaultSigVerificationGasConsumer)
+	ctx := input.ctx.WithBlockHeight(1)
+
+	params := input.ak.GetParams(ctx)
+	params.FeeDenoms = []string{"stake"}
+	input.ak.SetParams(ctx, params)
+
+	// keys and addresses
+	priv1, _, addr1 := keyPubAddr()
+
+	// set the accounts
+	acc1 := input.ak.NewAccountWithAddress(ctx, addr1)
+	acc1.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("atom", 10000000), sdk.NewInt64Coin("stake", 10000000)))
+	input.ak.SetAccount(ctx, acc1)
+
+	var tx sdk.Tx
+	msg := newTestMsg(addr1)
+	privs, accnums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+
+	// fee in a denomination that is not accepted
+	tx = newTestTx(ctx, []sdk.Msg{msg}, privs, accnums, seqs, newStdFee())
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeInvalidFeeDenom)
+
+	// fee in an accepted denomination
+	fee := NewStdFee(50000, sdk.NewCoins(sdk.NewInt64Coin("stake", 150)))
+	tx = newTestTx(ctx, []sdk.Msg{msg}, privs, accnums, seqs, fee)
+	checkValidTx(t, anteHandler, ctx, tx, false)
+}
+
 func TestEnsureSufficientMempoolFees(t *testing.T) {
 	// setup
 	input := setupTestInput()
@@ -800,3 +868,121 @@ func TestCustomSignatureVerificationGasConsumer(t *testing.T) {
 	tx = newTestTx(ctx, msgs, privs, accnums, seqs, fee)
 	checkValidTx(t, anteHandler, ctx, tx, false)
 }
+
+func TestSetUpContextDecorator(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx.WithBlockHeight(1)
+	outOfGas := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, sdk.Result, bool) {
+		ctx.GasMeter().ConsumeGas(tx.(StdTx).Fee.Gas+1, "test")
+		return ctx, sdk.Result{}, false
+	}
+
+	// only StdTx are accepted
+	newCtx, res, abort := NewSetUpContextDecorator().AnteHandle(ctx, nonStdTx{}, false, outOfGas)
+	require.True(t, abort)
+	require.Equal(t, sdk.CodeInternal, res.Code)
+	require.Equal(t, uint64(0), newCtx.GasMeter().Limit())
+
+	// out of gas panics of the following decorators are recovered
+	priv1, _, addr1 := keyPubAddr()
+	tx := newTestTx(ctx, []sdk.Msg{newTestMsg(addr1)}, []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}, newStdFee())
+	checkInvalidTx(t, sdk.ChainAnteDecorators(NewSetUpContextDecorator(), anteDecoratorFunc(outOfGas)), ctx, tx, false, sdk.CodeOutOfGas)
+}
+
+func TestConsumeTxSizeGasDecorator(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx.WithBlockHeight(1).WithTxBytes(make([]byte, 100))
+	params := input.ak.GetParams(ctx)
+
+	priv1, _, addr1 := keyPubAddr()
+	tx := newTestTx(ctx, []sdk.Msg{newTestMsg(addr1)}, []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}, newStdFee())
+
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, res, abort := sdk.ChainAnteDecorators(NewConsumeTxSizeGasDecorator(input.ak))(ctx, tx, false)
+	require.False(t, abort, res.Log)
+	require.Equal(t, params.TxSizeCostPerByte*100, ctx.GasMeter().GasConsumed())
+}
+
+func TestDeductFeeDecorator(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx.WithBlockHeight(1)
+	anteHandler := sdk.ChainAnteDecorators(NewDeductFeeDecorator(input.ak, input.fck))
+
+	priv1, _, addr1 := keyPubAddr()
+	tx := newTestTx(ctx, []sdk.Msg{newTestMsg(addr1)}, []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}, newStdFee())
+
+	// the fee payer must exist
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnknownAddress)
+
+	acc1 := input.ak.NewAccountWithAddress(ctx, addr1)
+	require.NoError(t, acc1.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("atom", 149))))
+	input.ak.SetAccount(ctx, acc1)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeInsufficientFunds)
+	require.True(t, input.fck.GetCollectedFees(ctx).IsEqual(emptyCoins))
+
+	// the fees are deducted and collected without the other decorators
+	require.NoError(t, acc1.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("atom", 300))))
+	input.ak.SetAccount(ctx, acc1)
+	checkValidTx(t, anteHandler, ctx, tx, false)
+	require.True(t, input.fck.GetCollectedFees(ctx).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("atom", 150))))
+	require.True(t, input.ak.GetAccount(ctx, addr1).GetCoins().IsEqual(sdk.NewCoins(sdk.NewInt64Coin("atom", 150))))
+}
+
+func TestDeductFeeDecoratorFeePayerGas(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx.WithBlockHeight(1)
+
+	priv1, _, addr1 := keyPubAddr()
+	acc1 := input.ak.NewAccountWithAddress(ctx, addr1)
+	require.NoError(t, acc1.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("atom", 300))))
+	input.ak.SetAccount(ctx, acc1)
+
+	fee := newStdFee()
+	tx := newTestTx(ctx, []sdk.Msg{newTestMsg(addr1)}, []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}, fee)
+	sigVerification := NewSigVerificationDecorator(input.ak, DefaultSigVerificationGasConsumer)
+
+	// deduct the fees and verify the signature
+	deductCtx, _ := ctx.CacheContext()
+	deductCtx = deductCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	anteHandler := sdk.ChainAnteDecorators(NewDeductFeeDecorator(input.ak, input.fck), sigVerification)
+	_, res, abort := anteHandler(deductCtx, tx, false)
+	require.False(t, abort, res.Log)
+	deductGas := deductCtx.GasMeter().GasConsumed()
+
+	// the fee payer has both the fees deducted and its sequence incremented
+	payer := input.ak.GetAccount(deductCtx, addr1)
+	require.True(t, payer.GetCoins().IsEqual(sdk.NewCoins(sdk.NewInt64Coin("atom", 150))))
+	require.Equal(t, uint64(1), payer.GetSequence())
+
+	// verify the signature of the account with the fees already deducted
+	verifyCtx, _ := ctx.CacheContext()
+	require.NoError(t, acc1.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("atom", 150))))
+	input.ak.SetAccount(verifyCtx, acc1)
+	verifyCtx = verifyCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	_, res, abort = sdk.ChainAnteDecorators(sigVerification)(verifyCtx, tx, false)
+	require.False(t, abort, res.Log)
+
+	// collect the fees alone
+	feesCtx, _ := ctx.CacheContext()
+	feesCtx = feesCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	input.fck.AddCollectedFees(feesCtx, fee.Amount)
+
+	// the fee payer is only loaded and stored once
+	require.Equal(t, verifyCtx.GasMeter().GasConsumed()+feesCtx.GasMeter().GasConsumed(), deductGas)
+}
+
+// anteDecoratorFunc turns an AnteHandler into a decorator calling it instead
+// of the next one.
+type anteDecoratorFunc sdk.AnteHandler
+
+func (fn anteDecoratorFunc) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx, simulate bool, _ sdk.AnteHandler,
+) (sdk.Context, sdk.Result, bool) {
+	return fn(ctx, tx, simulate)
+}
+
+// nonStdTx is a transaction which is not a StdTx.
+type nonStdTx struct{}
+
+func (nonStdTx) GetMsgs() []sdk.Msg       { return nil }
+func (nonStdTx) ValidateBasic() sdk.Error { return nil }
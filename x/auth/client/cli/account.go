@@ -21,11 +21,12 @@ func GetAccountCmd(storeName string, cdc *codec.Codec) *cobra.Command {
 			cliCtx := context.NewCLIContext().
 				WithCodec(cdc).WithAccountDecoder(cdc)
 
-			key, err := sdk.AccAddressFromBech32(args[0])
+			bz, err := cliCtx.AddressCodec.StringToBytes(args[0])
 			if err != nil {
 				return err
 			}
 
+			key := sdk.AccAddress(bz)
 			if err = cliCtx.EnsureAccountExistsFromAddr(key); err != nil {
 				return err
 			}
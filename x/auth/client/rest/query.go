@@ -1,9 +1,11 @@
 package rest
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/gorilla/mux"
+	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -11,18 +13,27 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/rest"
 
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 )
 
-// register REST routes
-func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec, storeName string) {
+// register REST routes, the requests to deprecated routes are logged with the
+// given logger
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec, storeName string, logger log.Logger) {
 	r.HandleFunc(
 		"/auth/accounts/{address}",
 		QueryAccountRequestHandlerFn(storeName, cdc, context.GetAccountDecoder(cdc), cliCtx),
 	).Methods("GET")
 
+	// the v1 balances are paginated, the unversioned route is kept for the
+	// clients which didn't move to the versioned ones yet
+	balancesHandler := QueryBalancesRequestHandlerFn(storeName, cdc, context.GetAccountDecoder(cdc), cliCtx)
+	rest.RegisterVersionedRoute(r, logger, "/bank/balances/{address}", "GET",
+		rest.VersionedHandler{Version: rest.VersionV1Beta1, Handler: balancesHandler, Deprecated: true},
+		rest.VersionedHandler{Version: rest.VersionV1, Handler: QueryBalancesPageRequestHandlerFn(cdc, cliCtx)},
+	)
 	r.HandleFunc(
 		"/bank/balances/{address}",
-		QueryBalancesRequestHandlerFn(storeName, cdc, context.GetAccountDecoder(cdc), cliCtx),
+		rest.DeprecationHandler(logger, balancesHandler, rest.VersionedPath(rest.VersionV1, "/bank/balances/{address}")),
 	).Methods("GET")
 }
 
@@ -33,9 +44,7 @@ func QueryAccountRequestHandlerFn(
 ) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
-		bech32addr := vars["address"]
-
-		addr, err := sdk.AccAddressFromBech32(bech32addr)
+		addr, err := cliCtx.AddressCodec.StringToBytes(vars["address"])
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -72,9 +81,7 @@ func QueryBalancesRequestHandlerFn(
 	return func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 		vars := mux.Vars(r)
-		bech32addr := vars["address"]
-
-		addr, err := sdk.AccAddressFromBech32(bech32addr)
+		addr, err := cliCtx.AddressCodec.StringToBytes(vars["address"])
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
@@ -102,3 +109,38 @@ func QueryBalancesRequestHandlerFn(
 		rest.PostProcessResponse(w, cdc, account.GetCoins(), cliCtx.Indent)
 	}
 }
+
+// QueryBalancesPageRequestHandlerFn returns a page of the balances of an
+// account in denom order, selected with the page and limit query parameters
+func QueryBalancesPageRequestHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		addr, err := cliCtx.AddressCodec.StringToBytes(vars["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := bank.NewQueryBalancesParams(addr, page, limit)
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", bank.QuerierRoute, bank.QueryBalances)
+		res, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
@@ -0,0 +1,40 @@
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestRegisterRoutesVersions(t *testing.T) {
+	r := mux.NewRouter()
+	cliCtx := context.CLIContext{}.WithAddressCodec(sdk.NewBech32Codec(sdk.Bech32PrefixAccAddr))
+	RegisterRoutes(cliCtx, r, codec.New(), "acc", log.NewNopLogger())
+
+	match := func(path string) bool {
+		req, err := http.NewRequest("GET", path, nil)
+		require.NoError(t, err)
+		var m mux.RouteMatch
+		return r.Match(req, &m)
+	}
+	require.True(t, match("/auth/accounts/addr"))
+	require.True(t, match("/bank/balances/addr"))
+	require.True(t, match("/v1beta1/bank/balances/addr"))
+	require.True(t, match("/v1/bank/balances/addr"))
+	require.False(t, match("/v2/bank/balances/addr"))
+
+	// the deprecated routes answer with warning headers, here along with the
+	// error of the invalid address
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest("GET", "/bank/balances/addr", nil))
+	require.Equal(t, "true", w.Header().Get("Deprecation"))
+	require.Contains(t, w.Header().Get("Warning"), "/v1/bank/balances/{address}")
+}
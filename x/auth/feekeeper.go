@@ -5,6 +5,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// FeeCollectorName is the name of the fee collector module account
+const FeeCollectorName = "fee_collector"
+
 var (
 	collectedFeesKey = []byte("collectedFees")
 )
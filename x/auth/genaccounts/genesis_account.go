@@ -21,10 +21,21 @@ type GenesisAccount struct {
 	DelegatedVesting sdk.Coins `json:"delegated_vesting"` // delegated vesting coins at time of delegation
 	StartTime        int64     `json:"start_time"`        // vesting start time (UNIX Epoch time)
 	EndTime          int64     `json:"end_time"`          // vesting end time (UNIX Epoch time)
+
+	// module account fields
+	ModuleName string `json:"module_name"` // name of the module owning the account
 }
 
 // validate the the VestingAccount parameters are possible
 func (ga GenesisAccount) Validate() error {
+	if ga.ModuleName != "" {
+		if !ga.Address.Equals(auth.NewModuleAddress(ga.ModuleName)) {
+			return fmt.Errorf("address %s is not the module account address of %s", ga.Address, ga.ModuleName)
+		}
+		if !ga.OriginalVesting.IsZero() {
+			return errors.New("module accounts cannot vest coins")
+		}
+	}
 	if !ga.OriginalVesting.IsZero() {
 		if ga.OriginalVesting.IsAnyGT(ga.Coins) {
 			return errors.New("vesting amount cannot be greater than total amount")
@@ -83,6 +94,11 @@ func NewGenesisAccountI(acc auth.Account) (GenesisAccount, error) {
 		gacc.EndTime = vacc.GetEndTime()
 	}
 
+	macc, ok := acc.(*auth.ModuleAccount)
+	if ok {
+		gacc.ModuleName = macc.GetName()
+	}
+
 	return gacc, nil
 }
 
@@ -92,6 +108,13 @@ func (ga *GenesisAccount) ToAccount() auth.Account {
 	bacc := auth.NewBaseAccount(ga.Address, ga.Coins.Sort(),
 		nil, ga.AccountNumber, ga.Sequence)
 
+	if ga.ModuleName != "" {
+		return &auth.ModuleAccount{
+			BaseAccount: bacc,
+			Name:        ga.ModuleName,
+		}
+	}
+
 	if !ga.OriginalVesting.IsZero() {
 
 		baseVestingAcc := auth.NewBaseVestingAccount(
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // State to Unmarshal
@@ -39,9 +40,10 @@ func SetGenesisStateInAppState(cdc *codec.Codec,
 	return appState
 }
 
-// Sanitize sorts accounts and coin sets.
+// Sanitize sorts accounts and coin sets. Accounts with the same account
+// number keep their order.
 func (gs GenesisState) Sanitize() {
-	sort.Slice(gs.Accounts, func(i, j int) bool {
+	sort.SliceStable(gs.Accounts, func(i, j int) bool {
 		return gs.Accounts[i].AccountNumber < gs.Accounts[j].AccountNumber
 	})
 
@@ -56,30 +58,65 @@ func (gs GenesisState) Sanitize() {
 func ValidateGenesis(genesisState GenesisState) error {
 	addrMap := make(map[string]bool, len(genesisState.Accounts))
 	for _, acc := range genesisState.Accounts {
-		addrStr := acc.Address.String()
-
-		// disallow any duplicate accounts
-		if _, ok := addrMap[addrStr]; ok {
-			return fmt.Errorf("duplicate account found in genesis state; address: %s", addrStr)
+		if err := validateGenesisAccount(acc, addrMap); err != nil {
+			return err
 		}
+	}
+	return nil
+}
 
-		// validate any vesting fields
-		if !acc.OriginalVesting.IsZero() {
-			if acc.EndTime == 0 {
-				return fmt.Errorf("missing end time for vesting account; address: %s", addrStr)
+// ValidateGenesisFromJSON validates the accounts of a JSON encoded genesis
+// state as ValidateGenesis, in chunks of InitGenesisChunkSize accounts, so
+// that the accounts of very large genesis states are never all decoded at once.
+func ValidateGenesisFromJSON(cdc *codec.Codec, data json.RawMessage) error {
+	addrMap := make(map[string]bool)
+	return sdk.ForEachJSONArrayChunk(data, "accounts", InitGenesisChunkSize, func(chunk []json.RawMessage) error {
+		for _, bz := range chunk {
+			var acc GenesisAccount
+			if err := cdc.UnmarshalJSON(bz, &acc); err != nil {
+				return err
 			}
-
-			if acc.StartTime >= acc.EndTime {
-				return fmt.Errorf(
-					"vesting start time must before end time; address: %s, start: %s, end: %s",
-					addrStr,
-					time.Unix(acc.StartTime, 0).UTC().Format(time.RFC3339),
-					time.Unix(acc.EndTime, 0).UTC().Format(time.RFC3339),
-				)
+			if err := validateGenesisAccount(acc, addrMap); err != nil {
+				return err
 			}
 		}
+		return nil
+	})
+}
 
-		addrMap[addrStr] = true
+// validateGenesisAccount validates a genesis account and records its address
+// in the addresses of the accounts validated before
+func validateGenesisAccount(acc GenesisAccount, addrMap map[string]bool) error {
+	addrStr := acc.Address.String()
+
+	// disallow any duplicate accounts
+	if _, ok := addrMap[addrStr]; ok {
+		return fmt.Errorf("duplicate account found in genesis state; address: %s", addrStr)
 	}
+
+	// validate any vesting fields
+	if !acc.OriginalVesting.IsZero() {
+		if acc.EndTime == 0 {
+			return fmt.Errorf("missing end time for vesting account; address: %s", addrStr)
+		}
+
+		if acc.StartTime >= acc.EndTime {
+			return fmt.Errorf(
+				"vesting start time must before end time; address: %s, start: %s, end: %s",
+				addrStr,
+				time.Unix(acc.StartTime, 0).UTC().Format(time.RFC3339),
+				time.Unix(acc.EndTime, 0).UTC().Format(time.RFC3339),
+			)
+		}
+	}
+
+	// validate any module account fields
+	if acc.ModuleName != "" {
+		if err := acc.Validate(); err != nil {
+			return fmt.Errorf("%s; address: %s", err, addrStr)
+		}
+	}
+
+	addrMap[addrStr] = true
 	return nil
 }
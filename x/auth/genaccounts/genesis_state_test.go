@@ -60,6 +60,10 @@ func TestValidateGenesisDuplicateAccounts(t *testing.T) {
 	genesisState := NewGenesisState(genAccs)
 	err := ValidateGenesis(genesisState)
 	require.Error(t, err)
+	require.Error(t, ValidateGenesisFromJSON(moduleCdc, moduleCdc.MustMarshalJSON(genesisState)))
+
+	genesisState.Accounts = genAccs[:1]
+	require.NoError(t, ValidateGenesisFromJSON(moduleCdc, moduleCdc.MustMarshalJSON(genesisState)))
 }
 
 // require invalid vesting account fails validation (invalid end time)
@@ -82,4 +86,5 @@ func TestValidateGenesisInvalidAccounts(t *testing.T) {
 	genesisState.Accounts[0].EndTime = 1548775410
 	err = ValidateGenesis(genesisState)
 	require.Error(t, err)
+	require.Error(t, ValidateGenesisFromJSON(moduleCdc, moduleCdc.MustMarshalJSON(genesisState)))
 }
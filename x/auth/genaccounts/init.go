@@ -1,10 +1,17 @@
 package genaccounts
 
 import (
+	"encoding/json"
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// InitGenesisChunkSize is the number of genesis accounts decoded and
+// initialized at once by InitGenesisFromJSON
+const InitGenesisChunkSize = 10000
+
 // initialize accounts and deliver genesis transactions
 func InitGenesis(ctx sdk.Context, cdc *codec.Codec,
 	accountKeeper AccountKeeper, genesisState GenesisState) {
@@ -18,3 +25,66 @@ func InitGenesis(ctx sdk.Context, cdc *codec.Codec,
 		accountKeeper.SetAccount(ctx, acc)
 	}
 }
+
+// InitGenesisFromJSON initializes the accounts of a JSON encoded genesis state
+// in chunks of InitGenesisChunkSize accounts, logging the progress, so that
+// the accounts of very large genesis states are never all decoded at once.
+// The accounts must be initialized in account number order, genesis states
+// whose accounts are not sorted are thus decoded and sorted at once.
+func InitGenesisFromJSON(ctx sdk.Context, cdc *codec.Codec, accountKeeper AccountKeeper, data json.RawMessage) {
+	sorted, err := genesisAccountsSorted(cdc, data)
+	if err != nil {
+		panic(err)
+	}
+	if !sorted {
+		var genesisState GenesisState
+		cdc.MustUnmarshalJSON(data, &genesisState)
+		InitGenesis(ctx, cdc, accountKeeper, genesisState)
+		return
+	}
+
+	logger := ctx.Logger()
+	initialized := 0
+	err = sdk.ForEachJSONArrayChunk(data, "accounts", InitGenesisChunkSize, func(chunk []json.RawMessage) error {
+		for _, bz := range chunk {
+			var gacc GenesisAccount
+			if err := cdc.UnmarshalJSON(bz, &gacc); err != nil {
+				return err
+			}
+			gacc.Coins = gacc.Coins.Sort()
+
+			acc := accountKeeper.NewAccount(ctx, gacc.ToAccount()) // set account number
+			accountKeeper.SetAccount(ctx, acc)
+		}
+
+		initialized += len(chunk)
+		logger.Info(fmt.Sprintf("Initialized %d genesis accounts", initialized))
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// genesisAccountsSorted returns true if the accounts of a JSON encoded genesis
+// state are sorted by account number, decoding only their account numbers
+func genesisAccountsSorted(cdc *codec.Codec, data json.RawMessage) (bool, error) {
+	sorted := true
+	var prev uint64
+	err := sdk.ForEachJSONArrayChunk(data, "accounts", InitGenesisChunkSize, func(chunk []json.RawMessage) error {
+		for _, bz := range chunk {
+			var acc struct {
+				AccountNumber uint64 `json:"account_number"`
+			}
+			if err := cdc.UnmarshalJSON(bz, &acc); err != nil {
+				return err
+			}
+			if acc.AccountNumber < prev {
+				sorted = false
+			}
+			prev = acc.AccountNumber
+		}
+		return nil
+	})
+	return sorted, err
+}
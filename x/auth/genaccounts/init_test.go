@@ -0,0 +1,73 @@
+package genaccounts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// accountKeeper records the accounts in the order they are set
+type accountKeeper struct {
+	accounts []auth.Account
+}
+
+func (ak *accountKeeper) NewAccount(_ sdk.Context, acc auth.Account) auth.Account {
+	if err := acc.SetAccountNumber(uint64(len(ak.accounts))); err != nil {
+		panic(err)
+	}
+	return acc
+}
+
+func (ak *accountKeeper) SetAccount(_ sdk.Context, acc auth.Account) {
+	ak.accounts = append(ak.accounts, acc)
+}
+
+func (ak *accountKeeper) IterateAccounts(_ sdk.Context, process func(auth.Account) (stop bool)) {
+	for _, acc := range ak.accounts {
+		if process(acc) {
+			return
+		}
+	}
+}
+
+func TestInitGenesisFromJSON(t *testing.T) {
+	ctx := sdk.NewContext(nil, abci.Header{}, false, log.NewNopLogger())
+
+	newGenesisAccount := func(addr sdk.ValAddress, accNum uint64) GenesisAccount {
+		acc := auth.NewBaseAccountWithAddress(sdk.AccAddress(addr))
+		acc.Coins = sdk.Coins{sdk.NewInt64Coin("bcoin", 1), sdk.NewInt64Coin("acoin", 2)}
+		acc.AccountNumber = accNum
+		return NewGenesisAccount(&acc)
+	}
+
+	for _, accNums := range [][]uint64{{0, 1}, {1, 0}} {
+		genesisState := NewGenesisState([]GenesisAccount{
+			newGenesisAccount(addr1, accNums[0]),
+			newGenesisAccount(addr2, accNums[1]),
+		})
+		sorted, err := genesisAccountsSorted(moduleCdc, moduleCdc.MustMarshalJSON(genesisState))
+		require.NoError(t, err)
+		require.Equal(t, accNums[0] < accNums[1], sorted)
+
+		// the accounts are initialized in account number order either way
+		ak := &accountKeeper{}
+		InitGenesisFromJSON(ctx, moduleCdc, ak, moduleCdc.MustMarshalJSON(genesisState))
+		require.Len(t, ak.accounts, 2)
+		for i, acc := range ak.accounts {
+			require.Equal(t, uint64(i), acc.GetAccountNumber())
+			require.Equal(t, "acoin", acc.GetCoins()[0].Denom)
+		}
+		require.Equal(t, sdk.AccAddress(addr1), ak.accounts[accNums[0]].GetAddress())
+		require.Equal(t, sdk.AccAddress(addr2), ak.accounts[accNums[1]].GetAddress())
+	}
+
+	// no accounts
+	ak := &accountKeeper{}
+	InitGenesisFromJSON(ctx, moduleCdc, ak, moduleCdc.MustMarshalJSON(NewGenesisState(nil)))
+	require.Empty(t, ak.accounts)
+}
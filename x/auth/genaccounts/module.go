@@ -35,12 +35,7 @@ func (AppModuleBasic) DefaultGenesis() json.RawMessage {
 
 // module validate genesis
 func (AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
-	var data GenesisState
-	err := moduleCdc.UnmarshalJSON(bz, &data)
-	if err != nil {
-		return err
-	}
-	return ValidateGenesis(data)
+	return ValidateGenesisFromJSON(moduleCdc, bz)
 }
 
 // extra function from sdk.AppModuleBasic
@@ -76,9 +71,7 @@ func NewAppModule(accountKeeper AccountKeeper) sdk.AppModule {
 
 // module init-genesis
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
-	var genesisState GenesisState
-	moduleCdc.MustUnmarshalJSON(data, &genesisState)
-	InitGenesis(ctx, moduleCdc, am.accountKeeper, genesisState)
+	InitGenesisFromJSON(ctx, moduleCdc, am.accountKeeper, data)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -29,6 +29,7 @@ func DefaultGenesisState() GenesisState {
 func InitGenesis(ctx sdk.Context, ak AccountKeeper, fck FeeCollectionKeeper, data GenesisState) {
 	ak.SetParams(ctx, data.Params)
 	fck.setCollectedFees(ctx, data.CollectedFees)
+	ak.InitModuleAccounts(ctx)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper
@@ -57,5 +58,18 @@ func ValidateGenesis(data GenesisState) error {
 	if data.Params.TxSizeCostPerByte == 0 {
 		return fmt.Errorf("invalid tx size cost per byte: %d", data.Params.TxSizeCostPerByte)
 	}
+	if data.Params.MaxMsgsPerTx == 0 {
+		return fmt.Errorf("invalid max messages per tx: %d", data.Params.MaxMsgsPerTx)
+	}
+	if data.Params.MaxTxBytes == 0 {
+		return fmt.Errorf("invalid max tx bytes: %d", data.Params.MaxTxBytes)
+	}
+	seenDenoms := make(map[string]bool)
+	for _, denom := range data.Params.FeeDenoms {
+		if denom == "" || seenDenoms[denom] {
+			return fmt.Errorf("invalid or duplicate fee denom: %q", denom)
+		}
+		seenDenoms[denom] = true
+	}
 	return nil
 }
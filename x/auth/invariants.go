@@ -0,0 +1,30 @@
+package auth
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// register auth invariants
+func RegisterInvariants(ir sdk.InvariantRouter, ak AccountKeeper) {
+	ir.RegisterRoute(ModuleName, "module-accounts",
+		ModuleAccountsInvariant(ak))
+}
+
+// ModuleAccountsInvariant checks that the registered module accounts exist and
+// match the registry, eg. after the registry changed across a restart
+func ModuleAccountsInvariant(ak AccountKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		for _, name := range ak.moduleAccountNames() {
+			acc := ak.GetAccount(ctx, NewModuleAddress(name))
+			if acc == nil {
+				return fmt.Errorf("module account %s doesn't exist", name)
+			}
+			if err := checkModuleAccount(acc, name); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
@@ -25,7 +25,8 @@ var (
 	// AddressStoreKeyPrefix prefix for account-by-address store
 	AddressStoreKeyPrefix = []byte{0x01}
 
-	globalAccountNumberKey = []byte("globalAccountNumber")
+	// GlobalAccountNumberKey param key for global account number
+	GlobalAccountNumberKey = []byte("globalAccountNumber")
 )
 
 // AccountKeeper encodes/decodes accounts using the go-amino (binary)
@@ -41,6 +42,9 @@ type AccountKeeper struct {
 	cdc *codec.Codec
 
 	paramSubspace subspace.Subspace
+
+	// names of the registered module accounts
+	moduleAccounts map[string]bool
 }
 
 // NewAccountKeeper returns a new sdk.AccountKeeper that uses go-amino to
@@ -58,6 +62,21 @@ func NewAccountKeeper(
 	}
 }
 
+// WithModuleAccounts returns a copy of the keeper with the module accounts of
+// the given names registered. It panics if a module account name is blank.
+func (ak AccountKeeper) WithModuleAccounts(names ...string) AccountKeeper {
+	moduleAccounts := make(map[string]bool, len(names))
+	for _, name := range names {
+		if err := validateModuleAccountName(name); err != nil {
+			panic(err)
+		}
+		moduleAccounts[name] = true
+	}
+
+	ak.moduleAccounts = moduleAccounts
+	return ak
+}
+
 // NewAccountWithAddress implements sdk.AccountKeeper.
 func (ak AccountKeeper) NewAccountWithAddress(ctx sdk.Context, addr sdk.AccAddress) Account {
 	acc := ak.proto()
@@ -182,7 +201,7 @@ func (ak AccountKeeper) setSequence(ctx sdk.Context, addr sdk.AccAddress, newSeq
 func (ak AccountKeeper) GetNextAccountNumber(ctx sdk.Context) uint64 {
 	var accNumber uint64
 	store := ctx.KVStore(ak.key)
-	bz := store.Get(globalAccountNumberKey)
+	bz := store.Get(GlobalAccountNumberKey)
 	if bz == nil {
 		accNumber = 0
 	} else {
@@ -193,7 +212,7 @@ func (ak AccountKeeper) GetNextAccountNumber(ctx sdk.Context) uint64 {
 	}
 
 	bz = ak.cdc.MustMarshalBinaryLengthPrefixed(accNumber + 1)
-	store.Set(globalAccountNumberKey, bz)
+	store.Set(GlobalAccountNumberKey, bz)
 
 	return accNumber
 }
@@ -9,8 +9,9 @@ import (
 )
 
 var (
-	_ sdk.AppModule      = AppModule{}
-	_ sdk.AppModuleBasic = AppModuleBasic{}
+	_ sdk.AppModule          = AppModule{}
+	_ sdk.AppModuleBasic     = AppModuleBasic{}
+	_ sdk.AppModuleDescriber = AppModule{}
 )
 
 // name of this module
@@ -68,7 +69,9 @@ func (AppModule) Name() string {
 }
 
 // register invariants
-func (AppModule) RegisterInvariants(_ sdk.InvariantRouter) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRouter) {
+	RegisterInvariants(ir, am.accountKeeper)
+}
 
 // module message route name
 func (AppModule) Route() string { return "" }
@@ -86,6 +89,16 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 	return NewQuerier(am.accountKeeper)
 }
 
+// module message types
+func (AppModule) MsgTypes() []string {
+	return []string{}
+}
+
+// module query paths
+func (AppModule) QueryPaths() []string {
+	return []string{QueryAccount}
+}
+
 // module init-genesis
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
 	var genesisState GenesisState
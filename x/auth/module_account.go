@@ -0,0 +1,149 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ Account = (*ModuleAccount)(nil)
+
+// ModuleAccount defines an account owned by a module, eg. to hold a pool of
+// coins, with an address derived from the module account name. It has no
+// public key and can't sign transactions.
+type ModuleAccount struct {
+	*BaseAccount
+
+	Name string `json:"name"`
+}
+
+// NewModuleAddress returns the address of the module account with the given
+// name
+func NewModuleAddress(name string) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(name)))
+}
+
+// NewEmptyModuleAccount creates a module account without coins nor account
+// number
+func NewEmptyModuleAccount(name string) *ModuleAccount {
+	baseAcc := NewBaseAccountWithAddress(NewModuleAddress(name))
+
+	return &ModuleAccount{
+		BaseAccount: &baseAcc,
+		Name:        name,
+	}
+}
+
+// GetName returns the name of the module account
+func (ma ModuleAccount) GetName() string {
+	return ma.Name
+}
+
+// SetPubKey - Implements Account. Module accounts have no public key.
+func (ma ModuleAccount) SetPubKey(pubKey crypto.PubKey) error {
+	return fmt.Errorf("not supported for module accounts")
+}
+
+// SetSequence - Implements Account. Module accounts never sign.
+func (ma ModuleAccount) SetSequence(seq uint64) error {
+	return fmt.Errorf("not supported for module accounts")
+}
+
+// String implements fmt.Stringer
+func (ma ModuleAccount) String() string {
+	return fmt.Sprintf(`Module Account:
+  Address:       %s
+  Name:          %s
+  Coins:         %s
+  AccountNumber: %d`,
+		ma.Address, ma.Name, ma.Coins, ma.AccountNumber,
+	)
+}
+
+// validateModuleAccountName checks that a module account name is not blank
+func validateModuleAccountName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return errors.New("module account name cannot be blank")
+	}
+	return nil
+}
+
+//_____________________________________________________________________________
+
+// GetModuleAddress returns the address of a registered module account, or
+// nil if no module account is registered under the name
+func (ak AccountKeeper) GetModuleAddress(name string) sdk.AccAddress {
+	if !ak.moduleAccounts[name] {
+		return nil
+	}
+	return NewModuleAddress(name)
+}
+
+// ModuleAccountAddrs returns the addresses of the registered module accounts,
+// eg. to block direct transfers to them
+func (ak AccountKeeper) ModuleAccountAddrs() map[string]bool {
+	addrs := make(map[string]bool, len(ak.moduleAccounts))
+	for name := range ak.moduleAccounts {
+		addrs[NewModuleAddress(name).String()] = true
+	}
+	return addrs
+}
+
+// GetModuleAccount returns the registered module account with the given name,
+// creating it if it doesn't exist yet. It panics if no module account is
+// registered under the name, or if the account stored at its address is not
+// that module account.
+func (ak AccountKeeper) GetModuleAccount(ctx sdk.Context, name string) *ModuleAccount {
+	if !ak.moduleAccounts[name] {
+		panic(fmt.Sprintf("module account %s is not registered", name))
+	}
+
+	acc := ak.GetAccount(ctx, NewModuleAddress(name))
+	if acc == nil {
+		macc := ak.NewAccount(ctx, NewEmptyModuleAccount(name)).(*ModuleAccount)
+		ak.SetAccount(ctx, macc)
+		return macc
+	}
+
+	if err := checkModuleAccount(acc, name); err != nil {
+		panic(err)
+	}
+	return acc.(*ModuleAccount)
+}
+
+// InitModuleAccounts creates the registered module accounts which don't exist
+// yet, in name order, and checks the existing ones against the registry
+func (ak AccountKeeper) InitModuleAccounts(ctx sdk.Context) {
+	for _, name := range ak.moduleAccountNames() {
+		ak.GetModuleAccount(ctx, name)
+	}
+}
+
+// moduleAccountNames returns the names of the registered module accounts in
+// order
+func (ak AccountKeeper) moduleAccountNames() []string {
+	names := make([]string, 0, len(ak.moduleAccounts))
+	for name := range ak.moduleAccounts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// checkModuleAccount checks that the given account is the module account
+// registered under the name
+func checkModuleAccount(acc Account, name string) error {
+	macc, ok := acc.(*ModuleAccount)
+	if !ok {
+		return fmt.Errorf("account %s of module %s is not a module account", acc.GetAddress(), name)
+	}
+	if macc.Name != name {
+		return fmt.Errorf("module account at the address of %s is named %s", name, macc.Name)
+	}
+	return nil
+}
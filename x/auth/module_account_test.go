@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestModuleAccounts(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+
+	require.Panics(t, func() { input.ak.WithModuleAccounts("pool", " ") })
+
+	ak := input.ak.WithModuleAccounts("fees", "pool")
+	require.Nil(t, ak.GetModuleAddress("unknown"))
+	require.Equal(t, NewModuleAddress("pool"), ak.GetModuleAddress("pool"))
+	require.Equal(t, map[string]bool{
+		NewModuleAddress("fees").String(): true,
+		NewModuleAddress("pool").String(): true,
+	}, ak.ModuleAccountAddrs())
+	require.Panics(t, func() { ak.GetModuleAccount(ctx, "unknown") })
+
+	// the module accounts are created in name order at genesis
+	ak.InitModuleAccounts(ctx)
+	fees := ak.GetModuleAccount(ctx, "fees")
+	pool := ak.GetModuleAccount(ctx, "pool")
+	require.Equal(t, uint64(0), fees.GetAccountNumber())
+	require.Equal(t, uint64(1), pool.GetAccountNumber())
+	require.Equal(t, "pool", pool.GetName())
+	require.NotNil(t, pool.SetPubKey(nil))
+
+	acc := ak.GetAccount(ctx, NewModuleAddress("pool"))
+	require.IsType(t, &ModuleAccount{}, acc)
+
+	require.Nil(t, ModuleAccountsInvariant(ak)(ctx))
+
+	// a registry not matching the stored module accounts is caught
+	missing := input.ak.WithModuleAccounts("other")
+	require.NotNil(t, ModuleAccountsInvariant(missing)(ctx))
+
+	ak.SetAccount(ctx, NewBaseAccount(NewModuleAddress("fees"), sdk.NewCoins(), nil, 0, 0))
+	require.Panics(t, func() { ak.InitModuleAccounts(ctx) })
+	require.NotNil(t, ModuleAccountsInvariant(ak)(ctx))
+}
@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OffchainSignDomain separates the sign bytes of off-chain data, eg. to prove
+// the ownership of an account for an airdrop claim or a login, from the sign
+// bytes of transactions. As StdOffchainSignDoc carries neither a chain-id, an
+// account number, a sequence nor messages, its signatures can never be used
+// to authorize a transaction.
+const OffchainSignDomain = "cosmos-sdk/offchain-data"
+
+// StdOffchainSignDoc is signed with the key of an account to sign arbitrary
+// off-chain data.
+type StdOffchainSignDoc struct {
+	Domain string         `json:"domain"`
+	Signer sdk.AccAddress `json:"signer"`
+	Data   []byte         `json:"data"`
+}
+
+// StdOffchainSignBytes returns the bytes to sign for arbitrary off-chain data
+// signed by the given account.
+func StdOffchainSignBytes(signer sdk.AccAddress, data []byte) []byte {
+	bz, err := moduleCdc.MarshalJSON(StdOffchainSignDoc{
+		Domain: OffchainSignDomain,
+		Signer: signer,
+		Data:   data,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// VerifyOffchainSignature checks that the signature of off-chain data was
+// made by the key of the signer account.
+func VerifyOffchainSignature(signer sdk.AccAddress, data []byte, sig StdSignature) error {
+	if sig.PubKey == nil {
+		return fmt.Errorf("signature has no public key")
+	}
+	if !bytes.Equal(sig.PubKey.Address(), signer) {
+		return fmt.Errorf("public key of the signature doesn't match the signer %s", signer)
+	}
+	if !sig.PubKey.VerifyBytes(StdOffchainSignBytes(signer, data), sig.Signature) {
+		return fmt.Errorf("invalid signature of %s", signer)
+	}
+	return nil
+}
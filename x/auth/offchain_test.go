@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+)
+
+func TestOffchainSignature(t *testing.T) {
+	data := []byte("claim airdrop")
+	signBytes := StdOffchainSignBytes(addr, data)
+	require.Contains(t, string(signBytes), OffchainSignDomain)
+
+	sigBytes, err := priv.Sign(signBytes)
+	require.NoError(t, err)
+	sig := StdSignature{PubKey: priv.PubKey(), Signature: sigBytes}
+	require.NoError(t, VerifyOffchainSignature(addr, data, sig))
+
+	// other data
+	require.Error(t, VerifyOffchainSignature(addr, []byte("other"), sig))
+
+	// key of another account
+	other := ed25519.GenPrivKey()
+	require.Error(t, VerifyOffchainSignature(addr, data, StdSignature{PubKey: other.PubKey(), Signature: sigBytes}))
+
+	// missing public key
+	require.Error(t, VerifyOffchainSignature(addr, data, StdSignature{Signature: sigBytes}))
+
+	// transaction signatures don't verify as off-chain signatures
+	txSigBytes, err := priv.Sign(StdSignBytes("", 0, 0, newStdFee(), nil, string(data)))
+	require.NoError(t, err)
+	require.Error(t, VerifyOffchainSignature(addr, data, StdSignature{PubKey: priv.PubKey(), Signature: txSigBytes}))
+}
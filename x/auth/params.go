@@ -19,6 +19,8 @@ const (
 	DefaultTxSizeCostPerByte      uint64 = 10
 	DefaultSigVerifyCostED25519   uint64 = 590
 	DefaultSigVerifyCostSecp256k1 uint64 = 1000
+	DefaultMaxMsgsPerTx           uint64 = 100
+	DefaultMaxTxBytes             uint64 = 1048576 // 1 MiB
 )
 
 // Parameter keys
@@ -28,22 +30,29 @@ var (
 	KeyTxSizeCostPerByte      = []byte("TxSizeCostPerByte")
 	KeySigVerifyCostED25519   = []byte("SigVerifyCostED25519")
 	KeySigVerifyCostSecp256k1 = []byte("SigVerifyCostSecp256k1")
+	KeyMaxMsgsPerTx           = []byte("MaxMsgsPerTx")
+	KeyMaxTxBytes             = []byte("MaxTxBytes")
+	KeyFeeDenoms              = []byte("FeeDenoms")
 )
 
 var _ subspace.ParamSet = &Params{}
 
 // Params defines the parameters for the auth module.
 type Params struct {
-	MaxMemoCharacters      uint64 `json:"max_memo_characters"`
-	TxSigLimit             uint64 `json:"tx_sig_limit"`
-	TxSizeCostPerByte      uint64 `json:"tx_size_cost_per_byte"`
-	SigVerifyCostED25519   uint64 `json:"sig_verify_cost_ed25519"`
-	SigVerifyCostSecp256k1 uint64 `json:"sig_verify_cost_secp256k1"`
+	MaxMemoCharacters      uint64   `json:"max_memo_characters"`
+	TxSigLimit             uint64   `json:"tx_sig_limit"`
+	TxSizeCostPerByte      uint64   `json:"tx_size_cost_per_byte"`
+	SigVerifyCostED25519   uint64   `json:"sig_verify_cost_ed25519"`
+	SigVerifyCostSecp256k1 uint64   `json:"sig_verify_cost_secp256k1"`
+	MaxMsgsPerTx           uint64   `json:"max_msgs_per_tx"`
+	MaxTxBytes             uint64   `json:"max_tx_bytes"`
+	FeeDenoms              []string `json:"fee_denoms"` // denominations accepted for fees, any if empty
 }
 
 // NewParams creates a new Params object
 func NewParams(maxMemoCharacters, txSigLimit, txSizeCostPerByte,
-	sigVerifyCostED25519, sigVerifyCostSecp256k1 uint64) Params {
+	sigVerifyCostED25519, sigVerifyCostSecp256k1, maxMsgsPerTx, maxTxBytes uint64,
+	feeDenoms []string) Params {
 
 	return Params{
 		MaxMemoCharacters:      maxMemoCharacters,
@@ -51,6 +60,9 @@ func NewParams(maxMemoCharacters, txSigLimit, txSizeCostPerByte,
 		TxSizeCostPerByte:      txSizeCostPerByte,
 		SigVerifyCostED25519:   sigVerifyCostED25519,
 		SigVerifyCostSecp256k1: sigVerifyCostSecp256k1,
+		MaxMsgsPerTx:           maxMsgsPerTx,
+		MaxTxBytes:             maxTxBytes,
+		FeeDenoms:              feeDenoms,
 	}
 }
 
@@ -69,6 +81,9 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 		{KeyTxSizeCostPerByte, &p.TxSizeCostPerByte},
 		{KeySigVerifyCostED25519, &p.SigVerifyCostED25519},
 		{KeySigVerifyCostSecp256k1, &p.SigVerifyCostSecp256k1},
+		{KeyMaxMsgsPerTx, &p.MaxMsgsPerTx},
+		{KeyMaxTxBytes, &p.MaxTxBytes},
+		{KeyFeeDenoms, &p.FeeDenoms},
 	}
 }
 
@@ -87,6 +102,9 @@ func DefaultParams() Params {
 		TxSizeCostPerByte:      DefaultTxSizeCostPerByte,
 		SigVerifyCostED25519:   DefaultSigVerifyCostED25519,
 		SigVerifyCostSecp256k1: DefaultSigVerifyCostSecp256k1,
+		MaxMsgsPerTx:           DefaultMaxMsgsPerTx,
+		MaxTxBytes:             DefaultMaxTxBytes,
+		FeeDenoms:              []string{},
 	}
 }
 
@@ -99,5 +117,8 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("TxSizeCostPerByte: %d\n", p.TxSizeCostPerByte))
 	sb.WriteString(fmt.Sprintf("SigVerifyCostED25519: %d\n", p.SigVerifyCostED25519))
 	sb.WriteString(fmt.Sprintf("SigVerifyCostSecp256k1: %d\n", p.SigVerifyCostSecp256k1))
+	sb.WriteString(fmt.Sprintf("MaxMsgsPerTx: %d\n", p.MaxMsgsPerTx))
+	sb.WriteString(fmt.Sprintf("MaxTxBytes: %d\n", p.MaxTxBytes))
+	sb.WriteString(fmt.Sprintf("FeeDenoms: %s\n", strings.Join(p.FeeDenoms, ",")))
 	return sb.String()
 }
@@ -0,0 +1,32 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// DecodeStore unmarshals the KVPair's Value to the corresponding auth type and
+// returns its human readable representation. Since the bank module keeps the
+// account balances in the auth store, this decoder covers them as well. It
+// panics on unknown keys.
+func DecodeStore(cdc *codec.Codec, kvPair cmn.KVPair) string {
+	switch {
+	case bytes.HasPrefix(kvPair.Key, auth.AddressStoreKeyPrefix):
+		var acc auth.Account
+		cdc.MustUnmarshalBinaryBare(kvPair.Value, &acc)
+		return fmt.Sprintf("Account: %v", acc)
+
+	case bytes.Equal(kvPair.Key, auth.GlobalAccountNumberKey):
+		var accNumber uint64
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvPair.Value, &accNumber)
+		return fmt.Sprintf("GlobalAccountNumber: %d", accNumber)
+
+	default:
+		panic(fmt.Sprintf("invalid auth key %X", kvPair.Key))
+	}
+}
@@ -29,7 +29,7 @@ func SendTxCmd(cdc *codec.Codec) *cobra.Command {
 				WithCodec(cdc).
 				WithAccountDecoder(cdc)
 
-			to, err := sdk.AccAddressFromBech32(args[1])
+			to, err := cliCtx.AddressCodec.StringToBytes(args[1])
 			if err != nil {
 				return err
 			}
@@ -41,7 +41,7 @@ func SendTxCmd(cdc *codec.Codec) *cobra.Command {
 			}
 
 			// build and sign the transaction, then broadcast to Tendermint
-			msg := bank.NewMsgSend(cliCtx.GetFromAddress(), to, coins)
+			msg := bank.NewMsgSend(cliCtx.GetFromAddress(), sdk.AccAddress(to), coins)
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
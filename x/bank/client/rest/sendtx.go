@@ -36,9 +36,7 @@ func init() {
 func SendRequestHandlerFn(cdc *codec.Codec, kb keys.Keybase, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
-		bech32Addr := vars["address"]
-
-		toAddr, err := sdk.AccAddressFromBech32(bech32Addr)
+		toAddr, err := cliCtx.AddressCodec.StringToBytes(vars["address"])
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -54,13 +52,13 @@ func SendRequestHandlerFn(cdc *codec.Codec, kb keys.Keybase, cliCtx context.CLIC
 			return
 		}
 
-		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		fromAddr, err := cliCtx.AddressCodec.StringToBytes(req.BaseReq.From)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		msg := bank.NewMsgSend(fromAddr, toAddr, req.Amount)
+		msg := bank.NewMsgSend(sdk.AccAddress(fromAddr), sdk.AccAddress(toAddr), req.Amount)
 		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
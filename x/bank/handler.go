@@ -29,6 +29,9 @@ func handleMsgSend(ctx sdk.Context, k Keeper, msg MsgSend) sdk.Result {
 	if !k.GetSendEnabled(ctx) {
 		return ErrSendDisabled(k.Codespace()).Result()
 	}
+	if k.BlockedAddr(msg.ToAddress) {
+		return ErrBlockedRecipient(k.Codespace(), msg.ToAddress).Result()
+	}
 	err := k.SendCoins(ctx, msg.FromAddress, msg.ToAddress, msg.Amount)
 	if err != nil {
 		return err.Result()
@@ -51,6 +54,11 @@ func handleMsgMultiSend(ctx sdk.Context, k Keeper, msg MsgMultiSend) sdk.Result
 	if !k.GetSendEnabled(ctx) {
 		return ErrSendDisabled(k.Codespace()).Result()
 	}
+	for _, out := range msg.Outputs {
+		if k.BlockedAddr(out.Address) {
+			return ErrBlockedRecipient(k.Codespace(), out.Address).Result()
+		}
+	}
 	resTags, err := k.InputOutputCoins(ctx, msg.Inputs, msg.Outputs)
 	if err != nil {
 		return err.Result()
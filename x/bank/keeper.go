@@ -2,6 +2,7 @@ package bank
 
 import (
 	"fmt"
+	"runtime"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -32,6 +33,9 @@ type BaseKeeper struct {
 
 	ak         auth.AccountKeeper
 	paramSpace params.Subspace
+
+	// panic when a balance would be set negative outside of CheckTx
+	strict bool
 }
 
 // NewBaseKeeper returns a new BaseKeeper
@@ -47,12 +51,28 @@ func NewBaseKeeper(ak auth.AccountKeeper,
 	}
 }
 
+// SetStrictMode sets whether the keeper panics when a balance would be set
+// negative while delivering transactions or blocks, so that arithmetic bugs of
+// the calling module are caught at the source instead of by an invariant.
+func (keeper *BaseKeeper) SetStrictMode(strict bool) *BaseKeeper {
+	keeper.strict = strict
+	return keeper
+}
+
 // SetCoins sets the coins at the addr.
 func (keeper BaseKeeper) SetCoins(
 	ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins,
 ) sdk.Error {
 
 	if !amt.IsValid() {
+		if keeper.strict && !ctx.IsCheckTx() && amt.IsAnyNegative() {
+			caller := "unknown"
+			if pc, _, _, ok := runtime.Caller(1); ok {
+				caller = runtime.FuncForPC(pc).Name()
+			}
+			panic(fmt.Sprintf("negative balance %s set for account %s by %s at height %d",
+				amt, addr, caller, ctx.BlockHeight()))
+		}
 		return sdk.ErrInvalidCoins(amt.String())
 	}
 	return setCoins(ctx, keeper.ak, addr, amt)
@@ -80,12 +100,61 @@ func (keeper BaseKeeper) AddCoins(
 	return addCoins(ctx, keeper.ak, addr, amt)
 }
 
+// WithSendRestriction returns a copy of the keeper which also runs the given
+// restriction on every transfer, after the restrictions already set.
+func (keeper BaseKeeper) WithSendRestriction(restriction SendRestriction) BaseKeeper {
+	keeper.BaseSendKeeper = keeper.BaseSendKeeper.WithSendRestriction(restriction)
+	return keeper
+}
+
+// WithBlockedAddrs returns a copy of the keeper which rejects the send and
+// multi-send messages to the given addresses, eg. the module accounts.
+func (keeper BaseKeeper) WithBlockedAddrs(addrs map[string]bool) BaseKeeper {
+	keeper.BaseSendKeeper = keeper.BaseSendKeeper.WithBlockedAddrs(addrs)
+	return keeper
+}
+
 // InputOutputCoins handles a list of inputs and outputs
 func (keeper BaseKeeper) InputOutputCoins(
 	ctx sdk.Context, inputs []Input, outputs []Output,
 ) (sdk.Tags, sdk.Error) {
 
-	return inputOutputCoins(ctx, keeper.ak, inputs, outputs)
+	if len(keeper.restrictions) == 0 {
+		return inputOutputCoins(ctx, keeper.ak, inputs, outputs)
+	}
+
+	// the restrictions only persist their state if the transfer succeeds
+	cacheCtx, write := ctx.CacheContext()
+
+	// the sender is only known for transfers with a single input, the inputs
+	// are checked on their own otherwise
+	var fromAddr sdk.AccAddress
+	if len(inputs) == 1 {
+		fromAddr = inputs[0].Address
+	} else {
+		for _, in := range inputs {
+			if _, err := keeper.applySendRestrictions(cacheCtx, in.Address, nil, in.Coins); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	restricted := make([]Output, len(outputs))
+	for i, out := range outputs {
+		toAddr, err := keeper.applySendRestrictions(cacheCtx, fromAddr, out.Address, out.Coins)
+		if err != nil {
+			return nil, err
+		}
+		restricted[i] = NewOutput(toAddr, out.Coins)
+	}
+
+	resTags, err := inputOutputCoins(cacheCtx, keeper.ak, inputs, restricted)
+	if err != nil {
+		return nil, err
+	}
+
+	write()
+	return resTags, nil
 }
 
 // DelegateCoins performs delegation by deducting amt coins from an account with
@@ -98,7 +167,23 @@ func (keeper BaseKeeper) DelegateCoins(
 	if !amt.IsValid() {
 		return nil, sdk.ErrInvalidCoins(amt.String())
 	}
-	return delegateCoins(ctx, keeper.ak, addr, amt)
+	if len(keeper.restrictions) == 0 {
+		return delegateCoins(ctx, keeper.ak, addr, amt)
+	}
+
+	// the restrictions only persist their state if the delegation succeeds
+	cacheCtx, write := ctx.CacheContext()
+	if _, err := keeper.applySendRestrictions(cacheCtx, addr, nil, amt); err != nil {
+		return nil, err
+	}
+
+	resTags, err := delegateCoins(cacheCtx, keeper.ak, addr, amt)
+	if err != nil {
+		return nil, err
+	}
+
+	write()
+	return resTags, nil
 }
 
 // UndelegateCoins performs undelegation by crediting amt coins to an account with
@@ -124,8 +209,23 @@ type SendKeeper interface {
 
 	GetSendEnabled(ctx sdk.Context) bool
 	SetSendEnabled(ctx sdk.Context, enabled bool)
+
+	BlockedAddr(addr sdk.AccAddress) bool
 }
 
+// SendRestriction is run before coins are transferred from fromAddr to toAddr.
+// It returns the address the coins are actually sent to, eg. an escrow address
+// instead of toAddr, or an error if the transfer is not allowed.
+//
+// For transfers with several inputs, it is run on every input with an empty
+// toAddr and then on every output with an empty fromAddr. It is also run on
+// delegations with an empty toAddr. The returned address is ignored whenever
+// toAddr is empty. The state written by the restrictions is discarded if the
+// transfer fails.
+type SendRestriction func(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins,
+) (sdk.AccAddress, sdk.Error)
+
 var _ SendKeeper = (*BaseSendKeeper)(nil)
 
 // BaseSendKeeper only allows transfers between accounts without the possibility of
@@ -135,6 +235,12 @@ type BaseSendKeeper struct {
 
 	ak         auth.AccountKeeper
 	paramSpace params.Subspace
+
+	// restrictions run in order on every transfer
+	restrictions []SendRestriction
+
+	// addresses which can't receive coins from the send messages
+	blockedAddrs map[string]bool
 }
 
 // NewBaseSendKeeper returns a new BaseSendKeeper.
@@ -156,7 +262,62 @@ func (keeper BaseSendKeeper) SendCoins(
 	if !amt.IsValid() {
 		return sdk.ErrInvalidCoins(amt.String())
 	}
-	return sendCoins(ctx, keeper.ak, fromAddr, toAddr, amt)
+
+	if len(keeper.restrictions) == 0 {
+		return sendCoins(ctx, keeper.ak, fromAddr, toAddr, amt)
+	}
+
+	// the restrictions only persist their state if the transfer succeeds
+	cacheCtx, write := ctx.CacheContext()
+	toAddr, err := keeper.applySendRestrictions(cacheCtx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+	if err := sendCoins(cacheCtx, keeper.ak, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
+	write()
+	return nil
+}
+
+// WithSendRestriction returns a copy of the keeper which also runs the given
+// restriction on every transfer, after the restrictions already set.
+func (keeper BaseSendKeeper) WithSendRestriction(restriction SendRestriction) BaseSendKeeper {
+	restrictions := make([]SendRestriction, len(keeper.restrictions), len(keeper.restrictions)+1)
+	copy(restrictions, keeper.restrictions)
+	keeper.restrictions = append(restrictions, restriction)
+	return keeper
+}
+
+// WithBlockedAddrs returns a copy of the keeper which rejects the send and
+// multi-send messages to the given addresses, eg. the module accounts.
+// Transfers made by other modules are not affected.
+func (keeper BaseSendKeeper) WithBlockedAddrs(addrs map[string]bool) BaseSendKeeper {
+	keeper.blockedAddrs = addrs
+	return keeper
+}
+
+// BlockedAddr returns true if the address can't receive coins from the send
+// and multi-send messages
+func (keeper BaseSendKeeper) BlockedAddr(addr sdk.AccAddress) bool {
+	return keeper.blockedAddrs[addr.String()]
+}
+
+// applySendRestrictions runs the send restrictions in order, each one on the
+// recipient returned by the previous one, and returns the final recipient.
+func (keeper BaseSendKeeper) applySendRestrictions(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins,
+) (sdk.AccAddress, sdk.Error) {
+
+	for _, restriction := range keeper.restrictions {
+		var err sdk.Error
+		toAddr, err = restriction(ctx, fromAddr, toAddr, amt)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return toAddr, nil
 }
 
 // GetSendEnabled returns the current SendEnabled
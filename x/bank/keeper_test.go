@@ -133,6 +133,114 @@ func TestKeeper(t *testing.T) {
 	require.True(t, bankKeeper.GetCoins(ctx, addr3).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("barcoin", 2), sdk.NewInt64Coin("foocoin", 5))))
 }
 
+func TestKeeperStrictMode(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+	bankKeeper := NewBaseKeeper(input.ak, input.pk.Subspace(DefaultParamspace), DefaultCodespace)
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	negative := sdk.Coins{{"foocoin", sdk.NewInt(-10)}}
+
+	// errors without strict mode
+	require.NotNil(t, bankKeeper.SetCoins(ctx, addr, negative))
+
+	// panics in strict mode, except in CheckTx
+	bankKeeper.SetStrictMode(true)
+	require.NotNil(t, bankKeeper.SetCoins(ctx.WithIsCheckTx(true), addr, negative))
+	require.Panics(t, func() {
+		bankKeeper.SetCoins(ctx, addr, negative) // nolint: errcheck
+	})
+
+	// other invalid coins still only error
+	unsorted := sdk.Coins{sdk.NewInt64Coin("foocoin", 10), sdk.NewInt64Coin("barcoin", 10)}
+	require.NotNil(t, bankKeeper.SetCoins(ctx, addr, unsorted))
+}
+
+func TestKeeperSendRestriction(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	addr3 := sdk.AccAddress([]byte("addr3"))
+	escrow := sdk.AccAddress([]byte("escrow"))
+	blocked := sdk.AccAddress([]byte("blocked"))
+
+	// coins for addr2 are redirected to the escrow address and transfers to
+	// the blocked address are rejected
+	bankKeeper := NewBaseKeeper(input.ak, input.pk.Subspace(DefaultParamspace), DefaultCodespace).
+		WithSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, sdk.Error) {
+			if toAddr.Equals(addr2) {
+				return escrow, nil
+			}
+			return toAddr, nil
+		}).
+		WithSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, sdk.Error) {
+			if toAddr.Equals(blocked) {
+				return nil, sdk.ErrUnauthorized("blocked address")
+			}
+			return toAddr, nil
+		})
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10))
+	bankKeeper.SetCoins(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 100)))
+
+	require.Nil(t, bankKeeper.SendCoins(ctx, addr, addr2, coins))
+	require.True(t, bankKeeper.GetCoins(ctx, addr2).Empty())
+	require.True(t, bankKeeper.GetCoins(ctx, escrow).IsEqual(coins))
+
+	require.Nil(t, bankKeeper.SendCoins(ctx, addr, addr3, coins))
+	require.True(t, bankKeeper.GetCoins(ctx, addr3).IsEqual(coins))
+
+	require.NotNil(t, bankKeeper.SendCoins(ctx, addr, blocked, coins))
+	require.True(t, bankKeeper.GetCoins(ctx, blocked).Empty())
+
+	// the outputs of multi-sends are restricted as well
+	inputs := []Input{NewInput(addr, coins.Add(coins))}
+	outputs := []Output{NewOutput(addr2, coins), NewOutput(addr3, coins)}
+	_, err := bankKeeper.InputOutputCoins(ctx, inputs, outputs)
+	require.Nil(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, escrow).IsEqual(coins.Add(coins)))
+	require.True(t, bankKeeper.GetCoins(ctx, addr3).IsEqual(coins.Add(coins)))
+
+	outputs = []Output{NewOutput(blocked, coins), NewOutput(addr3, coins)}
+	_, err = bankKeeper.InputOutputCoins(ctx, inputs, outputs)
+	require.NotNil(t, err)
+}
+
+func TestKeeperBlockedAddrs(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	moduleAddr := auth.NewModuleAddress("pool")
+
+	bankKeeper := NewBaseKeeper(input.ak, input.pk.Subspace(DefaultParamspace), DefaultCodespace).
+		WithBlockedAddrs(map[string]bool{moduleAddr.String(): true})
+	bankKeeper.SetSendEnabled(ctx, true)
+	handler := NewHandler(bankKeeper)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10))
+	bankKeeper.SetCoins(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 100)))
+	require.True(t, bankKeeper.BlockedAddr(moduleAddr))
+	require.False(t, bankKeeper.BlockedAddr(addr2))
+
+	// the send messages to the blocked address are rejected
+	res := handler(ctx, NewMsgSend(addr, moduleAddr, coins))
+	require.Equal(t, CodeBlockedRecipient, res.Code)
+
+	msg := NewMsgMultiSend([]Input{NewInput(addr, coins.Add(coins))},
+		[]Output{NewOutput(addr2, coins), NewOutput(moduleAddr, coins)})
+	res = handler(ctx, msg)
+	require.Equal(t, CodeBlockedRecipient, res.Code)
+	require.True(t, bankKeeper.GetCoins(ctx, addr2).Empty())
+
+	// while the other modules can still transfer coins to it
+	require.Nil(t, bankKeeper.SendCoins(ctx, addr, moduleAddr, coins))
+	require.True(t, bankKeeper.GetCoins(ctx, moduleAddr).IsEqual(coins))
+}
+
 func TestSendKeeper(t *testing.T) {
 	input := setupTestInput()
 	ctx := input.ctx
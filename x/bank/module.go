@@ -10,8 +10,9 @@ import (
 )
 
 var (
-	_ sdk.AppModule      = AppModule{}
-	_ sdk.AppModuleBasic = AppModuleBasic{}
+	_ sdk.AppModule          = AppModule{}
+	_ sdk.AppModuleBasic     = AppModuleBasic{}
+	_ sdk.AppModuleDescriber = AppModule{}
 )
 
 // name of this module
@@ -85,10 +86,20 @@ func (am AppModule) NewHandler() sdk.Handler {
 }
 
 // module querier route name
-func (AppModule) QuerierRoute() string { return "" }
+func (AppModule) QuerierRoute() string { return QuerierRoute }
 
 // module querier
-func (AppModule) NewQuerierHandler() sdk.Querier { return nil }
+func (am AppModule) NewQuerierHandler() sdk.Querier { return NewQuerier(am.keeper) }
+
+// module message types
+func (AppModule) MsgTypes() []string {
+	return []string{MsgSend{}.Type(), MsgMultiSend{}.Type()}
+}
+
+// module query paths
+func (AppModule) QueryPaths() []string {
+	return []string{QueryBalances}
+}
 
 // module init-genesis
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
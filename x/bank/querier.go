@@ -0,0 +1,82 @@
+package bank
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// QuerierRoute is the querier route for bank
+const QuerierRoute = ModuleName
+
+// query endpoints supported by the bank Querier
+const (
+	QueryBalances = "balances"
+)
+
+// default number of coins returned in a page of balances
+const defaultBalancesLimit = 100
+
+// NewQuerier creates a new querier for bank clients.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QueryBalances:
+			return queryBalances(ctx, req, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown bank query endpoint")
+		}
+	}
+}
+
+// QueryBalancesParams defines the params for the following queries:
+// - 'custom/bank/balances'
+type QueryBalancesParams struct {
+	Address     sdk.AccAddress
+	Page, Limit int
+}
+
+func NewQueryBalancesParams(addr sdk.AccAddress, page, limit int) QueryBalancesParams {
+	return QueryBalancesParams{addr, page, limit}
+}
+
+// queryBalances returns a page of the coins of an account, in denom order
+func queryBalances(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryBalancesParams
+
+	err := moduleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.Limit <= 0 {
+		params.Limit = defaultBalancesLimit
+	}
+	if params.Page < 1 {
+		params.Page = 1
+	}
+
+	// get pagination bounds, checking the page against the number of coins
+	// first so that large pages and limits can't overflow
+	coins := k.GetCoins(ctx, params.Address)
+	start, end := len(coins), len(coins)
+	if params.Page-1 <= len(coins)/params.Limit {
+		start = (params.Page - 1) * params.Limit
+		if params.Limit < end-start {
+			end = start + params.Limit
+		}
+	}
+
+	balances := sdk.Coins{}
+	balances = append(balances, coins[start:end]...)
+
+	res, err := codec.MarshalJSONIndent(moduleCdc, balances)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
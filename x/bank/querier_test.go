@@ -0,0 +1,57 @@
+package bank
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestQueryBalances(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+	bankKeeper := NewBaseKeeper(input.ak, input.pk.Subspace(DefaultParamspace), DefaultCodespace)
+	querier := NewQuerier(bankKeeper)
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	coins := sdk.NewCoins(
+		sdk.NewInt64Coin("barcoin", 1),
+		sdk.NewInt64Coin("bazcoin", 2),
+		sdk.NewInt64Coin("foocoin", 3),
+	)
+	bankKeeper.SetCoins(ctx, addr, coins)
+
+	query := func(page, limit int) sdk.Coins {
+		req := abci.RequestQuery{
+			Path: fmt.Sprintf("custom/%s/%s", QuerierRoute, QueryBalances),
+			Data: moduleCdc.MustMarshalJSON(NewQueryBalancesParams(addr, page, limit)),
+		}
+		res, err := querier(ctx, []string{QueryBalances}, req)
+		require.Nil(t, err)
+
+		var balances sdk.Coins
+		moduleCdc.MustUnmarshalJSON(res, &balances)
+		return balances
+	}
+
+	// the balances are paginated in denom order
+	require.True(t, coins.IsEqual(query(0, 0)))
+	require.True(t, coins[:2].IsEqual(query(1, 2)))
+	require.True(t, coins[2:].IsEqual(query(2, 2)))
+	require.Empty(t, query(3, 2))
+
+	// pages and limits overflowing the bounds don't panic
+	require.Empty(t, query(math.MaxInt64, 2))
+	require.Empty(t, query(math.MaxInt64, math.MaxInt64))
+	require.True(t, coins.IsEqual(query(1, math.MaxInt64)))
+	require.Empty(t, query(2, math.MaxInt64))
+
+	_, err := querier(ctx, []string{QueryBalances}, abci.RequestQuery{Data: []byte("invalid")})
+	require.NotNil(t, err)
+	_, err = querier(ctx, []string{"other"}, abci.RequestQuery{})
+	require.NotNil(t, err)
+}
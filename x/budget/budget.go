@@ -0,0 +1,145 @@
+package budget
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Budget defines a payment streamed from the community pool to a recipient.
+// The amount accrues every period (in blocks) from the start height until the
+// end height, if any, and is paid out when claimed by the recipient.
+type Budget struct {
+	BudgetID       uint64         `json:"budget_id"`
+	Recipient      sdk.AccAddress `json:"recipient"`
+	Amount         sdk.Coins      `json:"amount"`          // amount paid every period
+	Period         int64          `json:"period"`          // length of a period in blocks
+	StartHeight    int64          `json:"start_height"`    // height the first period starts at
+	EndHeight      int64          `json:"end_height"`      // height payments stop accruing at, 0 if unbounded
+	ClaimedPeriods int64          `json:"claimed_periods"` // number of periods already paid out
+	Claimed        sdk.Coins      `json:"claimed"`         // total amount already paid out
+}
+
+// NewBudget creates a new Budget object
+func NewBudget(budgetID uint64, recipient sdk.AccAddress, amount sdk.Coins,
+	period, startHeight, endHeight int64) Budget {
+
+	return Budget{
+		BudgetID:    budgetID,
+		Recipient:   recipient,
+		Amount:      amount,
+		Period:      period,
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+	}
+}
+
+// AccruedPeriods returns the number of periods elapsed at the given height,
+// whether they were claimed or not.
+func (b Budget) AccruedPeriods(height int64) int64 {
+	if b.EndHeight > 0 && height > b.EndHeight {
+		height = b.EndHeight
+	}
+
+	if height <= b.StartHeight {
+		return 0
+	}
+
+	return (height - b.StartHeight) / b.Period
+}
+
+// Claimable returns the number of periods elapsed at the given height that
+// were not claimed yet and the amount they accrued.
+func (b Budget) Claimable(height int64) (periods int64, amount sdk.Coins) {
+	periods = b.AccruedPeriods(height) - b.ClaimedPeriods
+	if periods <= 0 {
+		return 0, sdk.Coins{}
+	}
+
+	amount = make(sdk.Coins, len(b.Amount))
+	for i, coin := range b.Amount {
+		amount[i] = sdk.NewCoin(coin.Denom, coin.Amount.MulRaw(periods))
+	}
+
+	return periods, amount
+}
+
+// IsExhausted returns true if the budget has ended at the given height and
+// all its periods were claimed.
+func (b Budget) IsExhausted(height int64) bool {
+	return b.EndHeight > 0 && height >= b.EndHeight &&
+		b.ClaimedPeriods >= b.AccruedPeriods(b.EndHeight)
+}
+
+// Validate performs basic validation of a budget, returning errors in the
+// given codespace
+func (b Budget) Validate(codespace sdk.CodespaceType) sdk.Error {
+	if b.Recipient.Empty() {
+		return ErrInvalidBudget(codespace, "recipient cannot be empty")
+	}
+	if !b.Amount.IsValid() || b.Amount.Empty() {
+		return ErrInvalidBudget(codespace, fmt.Sprintf("invalid amount %s", b.Amount))
+	}
+	if b.Period <= 0 {
+		return ErrInvalidBudget(codespace, fmt.Sprintf("period must be positive, is %d", b.Period))
+	}
+	if b.EndHeight != 0 && b.EndHeight <= b.StartHeight {
+		return ErrInvalidBudget(codespace, fmt.Sprintf("end height %d must be greater than start height %d", b.EndHeight, b.StartHeight))
+	}
+	if b.ClaimedPeriods < 0 {
+		return ErrInvalidBudget(codespace, fmt.Sprintf("claimed periods cannot be negative, is %d", b.ClaimedPeriods))
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (b Budget) String() string {
+	return fmt.Sprintf(`Budget %d:
+  Recipient:       %s
+  Amount:          %s
+  Period:          %d
+  Start Height:    %d
+  End Height:      %d
+  Claimed Periods: %d
+  Claimed:         %s`, b.BudgetID, b.Recipient, b.Amount, b.Period,
+		b.StartHeight, b.EndHeight, b.ClaimedPeriods, b.Claimed)
+}
+
+// Budgets is a collection of budgets
+type Budgets []Budget
+
+// String implements the Stringer interface.
+func (b Budgets) String() string {
+	out := "ID - (Recipient) Amount/Period\n"
+	for _, budget := range b {
+		out += fmt.Sprintf("%d - (%s) %s/%d\n",
+			budget.BudgetID, budget.Recipient, budget.Amount, budget.Period)
+	}
+	return out
+}
+
+// ClaimableBudget defines the amount claimable from a budget at a given height
+type ClaimableBudget struct {
+	BudgetID uint64    `json:"budget_id"`
+	Height   int64     `json:"height"`
+	Periods  int64     `json:"periods"`
+	Amount   sdk.Coins `json:"amount"`
+}
+
+// NewClaimableBudget creates a new ClaimableBudget object
+func NewClaimableBudget(budgetID uint64, height, periods int64, amount sdk.Coins) ClaimableBudget {
+	return ClaimableBudget{
+		BudgetID: budgetID,
+		Height:   height,
+		Periods:  periods,
+		Amount:   amount,
+	}
+}
+
+// String implements the Stringer interface.
+func (cb ClaimableBudget) String() string {
+	return fmt.Sprintf(`Claimable Budget %d:
+  Height:  %d
+  Periods: %d
+  Amount:  %s`, cb.BudgetID, cb.Height, cb.Periods, cb.Amount)
+}
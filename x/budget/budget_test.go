@@ -0,0 +1,56 @@
+package budget
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestBudgetClaimable(t *testing.T) {
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	tests := []struct {
+		budget          Budget
+		height          int64
+		expectedPeriods int64
+		expectedAmount  sdk.Coins
+	}{
+		{NewBudget(1, addr1, amount, 5, 10, 0), 10, 0, sdk.Coins{}},
+		{NewBudget(1, addr1, amount, 5, 10, 0), 14, 0, sdk.Coins{}},
+		{NewBudget(1, addr1, amount, 5, 10, 0), 15, 1, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))},
+		{NewBudget(1, addr1, amount, 5, 10, 0), 32, 4, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 40))},
+		{NewBudget(1, addr1, amount, 5, 10, 20), 32, 2, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20))},
+		{Budget{BudgetID: 1, Recipient: addr1, Amount: amount, Period: 5, StartHeight: 10, ClaimedPeriods: 3}, 32, 1, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))},
+		{Budget{BudgetID: 1, Recipient: addr1, Amount: amount, Period: 5, StartHeight: 10, EndHeight: 20, ClaimedPeriods: 2}, 32, 0, sdk.Coins{}},
+	}
+
+	for i, tc := range tests {
+		periods, claimable := tc.budget.Claimable(tc.height)
+		require.Equal(t, tc.expectedPeriods, periods, "tc #%d", i)
+		require.True(t, tc.expectedAmount.IsEqual(claimable), "tc #%d: expected %s, got %s", i, tc.expectedAmount, claimable)
+	}
+}
+
+func TestBudgetValidate(t *testing.T) {
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	require.Nil(t, NewBudget(1, addr1, amount, 5, 10, 0).Validate(DefaultCodespace))
+	require.Nil(t, NewBudget(1, addr1, amount, 5, 10, 20).Validate(DefaultCodespace))
+	require.NotNil(t, NewBudget(1, nil, amount, 5, 10, 0).Validate(DefaultCodespace))
+	require.NotNil(t, NewBudget(1, addr1, sdk.Coins{}, 5, 10, 0).Validate(DefaultCodespace))
+	require.NotNil(t, NewBudget(1, addr1, amount, 0, 10, 0).Validate(DefaultCodespace))
+	require.NotNil(t, NewBudget(1, addr1, amount, 5, 10, 10).Validate(DefaultCodespace))
+}
+
+func TestBudgetProposalValidateBasic(t *testing.T) {
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	require.Nil(t, NewBudgetProposal("title", "description", addr1, amount, 5, 0).ValidateBasic())
+	require.Nil(t, NewBudgetProposal("title", "description", addr1, amount, 5, 3).ValidateBasic())
+	require.NotNil(t, NewBudgetProposal("title", "description", addr1, amount, 5, -1).ValidateBasic())
+	require.NotNil(t, NewBudgetProposal("title", "description", addr1, amount, 0, 3).ValidateBasic())
+	require.NotNil(t, NewBudgetProposal("title", "description", addr1, amount, math.MaxInt64/2, 3).ValidateBasic())
+}
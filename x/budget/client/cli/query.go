@@ -0,0 +1,114 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/budget"
+)
+
+// GetCmdQueryBudgets implements the query budgets command.
+func GetCmdQueryBudgets(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "budgets",
+		Args:  cobra.NoArgs,
+		Short: "Query all the budgets funded by the community pool",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, budget.QueryBudgets), nil)
+			if err != nil {
+				return err
+			}
+
+			var budgets budget.Budgets
+			cdc.MustUnmarshalJSON(res, &budgets)
+			return cliCtx.PrintOutput(budgets)
+		},
+	}
+}
+
+// GetCmdQueryBudget implements the query budget command.
+func GetCmdQueryBudget(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "budget [budget-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query details of a single budget",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query details of a budget, including the amount already claimed.
+
+Example:
+$ %s query budget budget 1
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := budgetQueryParams(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, budget.QueryBudget), bz)
+			if err != nil {
+				return err
+			}
+
+			var b budget.Budget
+			cdc.MustUnmarshalJSON(res, &b)
+			return cliCtx.PrintOutput(b)
+		},
+	}
+}
+
+// GetCmdQueryClaimable implements the query claimable command.
+func GetCmdQueryClaimable(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "claimable [budget-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the amount currently claimable from a budget",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the amount accrued by a budget since its last claim.
+
+Example:
+$ %s query budget claimable 1
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := budgetQueryParams(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, budget.QueryClaimable), bz)
+			if err != nil {
+				return err
+			}
+
+			var claimable budget.ClaimableBudget
+			cdc.MustUnmarshalJSON(res, &claimable)
+			return cliCtx.PrintOutput(claimable)
+		},
+	}
+}
+
+func budgetQueryParams(cdc *codec.Codec, arg string) ([]byte, error) {
+	budgetID, err := strconv.ParseUint(arg, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("budget-id %s not a valid uint, please input a valid budget-id", arg)
+	}
+
+	return cdc.MarshalJSON(budget.NewQueryBudgetParams(budgetID))
+}
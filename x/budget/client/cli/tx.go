@@ -0,0 +1,180 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	"github.com/cosmos/cosmos-sdk/x/budget"
+	budgetcutils "github.com/cosmos/cosmos-sdk/x/budget/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+// GetCmdClaimBudget implements the command to claim the payments accrued by
+// a budget.
+func GetCmdClaimBudget(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "claim [budget-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Claim the payments accrued by a budget",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claim the payments accrued by a budget since its last claim. Only the
+recipient of the budget can claim it.
+
+Example:
+$ %s tx budget claim 1 --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			budgetID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("budget-id %s not a valid uint, please input a valid budget-id", args[0])
+			}
+
+			msg := budget.NewMsgClaimBudget(budgetID, cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSubmitBudgetProposal implements the command to submit a budget
+// proposal.
+func GetCmdSubmitBudgetProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "budget [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a budget proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to stream an amount from the community pool to a
+recipient every period (in blocks), along with an initial deposit. The budget
+starts when the proposal passes and runs for the given number of periods, or
+until it is cancelled if the number of periods is zero. The proposal details
+must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal budget <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Block Explorer Funding",
+  "description": "Fund the maintenance of a block explorer",
+  "recipient": "cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq",
+  "amount": [
+    {
+      "denom": "stake",
+      "amount": "100"
+    }
+  ],
+  "period": "14400",
+  "periods": "30",
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			proposal, err := budgetcutils.ParseBudgetProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := budget.NewBudgetProposal(proposal.Title, proposal.Description,
+				proposal.Recipient, proposal.Amount, proposal.Period, proposal.Periods)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSubmitCancelBudgetProposal implements the command to submit a cancel
+// budget proposal.
+func GetCmdSubmitCancelBudgetProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-budget [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a cancel budget proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to stop a budget from accruing payments, along with an
+initial deposit. The payments accrued before the proposal passes can still be
+claimed. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal cancel-budget <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Stop Block Explorer Funding",
+  "description": "The block explorer is no longer maintained",
+  "budget_id": "1",
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			proposal, err := budgetcutils.ParseCancelBudgetProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := budget.NewCancelBudgetProposal(proposal.Title, proposal.Description, proposal.BudgetID)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"github.com/spf13/cobra"
+	amino "github.com/tendermint/go-amino"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/x/budget"
+	"github.com/cosmos/cosmos-sdk/x/budget/client/cli"
+)
+
+// ModuleClient exports all client functionality from this module
+type ModuleClient struct {
+	storeKey string
+	cdc      *amino.Codec
+}
+
+// NewModuleClient creates a new ModuleClient object
+func NewModuleClient(storeKey string, cdc *amino.Codec) ModuleClient {
+	return ModuleClient{
+		storeKey: storeKey,
+		cdc:      cdc,
+	}
+}
+
+// GetQueryCmd returns the cli query commands for this module
+func (mc ModuleClient) GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:   budget.ModuleName,
+		Short: "Querying commands for the budget module",
+	}
+
+	queryCmd.AddCommand(client.GetCommands(
+		cli.GetCmdQueryBudgets(mc.storeKey, mc.cdc),
+		cli.GetCmdQueryBudget(mc.storeKey, mc.cdc),
+		cli.GetCmdQueryClaimable(mc.storeKey, mc.cdc),
+	)...)
+	return queryCmd
+}
+
+// GetTxCmd returns the transaction commands for this module
+func (mc ModuleClient) GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:   budget.ModuleName,
+		Short: "Budget transactions subcommands",
+	}
+
+	txCmd.AddCommand(client.PostCommands(
+		cli.GetCmdClaimBudget(mc.cdc),
+	)...)
+	return txCmd
+}
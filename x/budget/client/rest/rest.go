@@ -0,0 +1,162 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	clientrest "github.com/cosmos/cosmos-sdk/client/rest"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/budget"
+	budgetcutils "github.com/cosmos/cosmos-sdk/x/budget/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+)
+
+// RestBudgetID is the id of the budget in the URL
+const RestBudgetID = "budget-id"
+
+type claimBudgetReq struct {
+	BaseReq   rest.BaseReq   `json:"base_req"`
+	Recipient sdk.AccAddress `json:"recipient"`
+}
+
+// RegisterRoutes registers budget-related REST handlers to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+	r.HandleFunc("/budget/budgets", queryBudgetsHandlerFn(cdc, cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/budget/budgets/{%s}", RestBudgetID), queryBudgetHandlerFn(cdc, cliCtx, budget.QueryBudget)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/budget/budgets/{%s}/claimable", RestBudgetID), queryBudgetHandlerFn(cdc, cliCtx, budget.QueryClaimable)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/budget/budgets/{%s}/claim", RestBudgetID), claimBudgetHandlerFn(cdc, cliCtx)).Methods("POST")
+}
+
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the budget
+// proposal REST handler.
+func ProposalRESTHandler(cliCtx context.CLIContext, cdc *codec.Codec) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "budget",
+		Handler:  postBudgetProposalHandlerFn(cdc, cliCtx),
+	}
+}
+
+// CancelProposalRESTHandler returns a ProposalRESTHandler that exposes the
+// cancel budget proposal REST handler.
+func CancelProposalRESTHandler(cliCtx context.CLIContext, cdc *codec.Codec) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "cancel_budget",
+		Handler:  postCancelBudgetProposalHandlerFn(cdc, cliCtx),
+	}
+}
+
+func queryBudgetsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", budget.QuerierRoute, budget.QueryBudgets), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func queryBudgetHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		budgetID, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)[RestBudgetID])
+		if !ok {
+			return
+		}
+
+		bz, err := cdc.MarshalJSON(budget.NewQueryBudgetParams(budgetID))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", budget.QuerierRoute, endpoint), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func claimBudgetHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		budgetID, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)[RestBudgetID])
+		if !ok {
+			return
+		}
+
+		var req claimBudgetReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		msg := budget.NewMsgClaimBudget(budgetID, req.Recipient)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postBudgetProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req budgetcutils.BudgetProposalReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := budget.NewBudgetProposal(req.Title, req.Description, req.Recipient, req.Amount, req.Period, req.Periods)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postCancelBudgetProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req budgetcutils.CancelBudgetProposalReq
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := budget.NewCancelBudgetProposal(req.Title, req.Description, req.BudgetID)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
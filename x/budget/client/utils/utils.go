@@ -0,0 +1,90 @@
+package utils
+
+import (
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+type (
+	// BudgetProposalJSON defines a BudgetProposal with a deposit used to parse
+	// budget proposals from a JSON file.
+	BudgetProposalJSON struct {
+		Title       string         `json:"title"`
+		Description string         `json:"description"`
+		Recipient   sdk.AccAddress `json:"recipient"`
+		Amount      sdk.Coins      `json:"amount"`
+		Period      int64          `json:"period"`
+		Periods     int64          `json:"periods"`
+		Deposit     sdk.Coins      `json:"deposit"`
+	}
+
+	// CancelBudgetProposalJSON defines a CancelBudgetProposal with a deposit
+	// used to parse cancel budget proposals from a JSON file.
+	CancelBudgetProposalJSON struct {
+		Title       string    `json:"title"`
+		Description string    `json:"description"`
+		BudgetID    uint64    `json:"budget_id"`
+		Deposit     sdk.Coins `json:"deposit"`
+	}
+
+	// BudgetProposalReq defines a budget proposal request body.
+	BudgetProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req"`
+
+		Title       string         `json:"title"`
+		Description string         `json:"description"`
+		Recipient   sdk.AccAddress `json:"recipient"`
+		Amount      sdk.Coins      `json:"amount"`
+		Period      int64          `json:"period"`
+		Periods     int64          `json:"periods"`
+		Proposer    sdk.AccAddress `json:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit"`
+	}
+
+	// CancelBudgetProposalReq defines a cancel budget proposal request body.
+	CancelBudgetProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req"`
+
+		Title       string         `json:"title"`
+		Description string         `json:"description"`
+		BudgetID    uint64         `json:"budget_id"`
+		Proposer    sdk.AccAddress `json:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit"`
+	}
+)
+
+// ParseBudgetProposalJSON reads and parses a BudgetProposalJSON from file.
+func ParseBudgetProposalJSON(cdc *codec.Codec, proposalFile string) (BudgetProposalJSON, error) {
+	proposal := BudgetProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseCancelBudgetProposalJSON reads and parses a CancelBudgetProposalJSON
+// from file.
+func ParseCancelBudgetProposalJSON(cdc *codec.Codec, proposalFile string) (CancelBudgetProposalJSON, error) {
+	proposal := CancelBudgetProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
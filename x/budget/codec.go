@@ -0,0 +1,22 @@
+package budget
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// Register concrete types on codec codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgClaimBudget{}, "cosmos-sdk/MsgClaimBudget", nil)
+	cdc.RegisterConcrete(BudgetProposal{}, "cosmos-sdk/BudgetProposal", nil)
+	cdc.RegisterConcrete(CancelBudgetProposal{}, "cosmos-sdk/CancelBudgetProposal", nil)
+}
+
+// generic sealed codec to be used throughout module
+var moduleCdc *codec.Codec
+
+func init() {
+	cdc := codec.New()
+	RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	moduleCdc = cdc.Seal()
+}
@@ -0,0 +1,38 @@
+package budget
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeUnknownBudget  sdk.CodeType = 1
+	CodeInvalidBudget  sdk.CodeType = 2
+	CodeNothingToClaim sdk.CodeType = 3
+	CodeInvalidClaimer sdk.CodeType = 4
+)
+
+// ErrUnknownBudget returns an error for a budget that does not exist
+func ErrUnknownBudget(codespace sdk.CodespaceType, budgetID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownBudget, fmt.Sprintf("unknown budget with id %d", budgetID))
+}
+
+// ErrInvalidBudget returns an error for an invalid budget
+func ErrInvalidBudget(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidBudget, msg)
+}
+
+// ErrNothingToClaim returns an error for a claim on a budget without any
+// accrued payment
+func ErrNothingToClaim(codespace sdk.CodespaceType, budgetID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeNothingToClaim, fmt.Sprintf("nothing to claim from budget %d", budgetID))
+}
+
+// ErrInvalidClaimer returns an error for a claim not made by the budget
+// recipient
+func ErrInvalidClaimer(codespace sdk.CodespaceType, claimer sdk.AccAddress, budgetID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidClaimer, fmt.Sprintf("%s is not the recipient of budget %d", claimer, budgetID))
+}
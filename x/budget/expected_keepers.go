@@ -0,0 +1,10 @@
+package budget
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DistrKeeper expected distribution keeper
+type DistrKeeper interface {
+	DistributeFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) sdk.Error
+}
@@ -0,0 +1,67 @@
+package budget
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - budget genesis state
+type GenesisState struct {
+	NextBudgetID uint64  `json:"next_budget_id"`
+	Budgets      Budgets `json:"budgets"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(nextBudgetID uint64, budgets Budgets) GenesisState {
+	return GenesisState{
+		NextBudgetID: nextBudgetID,
+		Budgets:      budgets,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		NextBudgetID: 1,
+		Budgets:      Budgets{},
+	}
+}
+
+// InitGenesis sets the budgets from a genesis state
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	k.SetNextBudgetID(ctx, data.NextBudgetID)
+	for _, budget := range data.Budgets {
+		k.SetBudget(ctx, budget)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	return NewGenesisState(k.GetNextBudgetID(ctx), k.GetBudgets(ctx))
+}
+
+// ValidateGenesis performs basic validation of the budgets genesis data
+// returning an error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	if data.NextBudgetID == 0 {
+		return fmt.Errorf("next budget ID must be positive")
+	}
+
+	seen := make(map[uint64]bool)
+	for _, budget := range data.Budgets {
+		if seen[budget.BudgetID] {
+			return fmt.Errorf("duplicate budget ID %d", budget.BudgetID)
+		}
+		if budget.BudgetID == 0 || budget.BudgetID >= data.NextBudgetID {
+			return fmt.Errorf("budget ID %d must be positive and less than the next budget ID %d",
+				budget.BudgetID, data.NextBudgetID)
+		}
+		if err := budget.Validate(DefaultCodespace); err != nil {
+			return fmt.Errorf("invalid budget %d: %s", budget.BudgetID, err.Result().Log)
+		}
+		seen[budget.BudgetID] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package budget
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/budget/tags"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewHandler returns a handler for "budget" type messages
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case MsgClaimBudget:
+			return handleMsgClaimBudget(ctx, k, msg)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized budget message type: %T", msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgClaimBudget(ctx sdk.Context, k Keeper, msg MsgClaimBudget) sdk.Result {
+	_, err := k.ClaimBudget(ctx, msg.BudgetID, msg.Recipient)
+	if err != nil {
+		return err.Result()
+	}
+
+	resTags := sdk.NewTags(
+		tags.Category, tags.TxCategory,
+		tags.Sender, msg.Recipient.String(),
+		tags.BudgetID, fmt.Sprintf("%d", msg.BudgetID),
+	)
+	return sdk.Result{
+		Tags: resTags,
+	}
+}
+
+// NewBudgetProposalHandler returns a handler for the budget proposals
+func NewBudgetProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case BudgetProposal:
+			return handleBudgetProposal(ctx, k, c)
+
+		case CancelBudgetProposal:
+			return handleCancelBudgetProposal(ctx, k, c)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized budget proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
+
+func handleBudgetProposal(ctx sdk.Context, k Keeper, p BudgetProposal) sdk.Error {
+	budget, err := k.CreateBudget(ctx, p.Recipient, p.Amount, p.Period, p.Periods)
+	if err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info(
+		fmt.Sprintf("created budget %d; recipient: %s, amount: %s, period: %d",
+			budget.BudgetID, budget.Recipient, budget.Amount, budget.Period),
+	)
+	return nil
+}
+
+func handleCancelBudgetProposal(ctx sdk.Context, k Keeper, p CancelBudgetProposal) sdk.Error {
+	if err := k.CancelBudget(ctx, p.BudgetID); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("cancelled budget %d", p.BudgetID))
+	return nil
+}
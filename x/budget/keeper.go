@@ -0,0 +1,189 @@
+package budget
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper of the budget store
+type Keeper struct {
+	storeKey    sdk.StoreKey
+	cdc         *codec.Codec
+	distrKeeper DistrKeeper
+	codespace   sdk.CodespaceType
+}
+
+// NewKeeper creates a new budget Keeper instance
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, distrKeeper DistrKeeper, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:    key,
+		cdc:         cdc,
+		distrKeeper: distrKeeper,
+		codespace:   codespace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+ModuleName)
+}
+
+// GetBudget returns the budget with the given ID
+func (k Keeper) GetBudget(ctx sdk.Context, budgetID uint64) (budget Budget, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetBudgetKey(budgetID))
+	if bz == nil {
+		return budget, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &budget)
+	return budget, true
+}
+
+// SetBudget sets a budget
+func (k Keeper) SetBudget(ctx sdk.Context, budget Budget) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(budget)
+	store.Set(GetBudgetKey(budget.BudgetID), bz)
+}
+
+// DeleteBudget deletes a budget
+func (k Keeper) DeleteBudget(ctx sdk.Context, budgetID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetBudgetKey(budgetID))
+}
+
+// IterateBudgets iterates over the budgets in ascending ID order and performs
+// a callback function until it returns true
+func (k Keeper) IterateBudgets(ctx sdk.Context, cb func(budget Budget) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, BudgetKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var budget Budget
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &budget)
+		if cb(budget) {
+			break
+		}
+	}
+}
+
+// GetBudgets returns all the budgets
+func (k Keeper) GetBudgets(ctx sdk.Context) (budgets Budgets) {
+	k.IterateBudgets(ctx, func(budget Budget) bool {
+		budgets = append(budgets, budget)
+		return false
+	})
+	return budgets
+}
+
+// GetNextBudgetID returns the ID of the next budget to be created. Budget IDs
+// start at 1.
+func (k Keeper) GetNextBudgetID(ctx sdk.Context) (budgetID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(NextBudgetIDKey)
+	if bz == nil {
+		return 1
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &budgetID)
+	return budgetID
+}
+
+// SetNextBudgetID sets the ID of the next budget to be created
+func (k Keeper) SetNextBudgetID(ctx sdk.Context, budgetID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(budgetID)
+	store.Set(NextBudgetIDKey, bz)
+}
+
+// CreateBudget creates a new budget streaming an amount every period to a
+// recipient, starting at the current height. If periods is zero the budget
+// runs until it is cancelled.
+func (k Keeper) CreateBudget(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins,
+	period, periods int64) (Budget, sdk.Error) {
+
+	startHeight := ctx.BlockHeight()
+
+	var endHeight int64
+	if periods > 0 {
+		if period > (math.MaxInt64-startHeight)/periods {
+			return Budget{}, ErrInvalidBudget(k.codespace,
+				fmt.Sprintf("%d periods of %d blocks overflow the end height", periods, period))
+		}
+		endHeight = startHeight + period*periods
+	}
+
+	budgetID := k.GetNextBudgetID(ctx)
+	budget := NewBudget(budgetID, recipient, amount, period, startHeight, endHeight)
+	if err := budget.Validate(k.codespace); err != nil {
+		return Budget{}, err
+	}
+
+	k.SetBudget(ctx, budget)
+	k.SetNextBudgetID(ctx, budgetID+1)
+
+	return budget, nil
+}
+
+// CancelBudget stops a budget from accruing payments at the current height.
+// The payments accrued so far can still be claimed, and the budget is deleted
+// right away if there are none.
+func (k Keeper) CancelBudget(ctx sdk.Context, budgetID uint64) sdk.Error {
+	budget, found := k.GetBudget(ctx, budgetID)
+	if !found {
+		return ErrUnknownBudget(k.codespace, budgetID)
+	}
+
+	height := ctx.BlockHeight()
+	if budget.EndHeight == 0 || budget.EndHeight > height {
+		budget.EndHeight = height
+	}
+
+	if budget.IsExhausted(height) {
+		k.DeleteBudget(ctx, budgetID)
+		return nil
+	}
+
+	k.SetBudget(ctx, budget)
+	return nil
+}
+
+// ClaimBudget pays the recipient of a budget the amount accrued since the last
+// claim out of the community pool. The budget is deleted once it has ended
+// and all its periods were claimed.
+func (k Keeper) ClaimBudget(ctx sdk.Context, budgetID uint64, recipient sdk.AccAddress) (sdk.Coins, sdk.Error) {
+	budget, found := k.GetBudget(ctx, budgetID)
+	if !found {
+		return nil, ErrUnknownBudget(k.codespace, budgetID)
+	}
+
+	if !budget.Recipient.Equals(recipient) {
+		return nil, ErrInvalidClaimer(k.codespace, recipient, budgetID)
+	}
+
+	height := ctx.BlockHeight()
+	periods, amount := budget.Claimable(height)
+	if periods == 0 {
+		return nil, ErrNothingToClaim(k.codespace, budgetID)
+	}
+
+	if err := k.distrKeeper.DistributeFeePool(ctx, amount, recipient); err != nil {
+		return nil, err
+	}
+
+	budget.ClaimedPeriods += periods
+	budget.Claimed = budget.Claimed.Add(amount)
+
+	if budget.IsExhausted(height) {
+		k.DeleteBudget(ctx, budgetID)
+	} else {
+		k.SetBudget(ctx, budget)
+	}
+
+	return amount, nil
+}
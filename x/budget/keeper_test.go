@@ -0,0 +1,164 @@
+package budget
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestBudgetProposalAndClaims(t *testing.T) {
+	pool := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	ctx, keeper, dk := createTestInput(t, pool)
+	handler := NewHandler(keeper)
+	proposalHandler := NewBudgetProposalHandler(keeper)
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	// a budget of 10 every 5 blocks for 3 periods starting at height 10
+	ctx = ctx.WithBlockHeight(10)
+	proposal := NewBudgetProposal("title", "description", addr1, amount, 5, 3)
+	require.Nil(t, proposal.ValidateBasic())
+	require.Nil(t, proposalHandler(ctx, proposal))
+
+	budget, found := keeper.GetBudget(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, int64(10), budget.StartHeight)
+	require.Equal(t, int64(25), budget.EndHeight)
+	require.Equal(t, uint64(2), keeper.GetNextBudgetID(ctx))
+
+	// nothing accrued yet
+	ctx = ctx.WithBlockHeight(14)
+	res := handler(ctx, NewMsgClaimBudget(1, addr1))
+	require.Equal(t, CodeNothingToClaim, res.Code)
+
+	// only the recipient can claim
+	ctx = ctx.WithBlockHeight(21)
+	res = handler(ctx, NewMsgClaimBudget(1, addr2))
+	require.Equal(t, CodeInvalidClaimer, res.Code)
+
+	// two periods accrued
+	res = handler(ctx, NewMsgClaimBudget(1, addr1))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, dk.balances[addr1.String()].IsEqual(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20))))
+	require.True(t, dk.communityPool.IsEqual(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 80))))
+
+	budget, found = keeper.GetBudget(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, int64(2), budget.ClaimedPeriods)
+	require.True(t, budget.Claimed.IsEqual(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20))))
+
+	// the last period is claimed after the end of the budget, which deletes it
+	ctx = ctx.WithBlockHeight(40)
+	res = handler(ctx, NewMsgClaimBudget(1, addr1))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, dk.balances[addr1.String()].IsEqual(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 30))))
+
+	_, found = keeper.GetBudget(ctx, 1)
+	require.False(t, found)
+
+	res = handler(ctx, NewMsgClaimBudget(1, addr1))
+	require.Equal(t, CodeUnknownBudget, res.Code)
+}
+
+func TestClaimInsufficientCommunityPool(t *testing.T) {
+	pool := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 15))
+	ctx, keeper, dk := createTestInput(t, pool)
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	budget, err := keeper.CreateBudget(ctx, addr1, amount, 1, 0)
+	require.Nil(t, err)
+
+	// two periods accrued but the pool only holds 15
+	ctx = ctx.WithBlockHeight(2)
+	_, err = keeper.ClaimBudget(ctx, budget.BudgetID, addr1)
+	require.NotNil(t, err)
+	require.True(t, dk.communityPool.IsEqual(pool))
+
+	budget, found := keeper.GetBudget(ctx, budget.BudgetID)
+	require.True(t, found)
+	require.Equal(t, int64(0), budget.ClaimedPeriods)
+}
+
+func TestCreateBudgetEndHeightOverflow(t *testing.T) {
+	pool := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	ctx, keeper, _ := createTestInput(t, pool)
+	ctx = ctx.WithBlockHeight(10)
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	// the last representable end height is accepted
+	budget, err := keeper.CreateBudget(ctx, addr1, amount, (math.MaxInt64-10)/2, 2)
+	require.Nil(t, err)
+	require.True(t, budget.EndHeight > budget.StartHeight)
+
+	_, err = keeper.CreateBudget(ctx, addr1, amount, math.MaxInt64/2, 2)
+	require.NotNil(t, err)
+	require.Equal(t, uint64(2), keeper.GetNextBudgetID(ctx))
+
+	// the errors are returned in the codespace of the keeper
+	keeper = NewKeeper(keeper.cdc, keeper.storeKey, keeper.distrKeeper, "budget-test")
+	_, err = keeper.CreateBudget(ctx, addr1, amount, math.MaxInt64/2, 2)
+	require.Equal(t, sdk.CodespaceType("budget-test"), err.Codespace())
+	_, err = keeper.CreateBudget(ctx, nil, amount, 5, 2)
+	require.Equal(t, sdk.CodespaceType("budget-test"), err.Codespace())
+}
+
+func TestCancelBudgetProposal(t *testing.T) {
+	pool := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	ctx, keeper, dk := createTestInput(t, pool)
+	proposalHandler := NewBudgetProposalHandler(keeper)
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	budget, err := keeper.CreateBudget(ctx, addr1, amount, 2, 0)
+	require.Nil(t, err)
+
+	require.NotNil(t, proposalHandler(ctx, NewCancelBudgetProposal("title", "description", 2)))
+
+	// cancelled after two periods, which can still be claimed
+	ctx = ctx.WithBlockHeight(5)
+	require.Nil(t, proposalHandler(ctx, NewCancelBudgetProposal("title", "description", budget.BudgetID)))
+
+	ctx = ctx.WithBlockHeight(100)
+	claimed, err := keeper.ClaimBudget(ctx, budget.BudgetID, addr1)
+	require.Nil(t, err)
+	require.True(t, claimed.IsEqual(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20))))
+	require.True(t, dk.balances[addr1.String()].IsEqual(claimed))
+
+	_, found := keeper.GetBudget(ctx, budget.BudgetID)
+	require.False(t, found)
+
+	// a budget cancelled before accruing anything is deleted right away
+	budget, err = keeper.CreateBudget(ctx, addr1, amount, 2, 0)
+	require.Nil(t, err)
+	require.Nil(t, proposalHandler(ctx.WithBlockHeight(101), NewCancelBudgetProposal("title", "description", budget.BudgetID)))
+
+	_, found = keeper.GetBudget(ctx, budget.BudgetID)
+	require.False(t, found)
+}
+
+func TestExportImportGenesis(t *testing.T) {
+	pool := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	ctx, keeper, _ := createTestInput(t, pool)
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	_, err := keeper.CreateBudget(ctx.WithBlockHeight(1), addr1, amount, 2, 0)
+	require.Nil(t, err)
+	_, err = keeper.CreateBudget(ctx.WithBlockHeight(1), addr2, amount, 3, 4)
+	require.Nil(t, err)
+
+	genState := ExportGenesis(ctx, keeper)
+	require.NoError(t, ValidateGenesis(genState))
+	require.Equal(t, uint64(3), genState.NextBudgetID)
+	require.Len(t, genState.Budgets, 2)
+
+	ctx2, keeper2, _ := createTestInput(t, pool)
+	InitGenesis(ctx2, keeper2, genState)
+	require.Equal(t, genState, ExportGenesis(ctx2, keeper2))
+
+	genState.NextBudgetID = 2
+	require.Error(t, ValidateGenesis(genState))
+	require.NoError(t, ValidateGenesis(DefaultGenesisState()))
+}
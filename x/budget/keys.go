@@ -0,0 +1,35 @@
+package budget
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "budget"
+
+	// StoreKey is the store key string for budget
+	StoreKey = ModuleName
+
+	// RouterKey is the message and proposal route for budget
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for budget
+	QuerierRoute = ModuleName
+)
+
+// Keys for budget store
+// Items are stored with the following key: values
+//
+// - 0x00: nextBudgetID
+//
+// - 0x01<budgetID_Bytes>: Budget
+var (
+	NextBudgetIDKey = []byte{0x00} // key for the next budget ID
+	BudgetKey       = []byte{0x01} // prefix for each key to a budget
+)
+
+// GetBudgetKey returns the store key of a budget
+func GetBudgetKey(budgetID uint64) []byte {
+	return append(BudgetKey, sdk.Uint64ToBigEndian(budgetID)...)
+}
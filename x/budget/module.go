@@ -0,0 +1,125 @@
+package budget
+
+import (
+	"encoding/json"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.AppModule          = AppModule{}
+	_ sdk.AppModuleBasic     = AppModuleBasic{}
+	_ sdk.AppModuleDescriber = AppModule{}
+)
+
+// app module basics object
+type AppModuleBasic struct{}
+
+// module name
+func (AppModuleBasic) Name() string {
+	return ModuleName
+}
+
+// register module codec
+func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
+	RegisterCodec(cdc)
+}
+
+// default genesis state
+func (AppModuleBasic) DefaultGenesis() json.RawMessage {
+	return moduleCdc.MustMarshalJSON(DefaultGenesisState())
+}
+
+// module validate genesis
+func (AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
+	var data GenesisState
+	err := moduleCdc.UnmarshalJSON(bz, &data)
+	if err != nil {
+		return err
+	}
+	return ValidateGenesis(data)
+}
+
+// ___________________________
+// app module
+type AppModule struct {
+	AppModuleBasic
+	keeper Keeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(keeper Keeper) AppModule {
+	return AppModule{
+		AppModuleBasic: AppModuleBasic{},
+		keeper:         keeper,
+	}
+}
+
+// module name
+func (AppModule) Name() string {
+	return ModuleName
+}
+
+// register invariants
+func (AppModule) RegisterInvariants(_ sdk.InvariantRouter) {}
+
+// module message route name
+func (AppModule) Route() string {
+	return RouterKey
+}
+
+// module handler
+func (am AppModule) NewHandler() sdk.Handler {
+	return NewHandler(am.keeper)
+}
+
+// module querier route name
+func (AppModule) QuerierRoute() string {
+	return QuerierRoute
+}
+
+// module querier
+func (am AppModule) NewQuerierHandler() sdk.Querier {
+	return NewQuerier(am.keeper)
+}
+
+// module message types
+func (AppModule) MsgTypes() []string {
+	return []string{MsgClaimBudget{}.Type()}
+}
+
+// module query paths
+func (AppModule) QueryPaths() []string {
+	return []string{
+		QueryBudgets,
+		QueryBudget,
+		QueryClaimable,
+	}
+}
+
+// module init-genesis
+func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState GenesisState
+	moduleCdc.MustUnmarshalJSON(data, &genesisState)
+	InitGenesis(ctx, am.keeper, genesisState)
+	return []abci.ValidatorUpdate{}
+}
+
+// module export genesis
+func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	gs := ExportGenesis(ctx, am.keeper)
+	return moduleCdc.MustMarshalJSON(gs)
+}
+
+// module begin-block
+func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) sdk.Tags {
+	return sdk.EmptyTags()
+}
+
+// module end-block
+func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) ([]abci.ValidatorUpdate, sdk.Tags) {
+	return []abci.ValidatorUpdate{}, sdk.EmptyTags()
+}
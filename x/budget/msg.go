@@ -0,0 +1,43 @@
+package budget
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgClaimBudget - message struct to claim the payments accrued by a budget
+type MsgClaimBudget struct {
+	BudgetID  uint64         `json:"budget_id"`
+	Recipient sdk.AccAddress `json:"recipient"`
+}
+
+// ensure Msg interface compliance at compile time
+var _ sdk.Msg = MsgClaimBudget{}
+
+// NewMsgClaimBudget creates a new MsgClaimBudget object
+func NewMsgClaimBudget(budgetID uint64, recipient sdk.AccAddress) MsgClaimBudget {
+	return MsgClaimBudget{
+		BudgetID:  budgetID,
+		Recipient: recipient,
+	}
+}
+
+// nolint
+func (msg MsgClaimBudget) Route() string { return RouterKey }
+func (msg MsgClaimBudget) Type() string  { return "claim_budget" }
+
+// get the bytes for the message signer to sign on
+func (msg MsgClaimBudget) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Recipient} }
+
+// GetSignBytes gets the sign bytes for the msg MsgClaimBudget
+func (msg MsgClaimBudget) GetSignBytes() []byte {
+	bz := moduleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgClaimBudget) ValidateBasic() sdk.Error {
+	if msg.Recipient.Empty() {
+		return sdk.ErrInvalidAddress(msg.Recipient.String())
+	}
+	return nil
+}
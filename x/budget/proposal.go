@@ -0,0 +1,128 @@
+package budget
+
+import (
+	"fmt"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeBudget defines the type for a BudgetProposal
+	ProposalTypeBudget = "Budget"
+
+	// ProposalTypeCancelBudget defines the type for a CancelBudgetProposal
+	ProposalTypeCancelBudget = "CancelBudget"
+)
+
+// Assert the proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = BudgetProposal{}
+	_ govtypes.Content = CancelBudgetProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeBudget)
+	govtypes.RegisterProposalTypeCodec(BudgetProposal{}, "cosmos-sdk/BudgetProposal")
+	govtypes.RegisterProposalType(ProposalTypeCancelBudget)
+	govtypes.RegisterProposalTypeCodec(CancelBudgetProposal{}, "cosmos-sdk/CancelBudgetProposal")
+}
+
+// BudgetProposal defines a proposal to stream an amount from the community
+// pool to a recipient every period, starting when the proposal passes. If
+// periods is zero, the budget runs until it is cancelled.
+type BudgetProposal struct {
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Recipient   sdk.AccAddress `json:"recipient"`
+	Amount      sdk.Coins      `json:"amount"`
+	Period      int64          `json:"period"`
+	Periods     int64          `json:"periods"`
+}
+
+func NewBudgetProposal(title, description string, recipient sdk.AccAddress,
+	amount sdk.Coins, period, periods int64) BudgetProposal {
+
+	return BudgetProposal{title, description, recipient, amount, period, periods}
+}
+
+// GetTitle returns the title of a budget proposal.
+func (bp BudgetProposal) GetTitle() string { return bp.Title }
+
+// GetDescription returns the description of a budget proposal.
+func (bp BudgetProposal) GetDescription() string { return bp.Description }
+
+// ProposalRoute returns the routing key of a budget proposal.
+func (bp BudgetProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a budget proposal.
+func (bp BudgetProposal) ProposalType() string { return ProposalTypeBudget }
+
+// ValidateBasic validates the budget proposal
+func (bp BudgetProposal) ValidateBasic() sdk.Error {
+	err := govtypes.ValidateAbstract(DefaultCodespace, bp)
+	if err != nil {
+		return err
+	}
+
+	if bp.Periods < 0 {
+		return ErrInvalidBudget(DefaultCodespace, fmt.Sprintf("number of periods cannot be negative, is %d", bp.Periods))
+	}
+	if bp.Periods > 0 && bp.Period > math.MaxInt64/bp.Periods {
+		return ErrInvalidBudget(DefaultCodespace, fmt.Sprintf("%d periods of %d blocks overflow the end height", bp.Periods, bp.Period))
+	}
+
+	// the start height is only known once the proposal passes
+	return NewBudget(0, bp.Recipient, bp.Amount, bp.Period, 0, 0).Validate(DefaultCodespace)
+}
+
+// String implements the Stringer interface.
+func (bp BudgetProposal) String() string {
+	return fmt.Sprintf(`Budget Proposal:
+  Title:       %s
+  Description: %s
+  Recipient:   %s
+  Amount:      %s
+  Period:      %d
+  Periods:     %d
+`, bp.Title, bp.Description, bp.Recipient, bp.Amount, bp.Period, bp.Periods)
+}
+
+// CancelBudgetProposal defines a proposal to stop a budget. The payments
+// accrued before the proposal passes can still be claimed.
+type CancelBudgetProposal struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	BudgetID    uint64 `json:"budget_id"`
+}
+
+func NewCancelBudgetProposal(title, description string, budgetID uint64) CancelBudgetProposal {
+	return CancelBudgetProposal{title, description, budgetID}
+}
+
+// GetTitle returns the title of a cancel budget proposal.
+func (cbp CancelBudgetProposal) GetTitle() string { return cbp.Title }
+
+// GetDescription returns the description of a cancel budget proposal.
+func (cbp CancelBudgetProposal) GetDescription() string { return cbp.Description }
+
+// ProposalRoute returns the routing key of a cancel budget proposal.
+func (cbp CancelBudgetProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a cancel budget proposal.
+func (cbp CancelBudgetProposal) ProposalType() string { return ProposalTypeCancelBudget }
+
+// ValidateBasic validates the cancel budget proposal
+func (cbp CancelBudgetProposal) ValidateBasic() sdk.Error {
+	return govtypes.ValidateAbstract(DefaultCodespace, cbp)
+}
+
+// String implements the Stringer interface.
+func (cbp CancelBudgetProposal) String() string {
+	return fmt.Sprintf(`Cancel Budget Proposal:
+  Title:       %s
+  Description: %s
+  Budget ID:   %d
+`, cbp.Title, cbp.Description, cbp.BudgetID)
+}
@@ -0,0 +1,99 @@
+package budget
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// query endpoints supported by the budget Querier
+const (
+	QueryBudgets   = "budgets"
+	QueryBudget    = "budget"
+	QueryClaimable = "claimable"
+)
+
+// NewQuerier creates a querier for budget REST endpoints
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QueryBudgets:
+			return queryBudgets(ctx, k)
+		case QueryBudget:
+			return queryBudget(ctx, req, k)
+		case QueryClaimable:
+			return queryClaimable(ctx, req, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown budget query endpoint")
+		}
+	}
+}
+
+// QueryBudgetParams defines the params for the following queries:
+// - 'custom/budget/budget'
+// - 'custom/budget/claimable'
+type QueryBudgetParams struct {
+	BudgetID uint64
+}
+
+// NewQueryBudgetParams creates a new instance of QueryBudgetParams
+func NewQueryBudgetParams(budgetID uint64) QueryBudgetParams {
+	return QueryBudgetParams{
+		BudgetID: budgetID,
+	}
+}
+
+func queryBudgets(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	budgets := k.GetBudgets(ctx)
+	if budgets == nil {
+		budgets = Budgets{}
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, budgets)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryBudget(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryBudgetParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	budget, found := k.GetBudget(ctx, params.BudgetID)
+	if !found {
+		return nil, ErrUnknownBudget(k.codespace, params.BudgetID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, budget)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryClaimable(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryBudgetParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	budget, found := k.GetBudget(ctx, params.BudgetID)
+	if !found {
+		return nil, ErrUnknownBudget(k.codespace, params.BudgetID)
+	}
+
+	height := ctx.BlockHeight()
+	periods, amount := budget.Claimable(height)
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, NewClaimableBudget(budget.BudgetID, height, periods, amount))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -0,0 +1,13 @@
+package tags
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Budget module tags
+var (
+	Category   = sdk.TagCategory
+	Sender     = sdk.TagSender
+	BudgetID   = "budget-id"
+	TxCategory = "budget"
+)
@@ -0,0 +1,59 @@
+// nolint
+package budget
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	addr1 = sdk.AccAddress([]byte("addr1_______________"))
+	addr2 = sdk.AccAddress([]byte("addr2_______________"))
+)
+
+// mockDistrKeeper pays budgets out of an in-memory community pool
+type mockDistrKeeper struct {
+	communityPool sdk.Coins
+	balances      map[string]sdk.Coins
+}
+
+func (dk *mockDistrKeeper) DistributeFeePool(_ sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) sdk.Error {
+	pool, hasNeg := dk.communityPool.SafeSub(amount)
+	if hasNeg {
+		return sdk.ErrInsufficientCoins("insufficient community pool funds")
+	}
+
+	dk.communityPool = pool
+	dk.balances[receiveAddr.String()] = dk.balances[receiveAddr.String()].Add(amount)
+	return nil
+}
+
+func createTestInput(t *testing.T, communityPool sdk.Coins) (sdk.Context, Keeper, *mockDistrKeeper) {
+	keyBudget := sdk.NewKVStoreKey(StoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyBudget, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "budget-chain"}, false, log.NewNopLogger())
+
+	cdc := codec.New()
+	RegisterCodec(cdc)
+
+	dk := &mockDistrKeeper{
+		communityPool: communityPool,
+		balances:      make(map[string]sdk.Coins),
+	}
+
+	return ctx, NewKeeper(cdc, keyBudget, dk, DefaultCodespace), dk
+}
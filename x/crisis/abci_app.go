@@ -7,10 +7,10 @@ import (
 )
 
 // check all registered invariants
-func EndBlocker(ctx sdk.Context, k Keeper, logger log.Logger) {
+func EndBlocker(ctx sdk.Context, k Keeper, logger log.Logger) sdk.Tags {
 	if k.invCheckPeriod == 0 || ctx.BlockHeight()%int64(k.invCheckPeriod) != 0 {
 		// skip running the invariant check
-		return
+		return sdk.EmptyTags()
 	}
-	k.AssertInvariants(ctx, logger)
+	return k.AssertInvariants(ctx, logger)
 }
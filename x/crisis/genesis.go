@@ -1,40 +1,60 @@
 package crisis
 
 import (
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // GenesisState - crisis genesis state
 type GenesisState struct {
-	ConstantFee sdk.Coin `json:"constant_fee"`
+	ConstantFee         sdk.Coin `json:"constant_fee"`
+	AlertOnlyInvariants []string `json:"alert_only_invariants"`
 }
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(constantFee sdk.Coin) GenesisState {
+func NewGenesisState(constantFee sdk.Coin, alertOnlyInvariants []string) GenesisState {
 	return GenesisState{
-		ConstantFee: constantFee,
+		ConstantFee:         constantFee,
+		AlertOnlyInvariants: alertOnlyInvariants,
 	}
 }
 
 // DefaultGenesisState creates a default GenesisState object
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		ConstantFee: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)),
+		ConstantFee:         sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)),
+		AlertOnlyInvariants: []string{},
 	}
 }
 
 // new crisis genesis
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	keeper.SetConstantFee(ctx, data.ConstantFee)
+	keeper.SetAlertOnlyInvariants(ctx, data.AlertOnlyInvariants)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 	constantFee := keeper.GetConstantFee(ctx)
-	return NewGenesisState(constantFee)
+	alertOnlyInvariants := keeper.GetAlertOnlyInvariants(ctx)
+	return NewGenesisState(constantFee, alertOnlyInvariants)
 }
 
-// ValidateGenesis - placeholder function
+// ValidateGenesis validates the alert-only invariant routes, which must be
+// unique full routes of the form module/route
 func ValidateGenesis(data GenesisState) error {
+	seen := make(map[string]bool)
+	for _, route := range data.AlertOnlyInvariants {
+		parts := strings.Split(route, "/")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return fmt.Errorf("invalid alert-only invariant route %q, expected module/route", route)
+		}
+		if seen[route] {
+			return fmt.Errorf("duplicate alert-only invariant route %s", route)
+		}
+		seen[route] = true
+	}
 	return nil
 }
@@ -36,19 +36,29 @@ func handleMsgVerifyInvariant(ctx sdk.Context, msg MsgVerifyInvariant, k Keeper)
 	// use a cached context to avoid gas costs during invariants
 	cacheCtx, _ := ctx.CacheContext()
 
-	found := false
-	var invarianceErr error
 	msgFullRoute := msg.FullInvariantRoute()
-	for _, invarRoute := range k.routes {
-		if invarRoute.FullRoute() == msgFullRoute {
-			invarianceErr = invarRoute.Invar(cacheCtx)
-			found = true
-			break
-		}
-	}
+	invarRoute, found := k.GetRoute(msgFullRoute)
 	if !found {
 		return ErrUnknownInvariant(DefaultCodespace).Result()
 	}
+	invarianceErr := invarRoute.Invar(cacheCtx)
+
+	resTags := sdk.NewTags(
+		tags.Sender, msg.Sender.String(),
+		tags.Invariant, msg.InvariantRoute,
+	)
+
+	if invarianceErr != nil && k.IsAlertOnly(ctx, msgFullRoute) {
+		logger := ctx.Logger().With("module", "x/crisis")
+		logger.Error("CRITICAL alert-only invariant broken", "invariant", msgFullRoute,
+			"sender", msg.Sender, "err", invarianceErr)
+
+		// the chain doesn't halt, the constant fee is kept so that reporting
+		// the broken invariant again can't be spammed
+		return sdk.Result{
+			Tags: resTags.AppendTag(tags.BrokenInvariant, msgFullRoute),
+		}
+	}
 
 	if invarianceErr != nil {
 
@@ -71,10 +81,6 @@ func handleMsgVerifyInvariant(ctx sdk.Context, msg MsgVerifyInvariant, k Keeper)
 		panic(invarianceErr)
 	}
 
-	resTags := sdk.NewTags(
-		tags.Sender, msg.Sender.String(),
-		tags.Invariant, msg.InvariantRoute,
-	)
 	return sdk.Result{
 		Tags: resTags,
 	}
@@ -6,10 +6,12 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/go-kit/kit/metrics/generic"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/crisis/tags"
 	distr "github.com/cosmos/cosmos-sdk/x/distribution"
 )
 
@@ -92,13 +94,39 @@ func TestHandleMsgVerifyInvariantWithInvariantBrokenAndNotEnoughPoolCoins(t *tes
 	}, fmt.Sprintf("%v", res))
 }
 
+func TestHandleMsgVerifyInvariantWithAlertOnlyInvariantBroken(t *testing.T) {
+	ctx, crisisKeeper, accKeeper, distrKeeper := CreateTestInput(t)
+	sender := addrs[0]
+	crisisKeeper.SetAlertOnlyInvariants(ctx, []string{dummyRouteWhichFails.FullRoute()})
+	coins := accKeeper.GetAccount(ctx, sender).GetCoins()
+	communityPool := distrKeeper.GetFeePool(ctx).CommunityPool
+
+	// the chain doesn't halt, the constant fee is charged and the community
+	// pool is not used to refund it
+	msg := NewMsgVerifyInvariant(sender, testModuleName, dummyRouteWhichFails.Route)
+	res := handleMsgVerifyInvariant(ctx, msg, crisisKeeper)
+	require.True(t, res.IsOK(), res.Log)
+	require.Contains(t, res.Tags, sdk.MakeTag(tags.BrokenInvariant, dummyRouteWhichFails.FullRoute()))
+	constantFee := sdk.NewCoins(crisisKeeper.GetConstantFee(ctx))
+	require.Equal(t, coins.Sub(constantFee), accKeeper.GetAccount(ctx, sender).GetCoins())
+	require.Equal(t, communityPool, distrKeeper.GetFeePool(ctx).CommunityPool)
+
+	resTags := crisisKeeper.AssertInvariants(ctx, ctx.Logger())
+	require.Equal(t, sdk.NewTags(tags.BrokenInvariant, dummyRouteWhichFails.FullRoute()), resTags)
+}
+
 func TestHandleMsgVerifyInvariantWithInvariantNotBroken(t *testing.T) {
-	ctx, crisisKeeper, _, _ := CreateTestInput(t)
+	ctx, crisisKeeper, accKeeper, _ := CreateTestInput(t)
 	sender := addrs[0]
+	coins := accKeeper.GetAccount(ctx, sender).GetCoins()
 
 	msg := NewMsgVerifyInvariant(sender, testModuleName, dummyRouteWhichPasses.Route)
 	res := handleMsgVerifyInvariant(ctx, msg, crisisKeeper)
 	require.True(t, res.IsOK())
+
+	// the constant fee is charged
+	constantFee := sdk.NewCoins(crisisKeeper.GetConstantFee(ctx))
+	require.Equal(t, coins.Sub(constantFee), accKeeper.GetAccount(ctx, sender).GetCoins())
 }
 
 func TestInvalidMsg(t *testing.T) {
@@ -109,3 +137,26 @@ func TestInvalidMsg(t *testing.T) {
 	require.False(t, res.IsOK())
 	require.True(t, strings.Contains(res.Log, "unrecognized crisis message type"))
 }
+
+func TestRegisterRouteDuplicate(t *testing.T) {
+	_, crisisKeeper, _, _ := CreateTestInput(t)
+
+	_, found := crisisKeeper.GetRoute(dummyRouteWhichPasses.FullRoute())
+	require.True(t, found)
+	require.Panics(t, func() {
+		crisisKeeper.RegisterRoute(testModuleName, dummyRouteWhichPasses.Route, dummyRouteWhichPasses.Invar)
+	})
+}
+
+func TestAssertInvariantsMetrics(t *testing.T) {
+	ctx, crisisKeeper, _, _ := CreateTestInput(t)
+	crisisKeeper.SetAlertOnlyInvariants(ctx, []string{dummyRouteWhichFails.FullRoute()})
+	metrics := &Metrics{
+		InvariantDuration: generic.NewHistogram("invariant_duration_seconds", 50),
+		BrokenInvariants:  generic.NewCounter("broken_invariants"),
+	}
+	crisisKeeper = crisisKeeper.WithMetrics(metrics)
+
+	crisisKeeper.AssertInvariants(ctx, ctx.Logger())
+	require.Equal(t, float64(1), metrics.BrokenInvariants.(*generic.Counter).Value())
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/tags"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
@@ -15,6 +16,7 @@ type Keeper struct {
 	routes         []InvarRoute
 	paramSpace     params.Subspace
 	invCheckPeriod uint
+	metrics        *Metrics
 
 	distrKeeper         DistrKeeper
 	bankKeeper          BankKeeper
@@ -30,18 +32,41 @@ func NewKeeper(paramSpace params.Subspace, invCheckPeriod uint,
 		routes:              []InvarRoute{},
 		paramSpace:          paramSpace.WithKeyTable(ParamKeyTable()),
 		invCheckPeriod:      invCheckPeriod,
+		metrics:             NopMetrics(),
 		distrKeeper:         distrKeeper,
 		bankKeeper:          bankKeeper,
 		feeCollectionKeeper: feeCollectionKeeper,
 	}
 }
 
-// register routes for the
+// WithMetrics returns a copy of the keeper reporting to the given metrics, eg.
+// PrometheusMetrics, instead of discarding them
+func (k Keeper) WithMetrics(metrics *Metrics) Keeper {
+	k.metrics = metrics
+	return k
+}
+
+// RegisterRoute registers an invariant of a module under the given route,
+// panicking if an invariant is already registered under the same full route
 func (k *Keeper) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
 	invarRoute := NewInvarRoute(moduleName, route, invar)
+	if _, found := k.GetRoute(invarRoute.FullRoute()); found {
+		panic(fmt.Sprintf("invariant %s already registered", invarRoute.FullRoute()))
+	}
 	k.routes = append(k.routes, invarRoute)
 }
 
+// GetRoute returns the invariant route registered under the given full
+// route, ie. "<module>/<route>"
+func (k Keeper) GetRoute(fullRoute string) (InvarRoute, bool) {
+	for _, ir := range k.routes {
+		if ir.FullRoute() == fullRoute {
+			return ir, true
+		}
+	}
+	return InvarRoute{}, false
+}
+
 // Routes - return the keeper's invariant routes
 func (k Keeper) Routes() []InvarRoute {
 	return k.routes
@@ -56,13 +81,31 @@ func (k Keeper) Invariants() []sdk.Invariant {
 	return invars
 }
 
-// assert all invariants
-func (k Keeper) AssertInvariants(ctx sdk.Context, logger log.Logger) {
+// assert all invariants, halting the chain on the first broken invariant
+// unless it is alert-only, in which case the breakage is logged and the full
+// route of the invariant is returned as a tag. The time spent on each
+// invariant is reported to the keeper metrics.
+func (k Keeper) AssertInvariants(ctx sdk.Context, logger log.Logger) sdk.Tags {
+	logger = logger.With("module", "x/crisis")
+	resTags := sdk.EmptyTags()
 
 	start := time.Now()
 	invarRoutes := k.Routes()
 	for _, ir := range invarRoutes {
-		if err := ir.Invar(ctx); err != nil {
+		invarStart := time.Now()
+		err := ir.Invar(ctx)
+		invarDuration := time.Since(invarStart)
+		k.metrics.InvariantDuration.With("invariant", ir.FullRoute()).Observe(invarDuration.Seconds())
+		logger.Debug("asserted invariant", "invariant", ir.FullRoute(), "duration", invarDuration)
+
+		if err != nil {
+			k.metrics.BrokenInvariants.With("invariant", ir.FullRoute()).Add(1)
+			if k.IsAlertOnly(ctx, ir.FullRoute()) {
+				logger.Error("CRITICAL alert-only invariant broken", "invariant", ir.FullRoute(),
+					"height", ctx.BlockHeight(), "err", err)
+				resTags = resTags.AppendTag(tags.BrokenInvariant, ir.FullRoute())
+				continue
+			}
 
 			// TODO: Include app name as part of context to allow for this to be
 			// variable.
@@ -75,7 +118,8 @@ func (k Keeper) AssertInvariants(ctx sdk.Context, logger log.Logger) {
 	end := time.Now()
 	diff := end.Sub(start)
 
-	logger.With("module", "x/crisis").Info("asserted all invariants", "duration", diff, "height", ctx.BlockHeight())
+	logger.Info("asserted all invariants", "duration", diff, "height", ctx.BlockHeight())
+	return resTags
 }
 
 // DONTCOVER
@@ -0,0 +1,53 @@
+package crisis
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	prometheus "github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+// module.
+const MetricsSubsystem = "crisis"
+
+// Metrics contains the metrics exposed by the crisis module. The invariants
+// are labelled by their full route.
+type Metrics struct {
+	// Time spent asserting an invariant, in seconds.
+	InvariantDuration metrics.Histogram
+	// Number of times an invariant was found broken.
+	BrokenInvariants metrics.Counter
+}
+
+// PrometheusMetrics returns Metrics built using the Prometheus client
+// library. Optionally, labels can be provided along with their values
+// ("foo", "fooValue").
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	withLabels := sdk.MetricLabels(labelsAndValues...)
+
+	return &Metrics{
+		InvariantDuration: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "invariant_duration_seconds",
+			Help:      "Time spent asserting an invariant.",
+		}, withLabels("invariant")).With(labelsAndValues...),
+		BrokenInvariants: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "broken_invariants",
+			Help:      "Number of times an invariant was found broken.",
+		}, withLabels("invariant")).With(labelsAndValues...),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		InvariantDuration: discard.NewHistogram(),
+		BrokenInvariants:  discard.NewCounter(),
+	}
+}
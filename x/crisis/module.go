@@ -11,8 +11,9 @@ import (
 )
 
 var (
-	_ sdk.AppModule      = AppModule{}
-	_ sdk.AppModuleBasic = AppModuleBasic{}
+	_ sdk.AppModule          = AppModule{}
+	_ sdk.AppModuleBasic     = AppModuleBasic{}
+	_ sdk.AppModuleDescriber = AppModule{}
 )
 
 // name of this module
@@ -89,6 +90,16 @@ func (AppModule) QuerierRoute() string { return "" }
 // module querier
 func (AppModule) NewQuerierHandler() sdk.Querier { return nil }
 
+// module message types
+func (AppModule) MsgTypes() []string {
+	return []string{MsgVerifyInvariant{}.Type()}
+}
+
+// module query paths
+func (AppModule) QueryPaths() []string {
+	return []string{}
+}
+
 // module init-genesis
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
 	var genesisState GenesisState
@@ -112,6 +123,6 @@ func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) sdk.Tags {
 
 // module end-block
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) ([]abci.ValidatorUpdate, sdk.Tags) {
-	EndBlocker(ctx, am.keeper, am.logger)
-	return []abci.ValidatorUpdate{}, sdk.EmptyTags()
+	resTags := EndBlocker(ctx, am.keeper, am.logger)
+	return []abci.ValidatorUpdate{}, resTags
 }
@@ -13,12 +13,16 @@ const (
 var (
 	// key for constant fee parameter
 	ParamStoreKeyConstantFee = []byte("ConstantFee")
+
+	// key for the invariants which only alert instead of halting the chain
+	ParamStoreKeyAlertOnlyInvariants = []byte("AlertOnlyInvariants")
 )
 
 // type declaration for parameters
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable(
 		ParamStoreKeyConstantFee, sdk.Coin{},
+		ParamStoreKeyAlertOnlyInvariants, []string{},
 	)
 }
 
@@ -32,3 +36,27 @@ func (k Keeper) GetConstantFee(ctx sdk.Context) (constantFee sdk.Coin) {
 func (k Keeper) SetConstantFee(ctx sdk.Context, constantFee sdk.Coin) {
 	k.paramSpace.Set(ctx, ParamStoreKeyConstantFee, constantFee)
 }
+
+// GetAlertOnlyInvariants gets the full routes of the invariants which only
+// alert when broken from the paramSpace
+func (k Keeper) GetAlertOnlyInvariants(ctx sdk.Context) (routes []string) {
+	k.paramSpace.GetIfExists(ctx, ParamStoreKeyAlertOnlyInvariants, &routes)
+	return
+}
+
+// SetAlertOnlyInvariants sets the full routes of the invariants which only
+// alert when broken in the paramSpace
+func (k Keeper) SetAlertOnlyInvariants(ctx sdk.Context, routes []string) {
+	k.paramSpace.Set(ctx, ParamStoreKeyAlertOnlyInvariants, routes)
+}
+
+// IsAlertOnly returns true if the invariant with the given full route only
+// alerts when broken instead of halting the chain
+func (k Keeper) IsAlertOnly(ctx sdk.Context, fullRoute string) bool {
+	for _, route := range k.GetAlertOnlyInvariants(ctx) {
+		if route == fullRoute {
+			return true
+		}
+	}
+	return false
+}
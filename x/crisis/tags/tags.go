@@ -8,4 +8,7 @@ import (
 var (
 	Sender    = sdk.TagSender
 	Invariant = "invariant"
+
+	// BrokenInvariant is set to the full route of a broken alert-only invariant
+	BrokenInvariant = "broken_invariant"
 )
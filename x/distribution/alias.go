@@ -27,6 +27,7 @@ const (
 	ParamBaseProposerReward          = keeper.ParamBaseProposerReward
 	ParamBonusProposerReward         = keeper.ParamBonusProposerReward
 	ParamWithdrawAddrEnabled         = keeper.ParamWithdrawAddrEnabled
+	ParamFundingSplits               = keeper.ParamFundingSplits
 	DefaultCodespace                 = types.DefaultCodespace
 	CodeInvalidInput                 = types.CodeInvalidInput
 	CodeNoDistributionInfo           = types.CodeNoDistributionInfo
@@ -85,6 +86,7 @@ var (
 	ErrSetWithdrawAddrDisabled                 = types.ErrSetWithdrawAddrDisabled
 	ErrBadDistribution                         = types.ErrBadDistribution
 	InitialFeePool                             = types.InitialFeePool
+	NewFundingSplit                            = types.NewFundingSplit
 	NewGenesisState                            = types.NewGenesisState
 	DefaultGenesisState                        = types.DefaultGenesisState
 	ValidateGenesis                            = types.ValidateGenesis
@@ -112,6 +114,7 @@ var (
 	ParamStoreKeyBaseProposerReward      = keeper.ParamStoreKeyBaseProposerReward
 	ParamStoreKeyBonusProposerReward     = keeper.ParamStoreKeyBonusProposerReward
 	ParamStoreKeyWithdrawAddrEnabled     = keeper.ParamStoreKeyWithdrawAddrEnabled
+	ParamStoreKeyFundingSplits           = keeper.ParamStoreKeyFundingSplits
 	TestAddrs                            = keeper.TestAddrs
 	Rewards                              = tags.Rewards
 	Commission                           = tags.Commission
@@ -138,6 +141,8 @@ type (
 	BankKeeper                             = types.BankKeeper
 	FeeCollectionKeeper                    = types.FeeCollectionKeeper
 	FeePool                                = types.FeePool
+	FundingSplit                           = types.FundingSplit
+	FundingSplits                          = types.FundingSplits
 	DelegatorWithdrawInfo                  = types.DelegatorWithdrawInfo
 	ValidatorOutstandingRewardsRecord      = types.ValidatorOutstandingRewardsRecord
 	ValidatorAccumulatedCommissionRecord   = types.ValidatorAccumulatedCommissionRecord
@@ -36,8 +36,14 @@ func QueryParams(cliCtx context.CLIContext, queryRoute string) (PrettyParams, er
 		return PrettyParams{}, err
 	}
 
+	route = fmt.Sprintf("custom/%s/params/%s", queryRoute, distr.ParamFundingSplits)
+	retFundingSplits, err := cliCtx.QueryWithData(route, []byte{})
+	if err != nil {
+		return PrettyParams{}, err
+	}
+
 	return NewPrettyParams(retCommunityTax, retBaseProposerReward,
-		retBonusProposerReward, retWithdrawAddrEnabled), nil
+		retBonusProposerReward, retWithdrawAddrEnabled, retFundingSplits), nil
 }
 
 // QueryDelegatorTotalRewards queries delegator total rewards.
@@ -59,9 +59,22 @@ func (k Keeper) AllocateTokens(ctx sdk.Context, sumPreviousPrecommitPower, total
 			previousProposer.String()))
 	}
 
+	// pay the funding splits, any rounding dust is left to the community pool
+	fundingSplits := k.GetFundingSplits(ctx)
+	for _, split := range fundingSplits {
+		share, _ := feesCollected.MulDecTruncate(split.Weight).TruncateDecimal()
+		if share.IsZero() {
+			continue
+		}
+		if _, err := k.bankKeeper.AddCoins(ctx, split.Address, share); err != nil {
+			panic(err)
+		}
+		remaining = remaining.Sub(sdk.NewDecCoins(share))
+	}
+
 	// calculate fraction allocated to validators
 	communityTax := k.GetCommunityTax(ctx)
-	voteMultiplier := sdk.OneDec().Sub(proposerMultiplier).Sub(communityTax)
+	voteMultiplier := sdk.OneDec().Sub(proposerMultiplier).Sub(communityTax).Sub(fundingSplits.TotalWeight())
 
 	// allocate tokens proportionally to voting power
 	// TODO consider parallelizing later, ref https://github.com/cosmos/cosmos-sdk/pull/3099#discussion_r246276376
@@ -5,8 +5,10 @@ import (
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
@@ -174,3 +176,40 @@ func TestAllocateTokensTruncation(t *testing.T) {
 	require.True(t, k.GetValidatorOutstandingRewards(ctx, valOpAddr2).IsValid())
 	require.True(t, k.GetValidatorOutstandingRewards(ctx, valOpAddr3).IsValid())
 }
+
+func TestAllocateTokensFundingSplits(t *testing.T) {
+	ctx, ak, k, sk, fck := CreateTestInputDefault(t, false, 1000)
+	sh := staking.NewHandler(sk)
+
+	// create validator with 0% commission
+	commission := staking.NewCommissionMsg(sdk.NewDec(0), sdk.NewDec(0), sdk.NewDec(0))
+	msg := staking.NewMsgCreateValidator(valOpAddr1, valConsPk1,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), staking.Description{}, commission, sdk.OneInt())
+	require.True(t, sh(ctx, msg).IsOK())
+
+	// send 10.5% of every block to a fund
+	fundAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	k.SetFundingSplits(ctx, types.FundingSplits{types.NewFundingSplit(fundAddr, sdk.NewDecWithPrec(105, 3))})
+
+	fees := sdk.Coins{
+		{sdk.DefaultBondDenom, sdk.NewInt(101)},
+	}
+	fck.SetCollectedFees(fees)
+	votes := []abci.VoteInfo{
+		{
+			Validator: abci.Validator{
+				Address: valConsPk1.Address(),
+				Power:   100,
+			},
+			SignedLastBlock: true,
+		},
+	}
+	k.AllocateTokens(ctx, 100, 100, valConsAddr1, votes)
+
+	// 10.605 truncated to 10 for the fund
+	require.Equal(t, sdk.Coins{{sdk.DefaultBondDenom, sdk.NewInt(10)}}, ak.GetAccount(ctx, fundAddr).GetCoins())
+	// proposer reward + staking.proportional = (5% + 82.5%) * 101 = 88.375
+	require.Equal(t, sdk.DecCoins{{sdk.DefaultBondDenom, sdk.NewDecWithPrec(88375, 3)}}, k.GetValidatorOutstandingRewards(ctx, valOpAddr1))
+	// community tax of 2.02 plus the 0.605 dust of the fund
+	require.Equal(t, sdk.DecCoins{{sdk.DefaultBondDenom, sdk.NewDecWithPrec(2625, 3)}}, k.GetFeePool(ctx).CommunityPool)
+}
@@ -14,7 +14,7 @@ func (k Keeper) DistributeFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr
 		return types.ErrBadDistribution(k.codespace)
 	}
 
-	feePool.CommunityPool.Sub(sdk.NewDecCoins(amount))
+	feePool.CommunityPool = feePool.CommunityPool.Sub(sdk.NewDecCoins(amount))
 	_, err := k.bankKeeper.AddCoins(ctx, receiveAddr, amount)
 	if err != nil {
 		return err
@@ -1,11 +1,13 @@
 package keeper
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
 func TestSetWithdrawAddr(t *testing.T) {
@@ -62,3 +64,49 @@ func TestWithdrawValidatorCommission(t *testing.T) {
 
 	require.True(t, true)
 }
+
+func TestDistributeFeePool(t *testing.T) {
+	ctx, ak, keeper, _, _ := CreateTestInputDefault(t, false, 1000)
+
+	feePool := keeper.GetFeePool(ctx)
+	feePool.CommunityPool = sdk.DecCoins{sdk.NewInt64DecCoin("stake", 10)}
+	keeper.SetFeePool(ctx, feePool)
+
+	balance := ak.GetAccount(ctx, delAddr1).GetCoins()
+
+	// cannot distribute more than the community pool
+	err := keeper.DistributeFeePool(ctx, sdk.NewCoins(sdk.NewInt64Coin("stake", 11)), delAddr1)
+	require.NotNil(t, err)
+
+	err = keeper.DistributeFeePool(ctx, sdk.NewCoins(sdk.NewInt64Coin("stake", 4)), delAddr1)
+	require.Nil(t, err)
+
+	require.Equal(t, sdk.DecCoins{sdk.NewInt64DecCoin("stake", 6)}, keeper.GetFeePoolCommunityCoins(ctx))
+	require.Equal(t, balance.Add(sdk.NewCoins(sdk.NewInt64Coin("stake", 4))), ak.GetAccount(ctx, delAddr1).GetCoins())
+}
+
+func TestSetRawFundingSplits(t *testing.T) {
+	ctx, _, keeper, _, _ := CreateTestInputDefault(t, false, 1000)
+
+	tests := []struct {
+		splits    types.FundingSplits
+		expectErr bool
+	}{
+		{types.FundingSplits{types.NewFundingSplit(delAddr1, sdk.NewDecWithPrec(5, 1))}, false},
+		{types.FundingSplits{types.NewFundingSplit(delAddr1, sdk.NewDecWithPrec(-1, 1))}, true},
+		{types.FundingSplits{types.NewFundingSplit(delAddr1, sdk.NewDecWithPrec(6, 1)), types.NewFundingSplit(delAddr2, sdk.NewDecWithPrec(5, 1))}, true},
+	}
+
+	for i, tc := range tests {
+		bz := keeper.cdc.MustMarshalJSON(tc.splits)
+		err := keeper.paramSpace.SetRaw(ctx, ParamStoreKeyFundingSplits, bz)
+		require.Equal(t, tc.expectErr, err != nil, "unexpected result for test case #%d", i)
+	}
+
+	// a split without a weight
+	bz := []byte(fmt.Sprintf(`[{"address":"%s"}]`, delAddr1))
+	require.NotNil(t, keeper.paramSpace.SetRaw(ctx, ParamStoreKeyFundingSplits, bz))
+
+	// the rejected changes are not stored
+	require.Equal(t, tests[0].splits, keeper.GetFundingSplits(ctx))
+}
@@ -29,6 +29,7 @@ var (
 	ParamStoreKeyBaseProposerReward  = []byte("baseproposerreward")
 	ParamStoreKeyBonusProposerReward = []byte("bonusproposerreward")
 	ParamStoreKeyWithdrawAddrEnabled = []byte("withdrawaddrenabled")
+	ParamStoreKeyFundingSplits       = []byte("fundingsplits")
 )
 
 // gets an address from a validator's outstanding rewards key
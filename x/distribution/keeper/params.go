@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
@@ -12,7 +15,17 @@ func ParamKeyTable() params.KeyTable {
 		ParamStoreKeyBaseProposerReward, sdk.Dec{},
 		ParamStoreKeyBonusProposerReward, sdk.Dec{},
 		ParamStoreKeyWithdrawAddrEnabled, false,
-	)
+		ParamStoreKeyFundingSplits, types.FundingSplits{},
+	).RegisterValidator(ParamStoreKeyFundingSplits, validateFundingSplits)
+}
+
+// validates the funding splits set by parameter change proposals
+func validateFundingSplits(value interface{}) error {
+	splits, ok := value.(types.FundingSplits)
+	if !ok {
+		return fmt.Errorf("invalid funding splits type %T", value)
+	}
+	return splits.Validate()
 }
 
 // returns the current CommunityTax rate from the global param store
@@ -66,3 +79,16 @@ func (k Keeper) GetWithdrawAddrEnabled(ctx sdk.Context) bool {
 func (k Keeper) SetWithdrawAddrEnabled(ctx sdk.Context, enabled bool) {
 	k.paramSpace.Set(ctx, ParamStoreKeyWithdrawAddrEnabled, &enabled)
 }
+
+// returns the current FundingSplits
+// nolint: errcheck
+func (k Keeper) GetFundingSplits(ctx sdk.Context) types.FundingSplits {
+	var splits types.FundingSplits
+	k.paramSpace.Get(ctx, ParamStoreKeyFundingSplits, &splits)
+	return splits
+}
+
+// nolint: errcheck
+func (k Keeper) SetFundingSplits(ctx sdk.Context, splits types.FundingSplits) {
+	k.paramSpace.Set(ctx, ParamStoreKeyFundingSplits, &splits)
+}
@@ -27,6 +27,7 @@ const (
 	ParamBaseProposerReward  = "base_proposer_reward"
 	ParamBonusProposerReward = "bonus_proposer_reward"
 	ParamWithdrawAddrEnabled = "withdraw_addr_enabled"
+	ParamFundingSplits       = "funding_splits"
 )
 
 func NewQuerier(k Keeper) sdk.Querier {
@@ -91,6 +92,12 @@ func queryParams(ctx sdk.Context, path []string, req abci.RequestQuery, k Keeper
 			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
 		}
 		return bz, nil
+	case ParamFundingSplits:
+		bz, err := codec.MarshalJSONIndent(k.cdc, k.GetFundingSplits(ctx))
+		if err != nil {
+			return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+		}
+		return bz, nil
 	default:
 		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("%s is not a valid query request path", req.Path))
 	}
@@ -142,6 +142,7 @@ func CreateTestInputAdvanced(t *testing.T, isCheckTx bool, initPower int64,
 	keeper.SetCommunityTax(ctx, communityTax)
 	keeper.SetBaseProposerReward(ctx, sdk.NewDecWithPrec(1, 2))
 	keeper.SetBonusProposerReward(ctx, sdk.NewDecWithPrec(4, 2))
+	keeper.SetFundingSplits(ctx, types.FundingSplits{})
 
 	return ctx, accountKeeper, bankKeeper, keeper, sk, fck, pk
 }
@@ -9,8 +9,9 @@ import (
 )
 
 var (
-	_ sdk.AppModule      = AppModule{}
-	_ sdk.AppModuleBasic = AppModuleBasic{}
+	_ sdk.AppModule          = AppModule{}
+	_ sdk.AppModuleBasic     = AppModuleBasic{}
+	_ sdk.AppModuleDescriber = AppModule{}
 )
 
 // app module basics object
@@ -85,6 +86,30 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 	return NewQuerier(am.keeper)
 }
 
+// module message types
+func (AppModule) MsgTypes() []string {
+	return []string{
+		MsgSetWithdrawAddress{}.Type(),
+		MsgWithdrawDelegatorReward{}.Type(),
+		MsgWithdrawValidatorCommission{}.Type(),
+	}
+}
+
+// module query paths
+func (AppModule) QueryPaths() []string {
+	return []string{
+		QueryParams,
+		QueryValidatorOutstandingRewards,
+		QueryValidatorCommission,
+		QueryValidatorSlashes,
+		QueryDelegationRewards,
+		QueryDelegatorTotalRewards,
+		QueryDelegatorValidators,
+		QueryWithdrawAddr,
+		QueryCommunityPool,
+	}
+}
+
 // module init-genesis
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
 	var genesisState GenesisState
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FundingSplit directs a weighted fraction of the collected fees and
+// inflation of every block to an address, eg. a developer fund
+type FundingSplit struct {
+	Address sdk.AccAddress `json:"address"`
+	Weight  sdk.Dec        `json:"weight"`
+}
+
+// NewFundingSplit creates a new FundingSplit object
+func NewFundingSplit(address sdk.AccAddress, weight sdk.Dec) FundingSplit {
+	return FundingSplit{
+		Address: address,
+		Weight:  weight,
+	}
+}
+
+// nolint
+func (fs FundingSplit) String() string {
+	return fmt.Sprintf("%s: %s", fs.Address, fs.Weight)
+}
+
+// FundingSplits is a collection of FundingSplit
+type FundingSplits []FundingSplit
+
+// TotalWeight returns the sum of the weights of all the splits
+func (fss FundingSplits) TotalWeight() sdk.Dec {
+	total := sdk.ZeroDec()
+	for _, fs := range fss {
+		total = total.Add(fs.Weight)
+	}
+	return total
+}
+
+// Validate checks that every split has an address and a positive weight,
+// that no address is listed twice and that the weights add up to at most one
+func (fss FundingSplits) Validate() error {
+	seen := make(map[string]bool)
+	for _, fs := range fss {
+		if fs.Address.Empty() {
+			return fmt.Errorf("funding split address cannot be empty")
+		}
+		if seen[fs.Address.String()] {
+			return fmt.Errorf("duplicate funding split address %s", fs.Address)
+		}
+		if fs.Weight.IsNil() || !fs.Weight.IsPositive() || fs.Weight.GT(sdk.OneDec()) {
+			return fmt.Errorf("funding split weight for %s should be positive "+
+				"and at most one, is %s", fs.Address, fs.Weight)
+		}
+		seen[fs.Address.String()] = true
+	}
+
+	if fss.TotalWeight().GT(sdk.OneDec()) {
+		return fmt.Errorf("funding split weights cannot add to be greater than one, "+
+			"adds to %s", fss.TotalWeight())
+	}
+	return nil
+}
+
+// nolint
+func (fss FundingSplits) String() string {
+	if len(fss) == 0 {
+		return "[]"
+	}
+
+	out := make([]string, len(fss))
+	for i, fs := range fss {
+		out[i] = fs.String()
+	}
+	return strings.Join(out, ", ")
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestFundingSplitsValidate(t *testing.T) {
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	tests := []struct {
+		splits    FundingSplits
+		expectErr bool
+	}{
+		{FundingSplits{}, false},
+		{FundingSplits{NewFundingSplit(addr1, sdk.NewDecWithPrec(5, 1)), NewFundingSplit(addr2, sdk.NewDecWithPrec(5, 1))}, false},
+		{FundingSplits{NewFundingSplit(nil, sdk.NewDecWithPrec(5, 1))}, true},
+		{FundingSplits{NewFundingSplit(addr1, sdk.ZeroDec())}, true},
+		{FundingSplits{NewFundingSplit(addr1, sdk.NewDecWithPrec(1, 1)), NewFundingSplit(addr1, sdk.NewDecWithPrec(1, 1))}, true},
+		{FundingSplits{NewFundingSplit(addr1, sdk.NewDecWithPrec(6, 1)), NewFundingSplit(addr2, sdk.NewDecWithPrec(5, 1))}, true},
+	}
+
+	for i, tc := range tests {
+		err := tc.splits.Validate()
+		require.Equal(t, tc.expectErr, err != nil, "unexpected result for test case #%d", i)
+	}
+}
+
+func TestValidateGenesisFundingSplits(t *testing.T) {
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	gs := DefaultGenesisState()
+	require.Nil(t, ValidateGenesis(gs))
+
+	gs.FundingSplits = FundingSplits{NewFundingSplit(addr1, sdk.NewDecWithPrec(5, 1))}
+	require.Nil(t, ValidateGenesis(gs))
+
+	// the splits and the other shares cannot exceed the rewards
+	gs.FundingSplits = FundingSplits{NewFundingSplit(addr1, sdk.NewDecWithPrec(95, 2))}
+	require.NotNil(t, ValidateGenesis(gs))
+
+	// which is checked without splits as well
+	gs.FundingSplits = FundingSplits{}
+	gs.CommunityTax = sdk.NewDecWithPrec(99, 2)
+	require.NotNil(t, ValidateGenesis(gs))
+
+	gs.CommunityTax = sdk.NewDecWithPrec(2, 2)
+	gs.FundingSplits = FundingSplits{NewFundingSplit(addr1, sdk.ZeroDec())}
+	require.NotNil(t, ValidateGenesis(gs))
+}
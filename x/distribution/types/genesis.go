@@ -59,6 +59,7 @@ type GenesisState struct {
 	BaseProposerReward              sdk.Dec                                `json:"base_proposer_reward"`
 	BonusProposerReward             sdk.Dec                                `json:"bonus_proposer_reward"`
 	WithdrawAddrEnabled             bool                                   `json:"withdraw_addr_enabled"`
+	FundingSplits                   FundingSplits                          `json:"funding_splits"`
 	DelegatorWithdrawInfos          []DelegatorWithdrawInfo                `json:"delegator_withdraw_infos"`
 	PreviousProposer                sdk.ConsAddress                        `json:"previous_proposer"`
 	OutstandingRewards              []ValidatorOutstandingRewardsRecord    `json:"outstanding_rewards"`
@@ -70,7 +71,7 @@ type GenesisState struct {
 }
 
 func NewGenesisState(feePool FeePool, communityTax, baseProposerReward, bonusProposerReward sdk.Dec,
-	withdrawAddrEnabled bool, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
+	withdrawAddrEnabled bool, fundingSplits FundingSplits, dwis []DelegatorWithdrawInfo, pp sdk.ConsAddress, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
 	cur []ValidatorCurrentRewardsRecord, dels []DelegatorStartingInfoRecord,
 	slashes []ValidatorSlashEventRecord) GenesisState {
@@ -81,6 +82,7 @@ func NewGenesisState(feePool FeePool, communityTax, baseProposerReward, bonusPro
 		BaseProposerReward:              baseProposerReward,
 		BonusProposerReward:             bonusProposerReward,
 		WithdrawAddrEnabled:             withdrawAddrEnabled,
+		FundingSplits:                   fundingSplits,
 		DelegatorWithdrawInfos:          dwis,
 		PreviousProposer:                pp,
 		OutstandingRewards:              r,
@@ -100,6 +102,7 @@ func DefaultGenesisState() GenesisState {
 		BaseProposerReward:              sdk.NewDecWithPrec(1, 2), // 1%
 		BonusProposerReward:             sdk.NewDecWithPrec(4, 2), // 4%
 		WithdrawAddrEnabled:             true,
+		FundingSplits:                   FundingSplits{},
 		DelegatorWithdrawInfos:          []DelegatorWithdrawInfo{},
 		PreviousProposer:                nil,
 		OutstandingRewards:              []ValidatorOutstandingRewardsRecord{},
@@ -114,22 +117,32 @@ func DefaultGenesisState() GenesisState {
 // ValidateGenesis validates the genesis state of distribution genesis input
 func ValidateGenesis(data GenesisState) error {
 	if data.CommunityTax.IsNegative() || data.CommunityTax.GT(sdk.OneDec()) {
-		return fmt.Errorf("mint parameter CommunityTax should non-negative and "+
+		return fmt.Errorf("distribution parameter CommunityTax should non-negative and "+
 			"less than one, is %s", data.CommunityTax.String())
 	}
 	if data.BaseProposerReward.IsNegative() {
-		return fmt.Errorf("mint parameter BaseProposerReward should be positive, is %s",
+		return fmt.Errorf("distribution parameter BaseProposerReward should be positive, is %s",
 			data.BaseProposerReward.String())
 	}
 	if data.BonusProposerReward.IsNegative() {
-		return fmt.Errorf("mint parameter BonusProposerReward should be positive, is %s",
+		return fmt.Errorf("distribution parameter BonusProposerReward should be positive, is %s",
 			data.BonusProposerReward.String())
 	}
 	if (data.BaseProposerReward.Add(data.BonusProposerReward)).
 		GT(sdk.OneDec()) {
-		return fmt.Errorf("mint parameters BaseProposerReward and "+
+		return fmt.Errorf("distribution parameters BaseProposerReward and "+
 			"BonusProposerReward cannot add to be greater than one, "+
 			"adds to %s", data.BaseProposerReward.Add(data.BonusProposerReward).String())
 	}
+	if err := data.FundingSplits.Validate(); err != nil {
+		return err
+	}
+	total := data.CommunityTax.Add(data.BaseProposerReward).Add(data.BonusProposerReward).
+		Add(data.FundingSplits.TotalWeight())
+	if total.GT(sdk.OneDec()) {
+		return fmt.Errorf("distribution parameters CommunityTax, BaseProposerReward, "+
+			"BonusProposerReward and FundingSplits cannot add to be greater than one, "+
+			"adds to %s", total.String())
+	}
 	return data.FeePool.ValidateGenesis()
 }
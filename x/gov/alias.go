@@ -79,6 +79,7 @@ var (
 	NewTallyResult                   = types.NewTallyResult
 	NewTallyResultFromMap            = types.NewTallyResultFromMap
 	EmptyTallyResult                 = types.EmptyTallyResult
+	NewProposalSimulationResult      = types.NewProposalSimulationResult
 	NewTextProposal                  = types.NewTextProposal
 	NewSoftwareUpgradeProposal       = types.NewSoftwareUpgradeProposal
 	RegisterProposalType             = types.RegisterProposalType
@@ -94,24 +95,28 @@ var (
 	KeyNextProposalID           = types.KeyNextProposalID
 	PrefixActiveProposalQueue   = types.PrefixActiveProposalQueue
 	PrefixInactiveProposalQueue = types.PrefixInactiveProposalQueue
+	PrefixProposal              = types.PrefixProposal
+	PrefixDeposit               = types.PrefixDeposit
+	PrefixVote                  = types.PrefixVote
 )
 
 type (
-	Content                 = types.Content
-	Handler                 = types.Handler
-	Deposit                 = types.Deposit
-	Deposits                = types.Deposits
-	MsgSubmitProposal       = types.MsgSubmitProposal
-	MsgDeposit              = types.MsgDeposit
-	MsgVote                 = types.MsgVote
-	Proposal                = types.Proposal
-	Proposals               = types.Proposals
-	ProposalQueue           = types.ProposalQueue
-	ProposalStatus          = types.ProposalStatus
-	TallyResult             = types.TallyResult
-	TextProposal            = types.TextProposal
-	SoftwareUpgradeProposal = types.SoftwareUpgradeProposal
-	Vote                    = types.Vote
-	Votes                   = types.Votes
-	VoteOption              = types.VoteOption
+	Content                  = types.Content
+	Handler                  = types.Handler
+	Deposit                  = types.Deposit
+	Deposits                 = types.Deposits
+	MsgSubmitProposal        = types.MsgSubmitProposal
+	MsgDeposit               = types.MsgDeposit
+	MsgVote                  = types.MsgVote
+	Proposal                 = types.Proposal
+	Proposals                = types.Proposals
+	ProposalQueue            = types.ProposalQueue
+	ProposalStatus           = types.ProposalStatus
+	TallyResult              = types.TallyResult
+	ProposalSimulationResult = types.ProposalSimulationResult
+	TextProposal             = types.TextProposal
+	SoftwareUpgradeProposal  = types.SoftwareUpgradeProposal
+	Vote                     = types.Vote
+	Votes                    = types.Votes
+	VoteOption               = types.VoteOption
 )
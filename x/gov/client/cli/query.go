@@ -436,6 +436,53 @@ $ %s query gov tally 1
 	}
 }
 
+// GetCmdQuerySimulate implements the command to dry-run the content of a
+// proposal.
+func GetCmdQuerySimulate(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "simulate [proposal-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Simulate the execution of a proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Execute the content of a proposal against the current state as if the
+proposal had passed and report whether its execution would succeed. No state is
+persisted. You can find the proposal-id by running "%s query gov proposals".
+
+Example:
+$ %s query gov simulate 1
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// validate that the proposal id is a uint
+			proposalID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
+			}
+
+			// Construct query
+			params := gov.NewQueryProposalParams(proposalID)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			// Query store
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, gov.QuerySimulate), bz)
+			if err != nil {
+				return err
+			}
+
+			var result gov.ProposalSimulationResult
+			cdc.MustUnmarshalJSON(res, &result)
+			return cliCtx.PrintOutput(result)
+		},
+	}
+}
+
 // GetCmdQueryProposal implements the query proposal command.
 func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
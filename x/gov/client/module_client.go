@@ -42,7 +42,8 @@ func (mc ModuleClient) GetQueryCmd() *cobra.Command {
 		govCli.GetCmdQueryProposer(mc.storeKey, mc.cdc),
 		govCli.GetCmdQueryDeposit(mc.storeKey, mc.cdc),
 		govCli.GetCmdQueryDeposits(mc.storeKey, mc.cdc),
-		govCli.GetCmdQueryTally(mc.storeKey, mc.cdc))...)
+		govCli.GetCmdQueryTally(mc.storeKey, mc.cdc),
+		govCli.GetCmdQuerySimulate(mc.storeKey, mc.cdc))...)
 
 	return govQueryCmd
 }
@@ -25,6 +25,7 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) sdk.Tags {
 
 		keeper.DeleteProposal(ctx, proposalID)
 		keeper.DeleteDeposits(ctx, proposalID) // delete any associated deposits (burned)
+		keeper.AfterProposalEnded(ctx, inactiveProposal)
 
 		resTags = resTags.AppendTag(tags.ProposalID, fmt.Sprintf("%d", proposalID))
 		resTags = resTags.AppendTag(tags.ProposalResult, tags.ActionProposalDropped)
@@ -58,19 +59,17 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) sdk.Tags {
 			keeper.RefundDeposits(ctx, activeProposal.ProposalID)
 
 			handler := keeper.router.GetRoute(activeProposal.ProposalRoute())
-			cacheCtx, writeCache := ctx.CacheContext()
 
 			// The proposal handler may execute state mutating logic depending
 			// on the proposal content. If the handler fails, no state mutation
 			// is written and the error message is logged.
-			err := handler(cacheCtx, activeProposal.Content)
+			_, err := sdk.RunAtomically(ctx, func(ctx sdk.Context) (sdk.Tags, sdk.Error) {
+				return nil, handler(ctx, activeProposal.Content)
+			})
 			if err == nil {
 				activeProposal.Status = StatusPassed
 				tagValue = tags.ActionProposalPassed
 				logMsg = "passed"
-
-				// write state to the underlying multi-store
-				writeCache()
 			} else {
 				activeProposal.Status = StatusFailed
 				tagValue = tags.ActionProposalFailed
@@ -88,6 +87,7 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) sdk.Tags {
 
 		keeper.SetProposal(ctx, activeProposal)
 		keeper.RemoveFromActiveProposalQueue(ctx, activeProposal.VotingEndTime, activeProposal.ProposalID)
+		keeper.AfterProposalEnded(ctx, activeProposal)
 
 		logger.Info(
 			fmt.Sprintf(
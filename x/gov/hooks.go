@@ -0,0 +1,34 @@
+package gov
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GovHooks event hooks for governance proposals
+type GovHooks interface {
+	AfterProposalDeposit(ctx sdk.Context, proposal Proposal) // Must be called after a deposit is made on a proposal, including the initial deposit
+	AfterProposalEnded(ctx sdk.Context, proposal Proposal)   // Must be called after a proposal is dropped or tallied
+}
+
+// SetHooks sets the governance hooks
+func (keeper *Keeper) SetHooks(gh GovHooks) *Keeper {
+	if keeper.hooks != nil {
+		panic("cannot set governance hooks twice")
+	}
+	keeper.hooks = gh
+	return keeper
+}
+
+// AfterProposalDeposit - call hook if registered
+func (keeper Keeper) AfterProposalDeposit(ctx sdk.Context, proposal Proposal) {
+	if keeper.hooks != nil {
+		keeper.hooks.AfterProposalDeposit(ctx, proposal)
+	}
+}
+
+// AfterProposalEnded - call hook if registered
+func (keeper Keeper) AfterProposalEnded(ctx sdk.Context, proposal Proposal) {
+	if keeper.hooks != nil {
+		keeper.hooks.AfterProposalEnded(ctx, proposal)
+	}
+}
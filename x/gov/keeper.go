@@ -1,6 +1,7 @@
 package gov
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -59,6 +60,9 @@ type Keeper struct {
 
 	// Proposal router
 	router Router
+
+	// Proposal event hooks
+	hooks GovHooks
 }
 
 // NewKeeper returns a governance keeper. It handles:
@@ -123,6 +127,37 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, content Content) (Proposal,
 	return proposal, nil
 }
 
+// SimulateProposal executes the content of a proposal in a cache-wrapped
+// context as if the proposal had passed and reports whether the execution
+// would succeed. State is not persisted. As it runs from a query, a panicking
+// proposal handler is reported as an internal error.
+func (keeper Keeper) SimulateProposal(ctx sdk.Context, proposalID uint64) (res ProposalSimulationResult, err sdk.Error) {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return ProposalSimulationResult{}, ErrUnknownProposal(keeper.codespace, proposalID)
+	}
+
+	if !keeper.router.HasRoute(proposal.ProposalRoute()) {
+		return ProposalSimulationResult{}, ErrNoProposalHandlerExists(keeper.codespace, proposal.Content)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			res, err = ProposalSimulationResult{}, sdk.ErrInternal(
+				fmt.Sprintf("proposal handler panicked while simulating proposal %d: %v", proposalID, r),
+			)
+		}
+	}()
+
+	cacheCtx, _ := ctx.CacheContext()
+	handler := keeper.router.GetRoute(proposal.ProposalRoute())
+	if err := handler(cacheCtx, proposal.Content); err != nil {
+		return NewProposalSimulationResult(proposalID, false, err.ABCILog()), nil
+	}
+
+	return NewProposalSimulationResult(proposalID, true, ""), nil
+}
+
 // Get Proposal from store by ProposalID
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (proposal Proposal, ok bool) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -167,7 +202,7 @@ func (keeper Keeper) GetProposalsFiltered(ctx sdk.Context, voterAddr sdk.AccAddr
 
 	matchingProposals := []Proposal{}
 
-	if numLatest == 0 {
+	if numLatest == 0 || numLatest > maxProposalID {
 		numLatest = maxProposalID
 	}
 
@@ -411,6 +446,10 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 		keeper.setDeposit(ctx, proposalID, depositorAddr, currDeposit)
 	}
 
+	// reload the proposal as its voting period may have been activated
+	proposal, _ = keeper.GetProposal(ctx, proposalID)
+	keeper.AfterProposalDeposit(ctx, proposal)
+
 	return nil, activatedVotingPeriod
 }
 
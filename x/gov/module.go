@@ -10,8 +10,9 @@ import (
 )
 
 var (
-	_ sdk.AppModule      = AppModule{}
-	_ sdk.AppModuleBasic = AppModuleBasic{}
+	_ sdk.AppModule          = AppModule{}
+	_ sdk.AppModuleBasic     = AppModuleBasic{}
+	_ sdk.AppModuleDescriber = AppModule{}
 )
 
 // app module basics object
@@ -87,6 +88,30 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 	return NewQuerier(am.keeper)
 }
 
+// module message types
+func (AppModule) MsgTypes() []string {
+	return []string{
+		TypeMsgSubmitProposal,
+		TypeMsgDeposit,
+		TypeMsgVote,
+	}
+}
+
+// module query paths
+func (AppModule) QueryPaths() []string {
+	return []string{
+		QueryParams,
+		QueryProposals,
+		QueryProposal,
+		QueryDeposits,
+		QueryDeposit,
+		QueryVotes,
+		QueryVote,
+		QueryTally,
+		QuerySimulate,
+	}
+}
+
 // module init-genesis
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
 	var genesisState GenesisState
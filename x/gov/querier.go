@@ -19,6 +19,7 @@ const (
 	QueryVotes     = "votes"
 	QueryVote      = "vote"
 	QueryTally     = "tally"
+	QuerySimulate  = "simulate"
 
 	ParamDeposit  = "deposit"
 	ParamVoting   = "voting"
@@ -44,6 +45,8 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryVote(ctx, path[1:], req, keeper)
 		case QueryTally:
 			return queryTally(ctx, path[1:], req, keeper)
+		case QuerySimulate:
+			return querySimulate(ctx, path[1:], req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown gov query endpoint")
 		}
@@ -80,6 +83,7 @@ func queryParams(ctx sdk.Context, path []string, req abci.RequestQuery, keeper K
 // - 'custom/gov/deposits'
 // - 'custom/gov/tally'
 // - 'custom/gov/votes'
+// - 'custom/gov/simulate'
 type QueryProposalParams struct {
 	ProposalID uint64
 }
@@ -228,6 +232,26 @@ func queryTally(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 	return bz, nil
 }
 
+// nolint: unparam
+func querySimulate(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params QueryProposalParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	result, sdkErr := keeper.SimulateProposal(ctx, params.ProposalID)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, result)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 // nolint: unparam
 func queryVotes(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	var params QueryProposalParams
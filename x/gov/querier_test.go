@@ -300,3 +300,49 @@ func TestQueries(t *testing.T) {
 	proposals = getQueriedProposals(t, ctx, cdc, querier, input.addrs[0], input.addrs[0], StatusNil, 0)
 	require.Equal(t, proposalID2, (proposals[0]).ProposalID)
 }
+
+func TestQuerySimulate(t *testing.T) {
+	cdc := codec.New()
+	input := getMockApp(t, 1000, GenesisState{}, nil)
+	querier := NewQuerier(input.keeper)
+
+	types.RegisterCodec(cdc)
+
+	header := abci.Header{Height: input.mApp.LastBlockHeight() + 1}
+	input.mApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	ctx := input.mApp.NewContext(false, abci.Header{})
+
+	proposal, err := input.keeper.SubmitProposal(ctx, testProposal())
+	require.Nil(t, err)
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, QuerierRoute, QuerySimulate}, "/"),
+		Data: cdc.MustMarshalJSON(NewQueryProposalParams(proposal.ProposalID)),
+	}
+
+	bz, err := querier(ctx, []string{QuerySimulate}, query)
+	require.Nil(t, err)
+	require.NotNil(t, bz)
+
+	var result ProposalSimulationResult
+	require.Nil(t, cdc.UnmarshalJSON(bz, &result))
+	require.Equal(t, NewProposalSimulationResult(proposal.ProposalID, true, ""), result)
+
+	// unknown proposal
+	query.Data = cdc.MustMarshalJSON(NewQueryProposalParams(proposal.ProposalID + 1))
+	_, err = querier(ctx, []string{QuerySimulate}, query)
+	require.NotNil(t, err)
+
+	// a panicking proposal handler doesn't crash the query
+	keeper := input.keeper
+	keeper.router = NewRouter().AddRoute(RouterKey, func(ctx sdk.Context, content Content) sdk.Error {
+		panic("proposal handler panic")
+	})
+	query.Data = cdc.MustMarshalJSON(NewQueryProposalParams(proposal.ProposalID))
+	require.NotPanics(t, func() {
+		_, err = NewQuerier(keeper)(ctx, []string{QuerySimulate}, query)
+	})
+	require.NotNil(t, err)
+	require.Equal(t, sdk.CodeInternal, err.Code())
+}
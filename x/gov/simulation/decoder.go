@@ -0,0 +1,50 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+// DecodeStore unmarshals the KVPair's Value to the corresponding gov type and
+// returns its human readable representation. It panics on unknown keys.
+func DecodeStore(cdc *codec.Codec, kvPair cmn.KVPair) string {
+	switch {
+	case bytes.HasPrefix(kvPair.Key, gov.PrefixProposal):
+		var proposal gov.Proposal
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvPair.Value, &proposal)
+		return fmt.Sprintf("Proposal: %v", proposal)
+
+	case bytes.HasPrefix(kvPair.Key, gov.PrefixDeposit):
+		var deposit gov.Deposit
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvPair.Value, &deposit)
+		return fmt.Sprintf("Deposit: %v", deposit)
+
+	case bytes.HasPrefix(kvPair.Key, gov.PrefixVote):
+		var vote gov.Vote
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvPair.Value, &vote)
+		return fmt.Sprintf("Vote: %v", vote)
+
+	case bytes.Equal(kvPair.Key, gov.KeyNextProposalID):
+		var proposalID uint64
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvPair.Value, &proposalID)
+		return fmt.Sprintf("NextProposalID: %d", proposalID)
+
+	case bytes.HasPrefix(kvPair.Key, gov.PrefixActiveProposalQueue):
+		var proposalID uint64
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvPair.Value, &proposalID)
+		return fmt.Sprintf("ActiveProposalQueue %X: %d", kvPair.Key, proposalID)
+
+	case bytes.HasPrefix(kvPair.Key, gov.PrefixInactiveProposalQueue):
+		var proposalID uint64
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvPair.Value, &proposalID)
+		return fmt.Sprintf("InactiveProposalQueue %X: %d", kvPair.Key, proposalID)
+
+	default:
+		panic(fmt.Sprintf("invalid gov key %X", kvPair.Key))
+	}
+}
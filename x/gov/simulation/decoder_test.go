@@ -0,0 +1,76 @@
+package simulation
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+var (
+	delPk1   = ed25519.GenPrivKey().PubKey()
+	delAddr1 = sdk.AccAddress(delPk1.Address())
+)
+
+func makeTestCodec() (cdc *codec.Codec) {
+	cdc = codec.New()
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	gov.RegisterCodec(cdc)
+	return
+}
+
+func TestDecodeStore(t *testing.T) {
+	cdc := makeTestCodec()
+
+	endTime := time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	content := gov.NewTextProposal("Test", "description")
+	proposal := gov.NewProposal(content, 1, endTime, endTime.Add(24*time.Hour))
+	deposit := gov.Deposit{Depositor: delAddr1, ProposalID: 1, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))}
+	vote := gov.Vote{Voter: delAddr1, ProposalID: 1, Option: gov.OptionYes}
+	proposalIDBz := cdc.MustMarshalBinaryLengthPrefixed(uint64(1))
+
+	kvPairs := cmn.KVPairs{
+		cmn.KVPair{Key: gov.KeyProposal(1), Value: cdc.MustMarshalBinaryLengthPrefixed(proposal)},
+		cmn.KVPair{Key: gov.KeyDeposit(1, delAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(deposit)},
+		cmn.KVPair{Key: gov.KeyVote(1, delAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(vote)},
+		cmn.KVPair{Key: gov.KeyNextProposalID, Value: proposalIDBz},
+		cmn.KVPair{Key: gov.KeyActiveProposalQueueProposal(endTime, 1), Value: proposalIDBz},
+		cmn.KVPair{Key: gov.KeyInactiveProposalQueueProposal(endTime, 1), Value: proposalIDBz},
+		cmn.KVPair{Key: []byte{0x99}, Value: []byte{0x99}},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"proposals", fmt.Sprintf("Proposal: %v", proposal)},
+		{"deposits", fmt.Sprintf("Deposit: %v", deposit)},
+		{"votes", fmt.Sprintf("Vote: %v", vote)},
+		{"next proposal ID", "NextProposalID: 1"},
+		{"active proposal queue", fmt.Sprintf("ActiveProposalQueue %X: 1", kvPairs[4].Key)},
+		{"inactive proposal queue", fmt.Sprintf("InactiveProposalQueue %X: 1", kvPairs[5].Key)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { DecodeStore(cdc, kvPairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, DecodeStore(cdc, kvPairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -32,31 +32,35 @@ var (
 	KeyNextProposalID           = []byte("newProposalID")
 	PrefixActiveProposalQueue   = []byte("activeProposalQueue")
 	PrefixInactiveProposalQueue = []byte("inactiveProposalQueue")
+
+	PrefixProposal = []byte("proposals:")
+	PrefixDeposit  = []byte("deposits:")
+	PrefixVote     = []byte("votes:")
 )
 
 // Key for getting a specific proposal from the store
 func KeyProposal(proposalID uint64) []byte {
-	return []byte(fmt.Sprintf("proposals:%d", proposalID))
+	return []byte(fmt.Sprintf("%s%d", PrefixProposal, proposalID))
 }
 
 // Key for getting a specific deposit from the store
 func KeyDeposit(proposalID uint64, depositorAddr sdk.AccAddress) []byte {
-	return []byte(fmt.Sprintf("deposits:%d:%d", proposalID, depositorAddr))
+	return []byte(fmt.Sprintf("%s%d:%d", PrefixDeposit, proposalID, depositorAddr))
 }
 
 // Key for getting a specific vote from the store
 func KeyVote(proposalID uint64, voterAddr sdk.AccAddress) []byte {
-	return []byte(fmt.Sprintf("votes:%d:%d", proposalID, voterAddr))
+	return []byte(fmt.Sprintf("%s%d:%d", PrefixVote, proposalID, voterAddr))
 }
 
 // Key for getting all deposits on a proposal from the store
 func KeyDepositsSubspace(proposalID uint64) []byte {
-	return []byte(fmt.Sprintf("deposits:%d:", proposalID))
+	return []byte(fmt.Sprintf("%s%d:", PrefixDeposit, proposalID))
 }
 
 // Key for getting all votes on a proposal from the store
 func KeyVotesSubspace(proposalID uint64) []byte {
-	return []byte(fmt.Sprintf("votes:%d:", proposalID))
+	return []byte(fmt.Sprintf("%s%d:", PrefixVote, proposalID))
 }
 
 // Returns the key for a proposalID in the activeProposalQueue
@@ -247,6 +247,28 @@ func (tr TallyResult) String() string {
   NoWithVeto: %s`, tr.Yes, tr.Abstain, tr.No, tr.NoWithVeto)
 }
 
+// ProposalSimulationResult defines the result of executing the content of a
+// proposal against a cache-wrapped state as if the proposal had passed.
+type ProposalSimulationResult struct {
+	ProposalID uint64 `json:"proposal_id"`
+	Success    bool   `json:"success"`
+	Log        string `json:"log"`
+}
+
+func NewProposalSimulationResult(proposalID uint64, success bool, log string) ProposalSimulationResult {
+	return ProposalSimulationResult{
+		ProposalID: proposalID,
+		Success:    success,
+		Log:        log,
+	}
+}
+
+func (psr ProposalSimulationResult) String() string {
+	return fmt.Sprintf(`Proposal %d Simulation Result:
+  Success: %t
+  Log:     %s`, psr.ProposalID, psr.Success, psr.Log)
+}
+
 // Proposal types
 const (
 	ProposalTypeText            string = "Text"
@@ -9,8 +9,9 @@ import (
 )
 
 var (
-	_ sdk.AppModule      = AppModule{}
-	_ sdk.AppModuleBasic = AppModuleBasic{}
+	_ sdk.AppModule          = AppModule{}
+	_ sdk.AppModuleBasic     = AppModuleBasic{}
+	_ sdk.AppModuleDescriber = AppModule{}
 )
 
 // name of this module
@@ -83,6 +84,20 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 	return NewQuerier(am.keeper)
 }
 
+// module message types
+func (AppModule) MsgTypes() []string {
+	return []string{}
+}
+
+// module query paths
+func (AppModule) QueryPaths() []string {
+	return []string{
+		QueryParameters,
+		QueryInflation,
+		QueryAnnualProvisions,
+	}
+}
+
 // module init-genesis
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
 	var genesisState GenesisState
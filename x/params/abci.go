@@ -0,0 +1,33 @@
+package params
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker applies the parameter changes scheduled up to the current
+// height. The changes scheduled for a height are applied all together or, if
+// any of them fails, not at all.
+func BeginBlocker(ctx sdk.Context, k Keeper) {
+	var due []ScheduledChanges
+	k.IterateScheduledChanges(ctx, func(sc ScheduledChanges) bool {
+		if sc.Height > ctx.BlockHeight() {
+			return true
+		}
+		due = append(due, sc)
+		return false
+	})
+
+	for _, sc := range due {
+		k.DeleteScheduledChanges(ctx, sc.Height)
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := applyChanges(cacheCtx, k, sc.Changes); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("failed to apply parameter changes scheduled at height %d: %s",
+				sc.Height, err.Error()))
+			continue
+		}
+		writeCache()
+	}
+}
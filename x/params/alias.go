@@ -18,6 +18,7 @@ const (
 	CodeUnknownSubspace  = types.CodeUnknownSubspace
 	CodeSettingParameter = types.CodeSettingParameter
 	CodeEmptyData        = types.CodeEmptyData
+	CodeInvalidHeight    = types.CodeInvalidHeight
 	ModuleName           = types.ModuleName
 	RouterKey            = types.RouterKey
 	ProposalTypeChange   = types.ProposalTypeChange
@@ -25,19 +26,26 @@ const (
 
 var (
 	// functions aliases
-	NewSubspace                = subspace.NewSubspace
-	NewKeyTable                = subspace.NewKeyTable
-	DefaultTestComponents      = subspace.DefaultTestComponents
-	RegisterCodec              = types.RegisterCodec
-	ErrUnknownSubspace         = types.ErrUnknownSubspace
-	ErrSettingParameter        = types.ErrSettingParameter
-	ErrEmptyChanges            = types.ErrEmptyChanges
-	ErrEmptySubspace           = types.ErrEmptySubspace
-	ErrEmptyKey                = types.ErrEmptyKey
-	ErrEmptyValue              = types.ErrEmptyValue
-	NewParameterChangeProposal = types.NewParameterChangeProposal
-	NewParamChange             = types.NewParamChange
-	ValidateChanges            = types.ValidateChanges
+	NewSubspace                         = subspace.NewSubspace
+	NewKeyTable                         = subspace.NewKeyTable
+	DefaultTestComponents               = subspace.DefaultTestComponents
+	RegisterCodec                       = types.RegisterCodec
+	ErrUnknownSubspace                  = types.ErrUnknownSubspace
+	ErrSettingParameter                 = types.ErrSettingParameter
+	ErrEmptyChanges                     = types.ErrEmptyChanges
+	ErrEmptySubspace                    = types.ErrEmptySubspace
+	ErrEmptyKey                         = types.ErrEmptyKey
+	ErrEmptyValue                       = types.ErrEmptyValue
+	ErrInvalidHeight                    = types.ErrInvalidHeight
+	GetScheduledChangesKey              = types.GetScheduledChangesKey
+	NewParameterChangeProposal          = types.NewParameterChangeProposal
+	NewParamChange                      = types.NewParamChange
+	ValidateChanges                     = types.ValidateChanges
+	NewScheduledChanges                 = types.NewScheduledChanges
+	NewScheduledParameterChangeProposal = types.NewScheduledParameterChangeProposal
+
+	// variable aliases
+	ScheduledChangesKey = types.ScheduledChangesKey
 )
 
 type (
@@ -49,4 +57,5 @@ type (
 	KeyTable                = subspace.KeyTable
 	ParameterChangeProposal = types.ParameterChangeProposal
 	ParamChange             = types.ParamChange
+	ScheduledChanges        = types.ScheduledChanges
 )
@@ -36,6 +36,9 @@ Proper vetting of a parameter change proposal should prevent this from happening
 (no deposits should occur during the governance process), but it should be noted
 regardless. 
 
+The changes are applied when the proposal passes, or from the optional
+"height" on if it is in the future by then.
+
 Example:
 $ %s tx gov submit-proposal param-change <path/to/proposal.json> --from=<key_or_address>
 
@@ -74,7 +77,8 @@ Where proposal.json contains:
 			}
 
 			from := cliCtx.GetFromAddress()
-			content := params.NewParameterChangeProposal(proposal.Title, proposal.Description, proposal.Changes.ToParamChanges())
+			content := params.NewScheduledParameterChangeProposal(proposal.Title, proposal.Description,
+				proposal.Changes.ToParamChanges(), proposal.Height)
 
 			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
 			if err := msg.ValidateBasic(); err != nil {
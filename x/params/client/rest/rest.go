@@ -35,7 +35,8 @@ func postProposalHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Han
 			return
 		}
 
-		content := params.NewParameterChangeProposal(req.Title, req.Description, req.Changes.ToParamChanges())
+		content := params.NewScheduledParameterChangeProposal(req.Title, req.Description,
+			req.Changes.ToParamChanges(), req.Height)
 
 		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
 		if err := msg.ValidateBasic(); err != nil {
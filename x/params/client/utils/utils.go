@@ -30,6 +30,7 @@ type (
 		Title       string           `json:"title"`
 		Description string           `json:"description"`
 		Changes     ParamChangesJSON `json:"changes"`
+		Height      int64            `json:"height,omitempty"`
 		Deposit     sdk.Coins        `json:"deposit"`
 	}
 
@@ -40,6 +41,7 @@ type (
 		Title       string           `json:"title"`
 		Description string           `json:"description"`
 		Changes     ParamChangesJSON `json:"changes"`
+		Height      int64            `json:"height,omitempty"`
 		Proposer    sdk.AccAddress   `json:"proposer"`
 		Deposit     sdk.Coins        `json:"deposit"`
 	}
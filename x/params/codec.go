@@ -0,0 +1,14 @@
+package params
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// generic sealed codec to be used throughout module
+var moduleCdc *codec.Codec
+
+func init() {
+	cdc := codec.New()
+	RegisterCodec(cdc)
+	moduleCdc = cdc.Seal()
+}
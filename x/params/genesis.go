@@ -0,0 +1,63 @@
+package params
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - params genesis state
+type GenesisState struct {
+	ScheduledChanges []ScheduledChanges `json:"scheduled_changes"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(scheduledChanges []ScheduledChanges) GenesisState {
+	return GenesisState{
+		ScheduledChanges: scheduledChanges,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		ScheduledChanges: []ScheduledChanges{},
+	}
+}
+
+// InitGenesis sets the scheduled parameter changes from a genesis state. The
+// parameters themselves are set by the genesis of their modules.
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	for _, sc := range data.ScheduledChanges {
+		k.ScheduleChanges(ctx, sc.Height, sc.Changes)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	scheduledChanges := []ScheduledChanges{}
+	k.IterateScheduledChanges(ctx, func(sc ScheduledChanges) bool {
+		scheduledChanges = append(scheduledChanges, sc)
+		return false
+	})
+	return NewGenesisState(scheduledChanges)
+}
+
+// ValidateGenesis performs basic validation of the params genesis data
+// returning an error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	seen := make(map[int64]bool)
+	for _, sc := range data.ScheduledChanges {
+		if sc.Height <= 0 {
+			return fmt.Errorf("invalid scheduled parameter changes height %d", sc.Height)
+		}
+		if seen[sc.Height] {
+			return fmt.Errorf("duplicate scheduled parameter changes height %d", sc.Height)
+		}
+		if err := ValidateChanges(sc.Changes); err != nil {
+			return fmt.Errorf("invalid parameter changes scheduled at height %d: %s", sc.Height, err.Error())
+		}
+		seen[sc.Height] = true
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+	"github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/tendermint/tendermint/libs/log"
 )
@@ -60,3 +61,48 @@ func (k Keeper) GetSubspace(s string) (Subspace, bool) {
 	}
 	return *space, ok
 }
+
+// GetScheduledChanges gets the parameter changes scheduled for a height
+func (k Keeper) GetScheduledChanges(ctx sdk.Context, height int64) []ParamChange {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.GetScheduledChangesKey(height))
+	if bz == nil {
+		return nil
+	}
+
+	var sc ScheduledChanges
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &sc)
+	return sc.Changes
+}
+
+// ScheduleChanges schedules parameter changes to be applied at the beginning
+// of the block at the given height, after the changes already scheduled for
+// that height
+func (k Keeper) ScheduleChanges(ctx sdk.Context, height int64, changes []ParamChange) {
+	sc := types.NewScheduledChanges(height, append(k.GetScheduledChanges(ctx, height), changes...))
+
+	store := ctx.KVStore(k.key)
+	store.Set(types.GetScheduledChangesKey(height), k.cdc.MustMarshalBinaryLengthPrefixed(sc))
+}
+
+// DeleteScheduledChanges deletes the parameter changes scheduled for a height
+func (k Keeper) DeleteScheduledChanges(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.key)
+	store.Delete(types.GetScheduledChangesKey(height))
+}
+
+// IterateScheduledChanges iterates over the scheduled parameter changes by
+// ascending height and performs a callback function until it returns true
+func (k Keeper) IterateScheduledChanges(ctx sdk.Context, cb func(sc ScheduledChanges) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iterator := sdk.KVStorePrefixIterator(store, types.ScheduledChangesKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var sc ScheduledChanges
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &sc)
+		if cb(sc) {
+			break
+		}
+	}
+}
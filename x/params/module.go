@@ -3,12 +3,15 @@ package params
 import (
 	"encoding/json"
 
+	abci "github.com/tendermint/tendermint/abci/types"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var (
+	_ sdk.AppModule      = AppModule{}
 	_ sdk.AppModuleBasic = AppModuleBasic{}
 )
 
@@ -28,7 +31,81 @@ func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
 }
 
 // default genesis state
-func (AppModuleBasic) DefaultGenesis() json.RawMessage { return nil }
+func (AppModuleBasic) DefaultGenesis() json.RawMessage {
+	return moduleCdc.MustMarshalJSON(DefaultGenesisState())
+}
 
 // module validate genesis
-func (AppModuleBasic) ValidateGenesis(_ json.RawMessage) error { return nil }
+func (AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
+	// the params genesis used to be empty
+	if bz == nil {
+		return nil
+	}
+
+	var data GenesisState
+	err := moduleCdc.UnmarshalJSON(bz, &data)
+	if err != nil {
+		return err
+	}
+	return ValidateGenesis(data)
+}
+
+// ___________________________
+// app module
+type AppModule struct {
+	AppModuleBasic
+	keeper Keeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(keeper Keeper) AppModule {
+	return AppModule{
+		AppModuleBasic: AppModuleBasic{},
+		keeper:         keeper,
+	}
+}
+
+// module name
+func (AppModule) Name() string {
+	return moduleName
+}
+
+// register invariants
+func (AppModule) RegisterInvariants(_ sdk.InvariantRouter) {}
+
+// module message route name
+func (AppModule) Route() string { return "" }
+
+// module handler
+func (AppModule) NewHandler() sdk.Handler { return nil }
+
+// module querier route name
+func (AppModule) QuerierRoute() string { return "" }
+
+// module querier
+func (AppModule) NewQuerierHandler() sdk.Querier { return nil }
+
+// module init-genesis
+func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState GenesisState
+	moduleCdc.MustUnmarshalJSON(data, &genesisState)
+	InitGenesis(ctx, am.keeper, genesisState)
+	return []abci.ValidatorUpdate{}
+}
+
+// module export genesis
+func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
+	gs := ExportGenesis(ctx, am.keeper)
+	return moduleCdc.MustMarshalJSON(gs)
+}
+
+// module begin-block
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) sdk.Tags {
+	BeginBlocker(ctx, am.keeper)
+	return sdk.EmptyTags()
+}
+
+// module end-block
+func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) ([]abci.ValidatorUpdate, sdk.Tags) {
+	return []abci.ValidatorUpdate{}, sdk.EmptyTags()
+}
@@ -21,7 +21,24 @@ func NewParamChangeProposalHandler(k Keeper) govtypes.Handler {
 }
 
 func handleParameterChangeProposal(ctx sdk.Context, k Keeper, p ParameterChangeProposal) sdk.Error {
-	for _, c := range p.Changes {
+	if p.Height <= ctx.BlockHeight() {
+		return applyChanges(ctx, k, p.Changes)
+	}
+
+	// check that the changes can be applied, the parameters may still be
+	// changed in between though
+	cacheCtx, _ := ctx.CacheContext()
+	if err := applyChanges(cacheCtx, k, p.Changes); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("scheduling parameter changes at height %d", p.Height))
+	k.ScheduleChanges(ctx, p.Height, p.Changes)
+	return nil
+}
+
+func applyChanges(ctx sdk.Context, k Keeper, changes []ParamChange) sdk.Error {
+	for _, c := range changes {
 		ss, ok := k.GetSubspace(c.Subspace)
 		if !ok {
 			return ErrUnknownSubspace(k.codespace, c.Subspace)
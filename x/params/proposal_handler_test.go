@@ -97,3 +97,43 @@ func TestProposalHandlerFailed(t *testing.T) {
 
 	require.False(t, ss.Has(input.ctx, []byte(keyMaxValidators)))
 }
+
+func TestProposalHandlerScheduled(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
+		params.NewKeyTable().RegisterParamSet(&testParams{}),
+	)
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+	ctx := input.ctx.WithBlockHeight(5)
+
+	// invalid changes are rejected right away
+	tp := params.NewScheduledParameterChangeProposal("Test", "description",
+		[]params.ParamChange{params.NewParamChange(testSubspace, keyMaxValidators, "", "invalidType")}, 10)
+	require.Error(t, hdlr(ctx, tp))
+
+	tp = params.NewScheduledParameterChangeProposal("Test", "description",
+		[]params.ParamChange{params.NewParamChange(testSubspace, keyMaxValidators, "", "1")}, 10)
+	require.NoError(t, hdlr(ctx, tp))
+	require.False(t, ss.Has(ctx, []byte(keyMaxValidators)))
+	require.Len(t, input.keeper.GetScheduledChanges(ctx, 10), 1)
+
+	genState := params.ExportGenesis(ctx, input.keeper)
+	require.NoError(t, params.ValidateGenesis(genState))
+	require.Len(t, genState.ScheduledChanges, 1)
+
+	params.BeginBlocker(ctx.WithBlockHeight(9), input.keeper)
+	require.False(t, ss.Has(ctx, []byte(keyMaxValidators)))
+
+	params.BeginBlocker(ctx.WithBlockHeight(10), input.keeper)
+	var param uint16
+	ss.Get(ctx, []byte(keyMaxValidators), &param)
+	require.Equal(t, uint16(1), param)
+	require.Empty(t, input.keeper.GetScheduledChanges(ctx, 10))
+
+	// changes scheduled at a past height are applied right away
+	tp = params.NewScheduledParameterChangeProposal("Test", "description",
+		[]params.ParamChange{params.NewParamChange(testSubspace, keyMaxValidators, "", "2")}, 3)
+	require.NoError(t, hdlr(ctx, tp))
+	ss.Get(ctx, []byte(keyMaxValidators), &param)
+	require.Equal(t, uint16(2), param)
+}
@@ -178,8 +178,8 @@ func (s Subspace) Set(ctx sdk.Context, key []byte, param interface{}) {
 }
 
 // SetRaw stores raw parameter bytes. It returns error if the stored parameter
-// has a different type from the input. It also sets to the transient store to
-// record change.
+// has a different type from the input or is rejected by the validator registered
+// for the key. It also sets to the transient store to record change.
 func (s Subspace) SetRaw(ctx sdk.Context, key []byte, param []byte) error {
 	attr, ok := s.table.m[string(key)]
 	if !ok {
@@ -193,6 +193,13 @@ func (s Subspace) SetRaw(ctx sdk.Context, key []byte, param []byte) error {
 		return err
 	}
 
+	if attr.validate != nil {
+		err = attr.validate(reflect.ValueOf(dest).Elem().Interface())
+		if err != nil {
+			return err
+		}
+	}
+
 	store := s.kvStore(ctx)
 	store.Set(key, param)
 	tStore := s.transientStore(ctx)
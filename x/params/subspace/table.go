@@ -5,7 +5,8 @@ import (
 )
 
 type attribute struct {
-	ty reflect.Type
+	ty       reflect.Type
+	validate func(value interface{}) error
 }
 
 // KeyTable subspaces appropriate type for each parameter key
@@ -68,6 +69,24 @@ func (t KeyTable) RegisterType(key []byte, ty interface{}) KeyTable {
 	return t
 }
 
+// Register a validator run on the values set through SetRaw for the key,
+// eg. by parameter change proposals
+func (t KeyTable) RegisterValidator(key []byte, validate func(value interface{}) error) KeyTable {
+	keystr := string(key)
+	attr, ok := t.m[keystr]
+	if !ok {
+		panic("parameter not registered")
+	}
+	if validate == nil {
+		panic("cannot register nil validator")
+	}
+
+	attr.validate = validate
+	t.m[keystr] = attr
+
+	return t
+}
+
 // Register multiple pairs from ParamSet
 func (t KeyTable) RegisterParamSet(ps ParamSet) KeyTable {
 	for _, kvp := range ps.ParamSetPairs() {
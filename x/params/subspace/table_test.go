@@ -30,6 +30,11 @@ func TestKeyTable(t *testing.T) {
 	require.NotPanics(t, func() { table.RegisterType([]byte("world"), int64(0)) })
 	require.Panics(t, func() { table.RegisterType([]byte("hello"), bool(false)) })
 
+	validate := func(value interface{}) error { return nil }
+	require.Panics(t, func() { table.RegisterValidator([]byte("unknown"), validate) })
+	require.Panics(t, func() { table.RegisterValidator([]byte("hello"), nil) })
+	require.NotPanics(t, func() { table.RegisterValidator([]byte("hello"), validate) })
+
 	require.NotPanics(t, func() { table.RegisterParamSet(&testparams{}) })
 	require.Panics(t, func() { table.RegisterParamSet(&testparams{}) })
 }
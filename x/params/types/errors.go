@@ -13,6 +13,7 @@ const (
 	CodeUnknownSubspace  sdk.CodeType = 1
 	CodeSettingParameter sdk.CodeType = 2
 	CodeEmptyData        sdk.CodeType = 3
+	CodeInvalidHeight    sdk.CodeType = 4
 )
 
 // ErrUnknownSubspace returns an unknown subspace error.
@@ -44,3 +45,9 @@ func ErrEmptyKey(codespace sdk.CodespaceType) sdk.Error {
 func ErrEmptyValue(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeEmptyData, "parameter value is empty")
 }
+
+// ErrInvalidHeight returns an error for an invalid height of scheduled
+// parameter changes.
+func ErrInvalidHeight(codespace sdk.CodespaceType, height int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidHeight, fmt.Sprintf("invalid parameter change height %d", height))
+}
@@ -1,5 +1,9 @@
 package types
 
+import (
+	"encoding/binary"
+)
+
 const (
 	// ModuleKey defines the name of the module
 	ModuleName = "params"
@@ -7,3 +11,16 @@ const (
 	// RouterKey defines the routing key for a ParameterChangeProposal
 	RouterKey = "params"
 )
+
+// ScheduledChangesKey prefixes the parameter changes scheduled for a future
+// height. Subspace names cannot be empty so that the key cannot collide with
+// the "<subspace>/" prefix of the parameters.
+var ScheduledChangesKey = []byte{0x00}
+
+// GetScheduledChangesKey gets the key of the parameter changes scheduled for
+// the given height
+func GetScheduledChangesKey(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return append(ScheduledChangesKey, bz...)
+}
@@ -22,15 +22,25 @@ func init() {
 }
 
 // ParameterChangeProposal defines a proposal which contains multiple parameter
-// changes.
+// changes. The changes are applied when the proposal passes, or at the
+// beginning of the block at Height if it is in the future by then.
 type ParameterChangeProposal struct {
 	Title       string        `json:"title"`
 	Description string        `json:"description"`
 	Changes     []ParamChange `json:"changes"`
+	Height      int64         `json:"height,omitempty"`
 }
 
 func NewParameterChangeProposal(title, description string, changes []ParamChange) ParameterChangeProposal {
-	return ParameterChangeProposal{title, description, changes}
+	return ParameterChangeProposal{title, description, changes, 0}
+}
+
+// NewScheduledParameterChangeProposal creates a parameter change proposal
+// whose changes are applied from the given height on.
+func NewScheduledParameterChangeProposal(
+	title, description string, changes []ParamChange, height int64,
+) ParameterChangeProposal {
+	return ParameterChangeProposal{title, description, changes, height}
 }
 
 // GetTitle returns the title of a parameter change proposal.
@@ -51,6 +61,9 @@ func (pcp ParameterChangeProposal) ValidateBasic() sdk.Error {
 	if err != nil {
 		return err
 	}
+	if pcp.Height < 0 {
+		return ErrInvalidHeight(DefaultCodespace, pcp.Height)
+	}
 
 	return ValidateChanges(pcp.Changes)
 }
@@ -62,8 +75,9 @@ func (pcp ParameterChangeProposal) String() string {
 	b.WriteString(fmt.Sprintf(`Parameter Change Proposal:
   Title:       %s
   Description: %s
+  Height:      %d
   Changes:
-`, pcp.Title, pcp.Description))
+`, pcp.Title, pcp.Description, pcp.Height))
 
 	for _, pc := range pcp.Changes {
 		b.WriteString(fmt.Sprintf(`    Param Change:
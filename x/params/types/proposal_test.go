@@ -29,3 +29,14 @@ func TestParameterChangeProposal(t *testing.T) {
 	pcp = NewParameterChangeProposal("test title", "test description", []ParamChange{pc5})
 	require.Error(t, pcp.ValidateBasic())
 }
+
+func TestScheduledParameterChangeProposal(t *testing.T) {
+	pc := NewParamChange("sub", "foo", "", "baz")
+
+	pcp := NewScheduledParameterChangeProposal("test title", "test description", []ParamChange{pc}, 10)
+	require.Equal(t, int64(10), pcp.Height)
+	require.Nil(t, pcp.ValidateBasic())
+
+	pcp = NewScheduledParameterChangeProposal("test title", "test description", []ParamChange{pc}, -1)
+	require.Error(t, pcp.ValidateBasic())
+}
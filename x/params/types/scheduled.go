@@ -0,0 +1,33 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ScheduledChanges defines the parameter changes of passed proposals which
+// are applied at the beginning of the block at Height.
+type ScheduledChanges struct {
+	Height  int64         `json:"height"`
+	Changes []ParamChange `json:"changes"`
+}
+
+// NewScheduledChanges creates a new ScheduledChanges object
+func NewScheduledChanges(height int64, changes []ParamChange) ScheduledChanges {
+	return ScheduledChanges{
+		Height:  height,
+		Changes: changes,
+	}
+}
+
+// String implements the Stringer interface.
+func (sc ScheduledChanges) String() string {
+	var b strings.Builder
+
+	b.WriteString(fmt.Sprintf("Scheduled Changes:\n  Height: %d\n", sc.Height))
+	for _, pc := range sc.Changes {
+		b.WriteString(pc.String())
+	}
+
+	return b.String()
+}
@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/performance"
+)
+
+const flagNumLimit = "limit"
+
+// GetCmdQueryValidator implements the query validator performance command.
+func GetCmdQueryValidator(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the performance of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the uptime over the signed blocks window, the number of slashes and
+the participation in the proposals currently in their voting period of a validator.
+
+Example:
+$ %s query performance validator cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			numLimit := uint64(viper.GetInt64(flagNumLimit))
+			bz, err := cdc.MarshalJSON(performance.NewQueryValidatorParams(valAddr, numLimit))
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, performance.QueryValidator), bz)
+			if err != nil {
+				return err
+			}
+
+			var vp performance.ValidatorPerformance
+			cdc.MustUnmarshalJSON(res, &vp)
+			return cliCtx.PrintOutput(vp)
+		},
+	}
+
+	cmd.Flags().String(flagNumLimit, "", fmt.Sprintf(
+		"(optional) limit the governance participation to the latest [number] proposals, at most %d",
+		performance.MaxLatestProposals))
+	return cmd
+}
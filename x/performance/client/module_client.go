@@ -0,0 +1,47 @@
+package client
+
+import (
+	"github.com/spf13/cobra"
+	amino "github.com/tendermint/go-amino"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/x/performance"
+	"github.com/cosmos/cosmos-sdk/x/performance/client/cli"
+)
+
+// ModuleClient exports all client functionality from this module
+type ModuleClient struct {
+	queryRoute string
+	cdc        *amino.Codec
+}
+
+// NewModuleClient creates a new ModuleClient object
+func NewModuleClient(queryRoute string, cdc *amino.Codec) ModuleClient {
+	return ModuleClient{
+		queryRoute: queryRoute,
+		cdc:        cdc,
+	}
+}
+
+// GetQueryCmd returns the cli query commands for this module
+func (mc ModuleClient) GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:   performance.ModuleName,
+		Short: "Querying commands for the performance module",
+	}
+
+	queryCmd.AddCommand(client.GetCommands(
+		cli.GetCmdQueryValidator(mc.queryRoute, mc.cdc),
+	)...)
+	return queryCmd
+}
+
+// GetTxCmd returns the transaction commands for this module
+func (mc ModuleClient) GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:   performance.ModuleName,
+		Short: "Performance transactions subcommands",
+	}
+
+	return txCmd
+}
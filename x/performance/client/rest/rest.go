@@ -0,0 +1,58 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/performance"
+)
+
+// REST variable names
+const (
+	RestValidatorAddr = "validatorAddr"
+	RestNumLimit      = "limit"
+)
+
+// RegisterRoutes registers performance-related REST handlers to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
+	r.HandleFunc(fmt.Sprintf("/performance/validators/{%s}", RestValidatorAddr), queryValidatorHandlerFn(cdc, cliCtx)).Methods("GET")
+}
+
+func queryValidatorHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		valAddr, err := sdk.ValAddressFromBech32(mux.Vars(r)[RestValidatorAddr])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var numLimit uint64
+		if strNumLimit := r.URL.Query().Get(RestNumLimit); len(strNumLimit) != 0 {
+			var ok bool
+			numLimit, ok = rest.ParseUint64OrReturnBadRequest(w, strNumLimit)
+			if !ok {
+				return
+			}
+		}
+
+		bz, err := cdc.MarshalJSON(performance.NewQueryValidatorParams(valAddr, numLimit))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", performance.QuerierRoute, performance.QueryValidator), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
@@ -0,0 +1,31 @@
+package performance
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+)
+
+// StakingKeeper expected staking keeper
+type StakingKeeper interface {
+	Validator(ctx sdk.Context, address sdk.ValAddress) sdk.Validator
+}
+
+// SlashingKeeper expected slashing keeper
+type SlashingKeeper interface {
+	GetValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress) (slashing.ValidatorSigningInfo, bool)
+	SignedBlocksWindow(ctx sdk.Context) int64
+}
+
+// DistrKeeper expected distribution keeper
+type DistrKeeper interface {
+	IterateValidatorSlashEventsBetween(ctx sdk.Context, val sdk.ValAddress, startingHeight uint64, endingHeight uint64,
+		handler func(height uint64, event distr.ValidatorSlashEvent) (stop bool))
+}
+
+// GovKeeper expected governance keeper
+type GovKeeper interface {
+	GetProposalsFiltered(ctx sdk.Context, voterAddr sdk.AccAddress, depositorAddr sdk.AccAddress,
+		status gov.ProposalStatus, numLatest uint64) []gov.Proposal
+}
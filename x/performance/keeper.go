@@ -0,0 +1,96 @@
+package performance
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+// MaxLatestProposals is the maximum number of the latest proposals iterated
+// over to compute the governance participation of a validator
+const MaxLatestProposals uint64 = 100
+
+// Keeper of the performance module, it has no store of its own and only
+// reads from the staking, slashing, distribution and governance keepers
+type Keeper struct {
+	cdc            *codec.Codec
+	stakingKeeper  StakingKeeper
+	slashingKeeper SlashingKeeper
+	distrKeeper    DistrKeeper
+	govKeeper      GovKeeper
+}
+
+// NewKeeper creates a new performance Keeper instance
+func NewKeeper(cdc *codec.Codec, sk StakingKeeper, slk SlashingKeeper, dk DistrKeeper, gk GovKeeper) Keeper {
+	return Keeper{
+		cdc:            cdc,
+		stakingKeeper:  sk,
+		slashingKeeper: slk,
+		distrKeeper:    dk,
+		govKeeper:      gk,
+	}
+}
+
+// GetValidatorPerformance computes the performance of a validator, the
+// governance participation is computed over at most numLatestProposals of
+// the latest proposals, or MaxLatestProposals if zero
+func (k Keeper) GetValidatorPerformance(ctx sdk.Context, valAddr sdk.ValAddress,
+	numLatestProposals uint64) (ValidatorPerformance, sdk.Error) {
+
+	validator := k.stakingKeeper.Validator(ctx, valAddr)
+	if validator == nil {
+		return ValidatorPerformance{}, staking.ErrNoValidatorFound(staking.DefaultCodespace)
+	}
+
+	consAddr := validator.GetConsAddr()
+	info, found := k.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		return ValidatorPerformance{}, slashing.ErrNoSigningInfoFound(slashing.DefaultCodespace, consAddr)
+	}
+
+	// uptime over the blocks of the signed blocks window
+	windowBlocks := info.IndexOffset
+	if window := k.slashingKeeper.SignedBlocksWindow(ctx); windowBlocks > window {
+		windowBlocks = window
+	}
+	signedBlocks := windowBlocks - info.MissedBlocksCounter
+	uptime := sdk.OneDec()
+	if windowBlocks > 0 {
+		uptime = sdk.NewDec(signedBlocks).QuoInt64(windowBlocks)
+	}
+
+	var slashes uint64
+	k.distrKeeper.IterateValidatorSlashEventsBetween(ctx, valAddr, 0, uint64(ctx.BlockHeight()),
+		func(_ uint64, _ distr.ValidatorSlashEvent) (stop bool) {
+			slashes++
+			return false
+		},
+	)
+
+	// validators vote with the account of their operator address
+	if numLatestProposals == 0 || numLatestProposals > MaxLatestProposals {
+		numLatestProposals = MaxLatestProposals
+	}
+	active := k.govKeeper.GetProposalsFiltered(ctx, nil, nil, gov.StatusVotingPeriod, numLatestProposals)
+	voted := k.govKeeper.GetProposalsFiltered(ctx, sdk.AccAddress(valAddr), nil, gov.StatusVotingPeriod, numLatestProposals)
+	participation := sdk.ZeroDec()
+	if len(active) > 0 {
+		participation = sdk.NewDec(int64(len(voted))).QuoInt64(int64(len(active)))
+	}
+
+	return ValidatorPerformance{
+		ValidatorAddress: valAddr,
+		Jailed:           validator.IsJailed(),
+		Tombstoned:       info.Tombstoned,
+		SignedBlocks:     signedBlocks,
+		WindowBlocks:     windowBlocks,
+		Uptime:           uptime,
+		Slashes:          slashes,
+		VotedProposals:   uint64(len(voted)),
+		ActiveProposals:  uint64(len(active)),
+		GovParticipation: participation,
+	}, nil
+}
@@ -0,0 +1,75 @@
+package performance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+)
+
+func TestGetValidatorPerformance(t *testing.T) {
+	ctx, keeper, slk, dk, gk := createTestInput(t, 500)
+
+	// unknown validator and missing signing info
+	_, err := keeper.GetValidatorPerformance(ctx, valAddr2, 0)
+	require.NotNil(t, err)
+	_, err = keeper.GetValidatorPerformance(ctx, valAddr, 0)
+	require.NotNil(t, err)
+
+	// no blocks counted yet, no slashes and no active proposals
+	slk.infos[consAddr.String()] = slashing.NewValidatorSigningInfo(consAddr, 500, 0, time.Unix(0, 0), false, 0)
+	performance, err := keeper.GetValidatorPerformance(ctx, valAddr, 0)
+	require.Nil(t, err)
+	require.Equal(t, sdk.OneDec(), performance.Uptime)
+	require.Equal(t, uint64(0), performance.Slashes)
+	require.Equal(t, sdk.ZeroDec(), performance.GovParticipation)
+
+	// 10 blocks missed over the 100 blocks of the window
+	slk.infos[consAddr.String()] = slashing.NewValidatorSigningInfo(consAddr, 0, 500, time.Unix(0, 0), false, 10)
+	dk.slashes[valAddr.String()] = []uint64{100, 200, 600}
+	gk.active = []gov.Proposal{{}, {}, {}, {}}
+	gk.voted[sdk.AccAddress(valAddr).String()] = []gov.Proposal{{}}
+
+	performance, err = keeper.GetValidatorPerformance(ctx, valAddr, 0)
+	require.Nil(t, err)
+	require.Equal(t, int64(100), performance.WindowBlocks)
+	require.Equal(t, int64(90), performance.SignedBlocks)
+	require.Equal(t, sdk.NewDecWithPrec(9, 1), performance.Uptime)
+	require.Equal(t, uint64(2), performance.Slashes)
+	require.Equal(t, uint64(1), performance.VotedProposals)
+	require.Equal(t, uint64(4), performance.ActiveProposals)
+	require.Equal(t, sdk.NewDecWithPrec(25, 2), performance.GovParticipation)
+
+	// participation over the latest two proposals only
+	performance, err = keeper.GetValidatorPerformance(ctx, valAddr, 2)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), performance.GovParticipation)
+}
+
+func TestQueryValidator(t *testing.T) {
+	ctx, keeper, slk, _, _ := createTestInput(t, 10)
+	querier := NewQuerier(keeper)
+
+	slk.infos[consAddr.String()] = slashing.NewValidatorSigningInfo(consAddr, 0, 10, time.Unix(0, 0), false, 1)
+
+	query := abci.RequestQuery{
+		Path: "",
+		Data: keeper.cdc.MustMarshalJSON(NewQueryValidatorParams(valAddr, 0)),
+	}
+	bz, err := querier(ctx, []string{QueryValidator}, query)
+	require.Nil(t, err)
+
+	var performance ValidatorPerformance
+	require.NoError(t, keeper.cdc.UnmarshalJSON(bz, &performance))
+	require.Equal(t, valAddr, performance.ValidatorAddress)
+	require.Equal(t, sdk.NewDecWithPrec(9, 1), performance.Uptime)
+
+	_, err = querier(ctx, []string{"unknown"}, query)
+	require.NotNil(t, err)
+}
@@ -0,0 +1,9 @@
+package performance
+
+const (
+	// ModuleName is the name of the performance module
+	ModuleName = "performance"
+
+	// QuerierRoute is the querier route for the performance module
+	QuerierRoute = ModuleName
+)
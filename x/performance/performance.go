@@ -0,0 +1,38 @@
+package performance
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidatorPerformance aggregates the uptime, slashing history and governance
+// participation of a validator.
+//
+// NOTE: votes are pruned once a proposal is tallied, so the governance
+// participation only covers the proposals currently in their voting period.
+type ValidatorPerformance struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address"`
+	Jailed           bool           `json:"jailed"`
+	Tombstoned       bool           `json:"tombstoned"`
+	SignedBlocks     int64          `json:"signed_blocks"`    // blocks signed within the window
+	WindowBlocks     int64          `json:"window_blocks"`    // blocks counted within the signed blocks window
+	Uptime           sdk.Dec        `json:"uptime"`           // fraction of the window blocks signed
+	Slashes          uint64         `json:"slashes"`          // number of times the validator was slashed
+	VotedProposals   uint64         `json:"voted_proposals"`  // active proposals the validator voted on
+	ActiveProposals  uint64         `json:"active_proposals"` // proposals in their voting period
+	GovParticipation sdk.Dec        `json:"gov_participation"`
+}
+
+// String implements the Stringer interface for ValidatorPerformance
+func (vp ValidatorPerformance) String() string {
+	return fmt.Sprintf(`Validator Performance:
+  Validator:          %s
+  Jailed:             %v
+  Tombstoned:         %v
+  Uptime:             %s (%d/%d blocks)
+  Slashes:            %d
+  Gov Participation:  %s (%d/%d proposals)`,
+		vp.ValidatorAddress, vp.Jailed, vp.Tombstoned, vp.Uptime, vp.SignedBlocks, vp.WindowBlocks,
+		vp.Slashes, vp.GovParticipation, vp.VotedProposals, vp.ActiveProposals)
+}
@@ -0,0 +1,59 @@
+package performance
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// query endpoints supported by the performance Querier
+const (
+	QueryValidator = "validator"
+)
+
+// NewQuerier creates a querier for performance REST endpoints
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QueryValidator:
+			return queryValidator(ctx, req, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown performance query endpoint")
+		}
+	}
+}
+
+// QueryValidatorParams defines the params for the following queries:
+// - 'custom/performance/validator'
+type QueryValidatorParams struct {
+	ValidatorAddr      sdk.ValAddress
+	NumLatestProposals uint64
+}
+
+// NewQueryValidatorParams creates a new instance of QueryValidatorParams
+func NewQueryValidatorParams(validatorAddr sdk.ValAddress, numLatestProposals uint64) QueryValidatorParams {
+	return QueryValidatorParams{
+		ValidatorAddr:      validatorAddr,
+		NumLatestProposals: numLatestProposals,
+	}
+}
+
+func queryValidator(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryValidatorParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	performance, sdkErr := k.GetValidatorPerformance(ctx, params.ValidatorAddr, params.NumLatestProposals)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, performance)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -0,0 +1,112 @@
+// nolint
+package performance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+var (
+	valPk    = ed25519.GenPrivKey().PubKey()
+	valAddr  = sdk.ValAddress(valPk.Address())
+	valAddr2 = sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	consAddr = sdk.ConsAddress(valPk.Address())
+)
+
+// mockStakingKeeper returns validators from an in-memory map
+type mockStakingKeeper struct {
+	validators map[string]sdk.Validator
+}
+
+func (sk mockStakingKeeper) Validator(_ sdk.Context, address sdk.ValAddress) sdk.Validator {
+	validator, ok := sk.validators[address.String()]
+	if !ok {
+		return nil
+	}
+	return validator
+}
+
+// mockSlashingKeeper returns signing infos from an in-memory map
+type mockSlashingKeeper struct {
+	window int64
+	infos  map[string]slashing.ValidatorSigningInfo
+}
+
+func (slk *mockSlashingKeeper) GetValidatorSigningInfo(_ sdk.Context, address sdk.ConsAddress) (slashing.ValidatorSigningInfo, bool) {
+	info, ok := slk.infos[address.String()]
+	return info, ok
+}
+
+func (slk *mockSlashingKeeper) SignedBlocksWindow(_ sdk.Context) int64 {
+	return slk.window
+}
+
+// mockDistrKeeper returns slash events from an in-memory map of heights
+type mockDistrKeeper struct {
+	slashes map[string][]uint64
+}
+
+func (dk *mockDistrKeeper) IterateValidatorSlashEventsBetween(_ sdk.Context, val sdk.ValAddress, startingHeight uint64,
+	endingHeight uint64, handler func(height uint64, event distr.ValidatorSlashEvent) (stop bool)) {
+	for _, height := range dk.slashes[val.String()] {
+		if height < startingHeight || height > endingHeight {
+			continue
+		}
+		if handler(height, distr.ValidatorSlashEvent{}) {
+			break
+		}
+	}
+}
+
+// mockGovKeeper returns the active proposals, or the ones voted by a voter
+type mockGovKeeper struct {
+	active []gov.Proposal
+	voted  map[string][]gov.Proposal
+}
+
+func (gk *mockGovKeeper) GetProposalsFiltered(_ sdk.Context, voterAddr sdk.AccAddress, _ sdk.AccAddress,
+	_ gov.ProposalStatus, numLatest uint64) []gov.Proposal {
+	proposals := gk.active
+	if len(voterAddr) != 0 {
+		proposals = gk.voted[voterAddr.String()]
+	}
+	if uint64(len(proposals)) > numLatest {
+		proposals = proposals[uint64(len(proposals))-numLatest:]
+	}
+	return proposals
+}
+
+func createTestInput(t *testing.T, height int64) (sdk.Context, Keeper, *mockSlashingKeeper, *mockDistrKeeper, *mockGovKeeper) {
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "performance-chain", Height: height}, false, log.NewNopLogger())
+
+	sk := mockStakingKeeper{
+		validators: map[string]sdk.Validator{
+			valAddr.String(): staking.NewValidator(valAddr, valPk, staking.Description{}),
+		},
+	}
+	slk := &mockSlashingKeeper{
+		window: 100,
+		infos:  make(map[string]slashing.ValidatorSigningInfo),
+	}
+	dk := &mockDistrKeeper{slashes: make(map[string][]uint64)}
+	gk := &mockGovKeeper{voted: make(map[string][]gov.Proposal)}
+
+	return ctx, NewKeeper(codec.New(), sk, slk, dk, gk), slk, dk, gk
+}
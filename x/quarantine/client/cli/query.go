@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/quarantine"
+)
+
+// GetCmdQueryIsQuarantined implements the query is-quarantined command.
+func GetCmdQueryIsQuarantined(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "is-quarantined [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query whether an account requires the acceptance of incoming transfers",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether an account opted in to require the explicit acceptance of
+incoming transfers.
+
+Example:
+$ %s query quarantine is-quarantined cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := accountQueryParams(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, quarantine.QueryIsQuarantined), bz)
+			if err != nil {
+				return err
+			}
+
+			var quarantined bool
+			cdc.MustUnmarshalJSON(res, &quarantined)
+			return cliCtx.PrintOutput(quarantined)
+		},
+	}
+}
+
+// GetCmdQueryFunds implements the query quarantined funds command.
+func GetCmdQueryFunds(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "funds [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the funds quarantined for an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the funds held in escrow for an account until it accepts or
+declines them, per sender.
+
+Example:
+$ %s query quarantine funds cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := accountQueryParams(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, quarantine.QueryFunds), bz)
+			if err != nil {
+				return err
+			}
+
+			var funds quarantine.QuarantinedFundsList
+			cdc.MustUnmarshalJSON(res, &funds)
+			return cliCtx.PrintOutput(funds)
+		},
+	}
+}
+
+func accountQueryParams(cdc *codec.Codec, arg string) ([]byte, error) {
+	addr, err := sdk.AccAddressFromBech32(arg)
+	if err != nil {
+		return nil, err
+	}
+
+	return cdc.MarshalJSON(quarantine.NewQueryAccountParams(addr))
+}
@@ -0,0 +1,141 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	"github.com/cosmos/cosmos-sdk/x/quarantine"
+)
+
+// GetCmdOptIn implements the command for an account to require the explicit
+// acceptance of incoming transfers.
+func GetCmdOptIn(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "opt-in",
+		Args:  cobra.NoArgs,
+		Short: "Require the explicit acceptance of incoming transfers",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Opt in to hold the coins sent to your account in escrow until you accept
+or decline them.
+
+Example:
+$ %s tx quarantine opt-in --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return sendMsg(cdc, func(from sdk.AccAddress) sdk.Msg {
+				return quarantine.NewMsgOptIn(from)
+			})
+		},
+	}
+}
+
+// GetCmdOptOut implements the command for an account to stop requiring the
+// explicit acceptance of incoming transfers.
+func GetCmdOptOut(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "opt-out",
+		Args:  cobra.NoArgs,
+		Short: "Stop requiring the explicit acceptance of incoming transfers",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Opt out of holding the coins sent to your account in escrow. The coins
+already held in escrow must still be accepted or declined.
+
+Example:
+$ %s tx quarantine opt-out --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return sendMsg(cdc, func(from sdk.AccAddress) sdk.Msg {
+				return quarantine.NewMsgOptOut(from)
+			})
+		},
+	}
+}
+
+// GetCmdAccept implements the command to accept the funds quarantined for an
+// account from a sender.
+func GetCmdAccept(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "accept [from-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Accept the funds held in escrow for your account from a sender",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Accept all the coins sent to your account by a sender and held in escrow.
+
+Example:
+$ %s tx quarantine accept cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fromAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			return sendMsg(cdc, func(to sdk.AccAddress) sdk.Msg {
+				return quarantine.NewMsgAccept(to, fromAddr)
+			})
+		},
+	}
+}
+
+// GetCmdDecline implements the command to decline the funds quarantined for
+// an account from a sender.
+func GetCmdDecline(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "decline [from-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Decline the funds held in escrow for your account from a sender",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Decline all the coins sent to your account by a sender and held in escrow,
+returning them to the sender.
+
+Example:
+$ %s tx quarantine decline cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fromAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			return sendMsg(cdc, func(to sdk.AccAddress) sdk.Msg {
+				return quarantine.NewMsgDecline(to, fromAddr)
+			})
+		},
+	}
+}
+
+// sendMsg builds the message signed by the --from account with the given
+// function, validates it and generates or broadcasts the transaction
+func sendMsg(cdc *codec.Codec, newMsg func(signer sdk.AccAddress) sdk.Msg) error {
+	txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+	cliCtx := context.NewCLIContext().
+		WithCodec(cdc).
+		WithAccountDecoder(cdc)
+
+	msg := newMsg(cliCtx.GetFromAddress())
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"github.com/spf13/cobra"
+	amino "github.com/tendermint/go-amino"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/x/quarantine"
+	"github.com/cosmos/cosmos-sdk/x/quarantine/client/cli"
+)
+
+// ModuleClient exports all client functionality from this module
+type ModuleClient struct {
+	storeKey string
+	cdc      *amino.Codec
+}
+
+// NewModuleClient creates a new ModuleClient object
+func NewModuleClient(storeKey string, cdc *amino.Codec) ModuleClient {
+	return ModuleClient{
+		storeKey: storeKey,
+		cdc:      cdc,
+	}
+}
+
+// GetQueryCmd returns the cli query commands for this module
+func (mc ModuleClient) GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:   quarantine.ModuleName,
+		Short: "Querying commands for the quarantine module",
+	}
+
+	queryCmd.AddCommand(client.GetCommands(
+		cli.GetCmdQueryIsQuarantined(mc.storeKey, mc.cdc),
+		cli.GetCmdQueryFunds(mc.storeKey, mc.cdc),
+	)...)
+	return queryCmd
+}
+
+// GetTxCmd returns the transaction commands for this module
+func (mc ModuleClient) GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:   quarantine.ModuleName,
+		Short: "Quarantine transactions subcommands",
+	}
+
+	txCmd.AddCommand(client.PostCommands(
+		cli.GetCmdOptIn(mc.cdc),
+		cli.GetCmdOptOut(mc.cdc),
+		cli.GetCmdAccept(mc.cdc),
+		cli.GetCmdDecline(mc.cdc),
+	)...)
+	return txCmd
+}
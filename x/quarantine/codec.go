@@ -0,0 +1,23 @@
+package quarantine
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// Register concrete types on codec codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgOptIn{}, "cosmos-sdk/MsgQuarantineOptIn", nil)
+	cdc.RegisterConcrete(MsgOptOut{}, "cosmos-sdk/MsgQuarantineOptOut", nil)
+	cdc.RegisterConcrete(MsgAccept{}, "cosmos-sdk/MsgQuarantineAccept", nil)
+	cdc.RegisterConcrete(MsgDecline{}, "cosmos-sdk/MsgQuarantineDecline", nil)
+}
+
+// generic sealed codec to be used throughout module
+var moduleCdc *codec.Codec
+
+func init() {
+	cdc := codec.New()
+	RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	moduleCdc = cdc.Seal()
+}
@@ -0,0 +1,28 @@
+package quarantine
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeNoQuarantinedFunds sdk.CodeType = 1
+	CodeUnknownSender      sdk.CodeType = 2
+)
+
+// ErrNoQuarantinedFunds returns an error for an accept or decline without any
+// funds quarantined for the recipient from the sender
+func ErrNoQuarantinedFunds(codespace sdk.CodespaceType, recipient, sender sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeNoQuarantinedFunds,
+		fmt.Sprintf("no funds quarantined for %s from %s", recipient, sender))
+}
+
+// ErrUnknownSender returns an error for a transfer to a quarantined
+// account whose sender is not known, eg. a transfer with several inputs
+func ErrUnknownSender(codespace sdk.CodespaceType, recipient sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownSender,
+		fmt.Sprintf("transfers to quarantined account %s must have a single sender", recipient))
+}
@@ -0,0 +1,87 @@
+package quarantine
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - quarantine genesis state
+type GenesisState struct {
+	QuarantinedAccounts []sdk.AccAddress     `json:"quarantined_accounts"`
+	QuarantinedFunds    QuarantinedFundsList `json:"quarantined_funds"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(accounts []sdk.AccAddress, funds QuarantinedFundsList) GenesisState {
+	return GenesisState{
+		QuarantinedAccounts: accounts,
+		QuarantinedFunds:    funds,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		QuarantinedAccounts: []sdk.AccAddress{},
+		QuarantinedFunds:    QuarantinedFundsList{},
+	}
+}
+
+// InitGenesis sets the quarantined accounts and funds from a genesis state.
+// The quarantined coins must be held by the escrow address.
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	for _, addr := range data.QuarantinedAccounts {
+		k.SetQuarantined(ctx, addr, true)
+	}
+	for _, funds := range data.QuarantinedFunds {
+		k.SetQuarantinedFunds(ctx, funds)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	accounts := []sdk.AccAddress{}
+	k.IterateQuarantinedAccounts(ctx, func(addr sdk.AccAddress) bool {
+		accounts = append(accounts, addr)
+		return false
+	})
+
+	funds := QuarantinedFundsList{}
+	k.IterateQuarantinedFunds(ctx, func(qf QuarantinedFunds) bool {
+		funds = append(funds, qf)
+		return false
+	})
+
+	return NewGenesisState(accounts, funds)
+}
+
+// ValidateGenesis performs basic validation of the quarantine genesis data
+// returning an error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	seenAccounts := make(map[string]bool)
+	for _, addr := range data.QuarantinedAccounts {
+		if addr.Empty() {
+			return fmt.Errorf("quarantined account address cannot be empty")
+		}
+		if seenAccounts[addr.String()] {
+			return fmt.Errorf("duplicate quarantined account %s", addr)
+		}
+		seenAccounts[addr.String()] = true
+	}
+
+	seenFunds := make(map[string]bool)
+	for _, funds := range data.QuarantinedFunds {
+		if err := funds.Validate(); err != nil {
+			return err
+		}
+
+		key := string(GetQuarantinedFundsKey(funds.Recipient, funds.Sender))
+		if seenFunds[key] {
+			return fmt.Errorf("duplicate funds quarantined for %s from %s", funds.Recipient, funds.Sender)
+		}
+		seenFunds[key] = true
+	}
+
+	return nil
+}
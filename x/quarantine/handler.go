@@ -0,0 +1,87 @@
+package quarantine
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/quarantine/tags"
+)
+
+// NewHandler returns a handler for "quarantine" type messages
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case MsgOptIn:
+			return handleMsgOptIn(ctx, k, msg)
+
+		case MsgOptOut:
+			return handleMsgOptOut(ctx, k, msg)
+
+		case MsgAccept:
+			return handleMsgAccept(ctx, k, msg)
+
+		case MsgDecline:
+			return handleMsgDecline(ctx, k, msg)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized quarantine message type: %T", msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgOptIn(ctx sdk.Context, k Keeper, msg MsgOptIn) sdk.Result {
+	k.SetQuarantined(ctx, msg.ToAddress, true)
+
+	resTags := sdk.NewTags(
+		tags.Category, tags.TxCategory,
+		tags.Sender, msg.ToAddress.String(),
+	)
+	return sdk.Result{
+		Tags: resTags,
+	}
+}
+
+func handleMsgOptOut(ctx sdk.Context, k Keeper, msg MsgOptOut) sdk.Result {
+	k.SetQuarantined(ctx, msg.ToAddress, false)
+
+	resTags := sdk.NewTags(
+		tags.Category, tags.TxCategory,
+		tags.Sender, msg.ToAddress.String(),
+	)
+	return sdk.Result{
+		Tags: resTags,
+	}
+}
+
+func handleMsgAccept(ctx sdk.Context, k Keeper, msg MsgAccept) sdk.Result {
+	_, err := k.AcceptQuarantinedFunds(ctx, msg.ToAddress, msg.FromAddress)
+	if err != nil {
+		return err.Result()
+	}
+
+	resTags := sdk.NewTags(
+		tags.Category, tags.TxCategory,
+		tags.Sender, msg.ToAddress.String(),
+		tags.FromAddr, msg.FromAddress.String(),
+	)
+	return sdk.Result{
+		Tags: resTags,
+	}
+}
+
+func handleMsgDecline(ctx sdk.Context, k Keeper, msg MsgDecline) sdk.Result {
+	_, err := k.DeclineQuarantinedFunds(ctx, msg.ToAddress, msg.FromAddress)
+	if err != nil {
+		return err.Result()
+	}
+
+	resTags := sdk.NewTags(
+		tags.Category, tags.TxCategory,
+		tags.Sender, msg.ToAddress.String(),
+		tags.FromAddr, msg.FromAddress.String(),
+	)
+	return sdk.Result{
+		Tags: resTags,
+	}
+}
@@ -0,0 +1,31 @@
+package quarantine
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all quarantine invariants
+func RegisterInvariants(ir sdk.InvariantRouter, k Keeper) {
+	ir.RegisterRoute(ModuleName, "escrow-funds", EscrowFundsInvariant(k))
+}
+
+// EscrowFundsInvariant checks that the escrow address holds at least the sum
+// of all the quarantined funds, coins can be sent to it directly
+func EscrowFundsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		expected := sdk.NewCoins()
+		k.IterateQuarantinedFunds(ctx, func(funds QuarantinedFunds) bool {
+			expected = expected.Add(funds.Coins)
+			return false
+		})
+
+		escrowed := k.bankKeeper.GetCoins(ctx, EscrowAccAddr)
+		if !escrowed.IsAllGTE(expected) {
+			return fmt.Errorf("escrowed coins %s are less than the sum of the quarantined funds %s",
+				escrowed, expected)
+		}
+		return nil
+	}
+}
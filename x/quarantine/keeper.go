@@ -0,0 +1,177 @@
+package quarantine
+
+import (
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper of the quarantine store
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	cdc        *codec.Codec
+	bankKeeper BankKeeper
+	codespace  sdk.CodespaceType
+}
+
+// NewKeeper creates a new quarantine Keeper instance
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, bankKeeper BankKeeper, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:   key,
+		cdc:        cdc,
+		bankKeeper: bankKeeper,
+		codespace:  codespace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+ModuleName)
+}
+
+// IsQuarantined returns true if the account requires the explicit acceptance
+// of incoming transfers
+func (k Keeper) IsQuarantined(ctx sdk.Context, addr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(GetQuarantinedAccountKey(addr))
+}
+
+// SetQuarantined sets whether the account requires the explicit acceptance of
+// incoming transfers. The funds already quarantined for the account stay in
+// escrow until they are accepted or declined.
+func (k Keeper) SetQuarantined(ctx sdk.Context, addr sdk.AccAddress, quarantined bool) {
+	store := ctx.KVStore(k.storeKey)
+	if quarantined {
+		store.Set(GetQuarantinedAccountKey(addr), []byte{0x01})
+	} else {
+		store.Delete(GetQuarantinedAccountKey(addr))
+	}
+}
+
+// IterateQuarantinedAccounts iterates over the quarantined accounts and
+// performs a callback function until it returns true
+func (k Keeper) IterateQuarantinedAccounts(ctx sdk.Context, cb func(addr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, QuarantinedAccountKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		addr := sdk.AccAddress(iterator.Key()[len(QuarantinedAccountKey):])
+		if cb(addr) {
+			break
+		}
+	}
+}
+
+// GetQuarantinedFunds returns the funds quarantined for a recipient from a
+// sender
+func (k Keeper) GetQuarantinedFunds(ctx sdk.Context, recipient, sender sdk.AccAddress) (funds QuarantinedFunds, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetQuarantinedFundsKey(recipient, sender))
+	if bz == nil {
+		return funds, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &funds)
+	return funds, true
+}
+
+// SetQuarantinedFunds sets quarantined funds
+func (k Keeper) SetQuarantinedFunds(ctx sdk.Context, funds QuarantinedFunds) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(funds)
+	store.Set(GetQuarantinedFundsKey(funds.Recipient, funds.Sender), bz)
+}
+
+// DeleteQuarantinedFunds deletes quarantined funds
+func (k Keeper) DeleteQuarantinedFunds(ctx sdk.Context, recipient, sender sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetQuarantinedFundsKey(recipient, sender))
+}
+
+// iterateQuarantinedFunds iterates over the quarantined funds with the given
+// key prefix and performs a callback function until it returns true
+func (k Keeper) iterateQuarantinedFunds(ctx sdk.Context, prefix []byte, cb func(funds QuarantinedFunds) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var funds QuarantinedFunds
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &funds)
+		if cb(funds) {
+			break
+		}
+	}
+}
+
+// IterateQuarantinedFunds iterates over all the quarantined funds and
+// performs a callback function until it returns true
+func (k Keeper) IterateQuarantinedFunds(ctx sdk.Context, cb func(funds QuarantinedFunds) (stop bool)) {
+	k.iterateQuarantinedFunds(ctx, QuarantinedFundsKey, cb)
+}
+
+// GetRecipientQuarantinedFunds returns all the funds quarantined for a
+// recipient
+func (k Keeper) GetRecipientQuarantinedFunds(ctx sdk.Context, recipient sdk.AccAddress) (fundsList QuarantinedFundsList) {
+	k.iterateQuarantinedFunds(ctx, GetQuarantinedFundsRecipientKey(recipient), func(funds QuarantinedFunds) bool {
+		fundsList = append(fundsList, funds)
+		return false
+	})
+	return fundsList
+}
+
+// SendRestriction is the bank send restriction redirecting the transfers to
+// quarantined accounts to the escrow address and recording them as
+// quarantined funds. Transfers from the account itself or from the escrow
+// address are not quarantined. The bank keeper discards the recorded funds if
+// the transfer fails.
+func (k Keeper) SendRestriction(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins,
+) (sdk.AccAddress, sdk.Error) {
+
+	if !k.IsQuarantined(ctx, toAddr) || toAddr.Equals(fromAddr) || fromAddr.Equals(EscrowAccAddr) {
+		return toAddr, nil
+	}
+	if fromAddr.Empty() {
+		return nil, ErrUnknownSender(k.codespace, toAddr)
+	}
+
+	funds, found := k.GetQuarantinedFunds(ctx, toAddr, fromAddr)
+	if !found {
+		funds = NewQuarantinedFunds(toAddr, fromAddr, sdk.NewCoins())
+	}
+	funds.Coins = funds.Coins.Add(amt)
+	k.SetQuarantinedFunds(ctx, funds)
+
+	return EscrowAccAddr, nil
+}
+
+// AcceptQuarantinedFunds sends the funds quarantined for the recipient from
+// the sender to the recipient
+func (k Keeper) AcceptQuarantinedFunds(ctx sdk.Context, recipient, sender sdk.AccAddress) (sdk.Coins, sdk.Error) {
+	return k.releaseQuarantinedFunds(ctx, recipient, sender, recipient)
+}
+
+// DeclineQuarantinedFunds returns the funds quarantined for the recipient
+// from the sender to the sender
+func (k Keeper) DeclineQuarantinedFunds(ctx sdk.Context, recipient, sender sdk.AccAddress) (sdk.Coins, sdk.Error) {
+	return k.releaseQuarantinedFunds(ctx, recipient, sender, sender)
+}
+
+func (k Keeper) releaseQuarantinedFunds(
+	ctx sdk.Context, recipient, sender, releaseAddr sdk.AccAddress,
+) (sdk.Coins, sdk.Error) {
+
+	funds, found := k.GetQuarantinedFunds(ctx, recipient, sender)
+	if !found {
+		return nil, ErrNoQuarantinedFunds(k.codespace, recipient, sender)
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, EscrowAccAddr, releaseAddr, funds.Coins); err != nil {
+		return nil, err
+	}
+
+	k.DeleteQuarantinedFunds(ctx, recipient, sender)
+	return funds.Coins, nil
+}
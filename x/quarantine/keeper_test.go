@@ -0,0 +1,129 @@
+package quarantine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+func TestQuarantineAcceptAndDecline(t *testing.T) {
+	ctx, keeper, bk := createTestInput(t)
+	handler := NewHandler(keeper)
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	// transfers to accounts which did not opt in are not quarantined
+	require.Nil(t, bk.SendCoins(ctx, addr2, addr1, amount))
+	require.True(t, bk.GetCoins(ctx, addr1).IsEqual(initCoins.Add(amount)))
+
+	res := handler(ctx, NewMsgOptIn(addr1))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, keeper.IsQuarantined(ctx, addr1))
+
+	// transfers to a quarantined account are held in escrow per sender
+	require.Nil(t, bk.SendCoins(ctx, addr2, addr1, amount))
+	require.Nil(t, bk.SendCoins(ctx, addr2, addr1, amount))
+	require.Nil(t, bk.SendCoins(ctx, addr3, addr1, amount))
+	require.True(t, bk.GetCoins(ctx, addr1).IsEqual(initCoins.Add(amount)))
+	require.True(t, bk.GetCoins(ctx, EscrowAccAddr).IsEqual(amount.Add(amount).Add(amount)))
+
+	funds := keeper.GetRecipientQuarantinedFunds(ctx, addr1)
+	require.Len(t, funds, 2)
+	require.Nil(t, EscrowFundsInvariant(keeper)(ctx))
+
+	// accepting releases the funds to the recipient
+	res = handler(ctx, NewMsgAccept(addr1, addr2))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, bk.GetCoins(ctx, addr1).IsEqual(initCoins.Add(amount).Add(amount).Add(amount)))
+
+	res = handler(ctx, NewMsgAccept(addr1, addr2))
+	require.Equal(t, CodeNoQuarantinedFunds, res.Code)
+
+	// declining returns the funds to the sender, even after opting out
+	res = handler(ctx, NewMsgOptOut(addr1))
+	require.True(t, res.IsOK(), res.Log)
+	require.False(t, keeper.IsQuarantined(ctx, addr1))
+
+	res = handler(ctx, NewMsgDecline(addr1, addr3))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, bk.GetCoins(ctx, addr3).IsEqual(initCoins))
+	require.True(t, bk.GetCoins(ctx, EscrowAccAddr).Empty())
+	require.Empty(t, keeper.GetRecipientQuarantinedFunds(ctx, addr1))
+}
+
+func TestQuarantineFailedSend(t *testing.T) {
+	ctx, keeper, bk := createTestInput(t)
+	keeper.SetQuarantined(ctx, addr1, true)
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	// a transfer exceeding the sender's balance records no quarantined funds
+	require.NotNil(t, bk.SendCoins(ctx, addr2, addr1, initCoins.Add(amount)))
+	_, found := keeper.GetQuarantinedFunds(ctx, addr1, addr2)
+	require.False(t, found)
+	require.True(t, bk.GetCoins(ctx, EscrowAccAddr).Empty())
+
+	inputs := []bank.Input{bank.NewInput(addr2, initCoins.Add(amount))}
+	outputs := []bank.Output{bank.NewOutput(addr1, initCoins.Add(amount))}
+	_, err := bk.InputOutputCoins(ctx, inputs, outputs)
+	require.NotNil(t, err)
+	_, found = keeper.GetQuarantinedFunds(ctx, addr1, addr2)
+	require.False(t, found)
+
+	// a successful transfer is still recorded
+	require.Nil(t, bk.SendCoins(ctx, addr2, addr1, amount))
+	qf, found := keeper.GetQuarantinedFunds(ctx, addr1, addr2)
+	require.True(t, found)
+	require.True(t, qf.Coins.IsEqual(amount))
+	require.Nil(t, EscrowFundsInvariant(keeper)(ctx))
+}
+
+func TestQuarantineMultiSend(t *testing.T) {
+	ctx, keeper, bk := createTestInput(t)
+	keeper.SetQuarantined(ctx, addr1, true)
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	// a single sender is quarantined like a regular transfer
+	inputs := []bank.Input{bank.NewInput(addr2, amount.Add(amount))}
+	outputs := []bank.Output{bank.NewOutput(addr1, amount), bank.NewOutput(addr3, amount)}
+	_, err := bk.InputOutputCoins(ctx, inputs, outputs)
+	require.Nil(t, err)
+
+	qf, found := keeper.GetQuarantinedFunds(ctx, addr1, addr2)
+	require.True(t, found)
+	require.True(t, qf.Coins.IsEqual(amount))
+
+	// the sender of the funds is unknown with several inputs
+	inputs = []bank.Input{bank.NewInput(addr2, amount), bank.NewInput(addr3, amount)}
+	outputs = []bank.Output{bank.NewOutput(addr1, amount.Add(amount))}
+	_, err = bk.InputOutputCoins(ctx, inputs, outputs)
+	require.NotNil(t, err)
+	require.Equal(t, CodeUnknownSender, err.Code())
+}
+
+func TestEscrowModuleAccount(t *testing.T) {
+	// registering the escrow as a module account blocks sends to it
+	require.Equal(t, auth.NewModuleAddress(EscrowName), EscrowAccAddr)
+}
+
+func TestExportImportGenesis(t *testing.T) {
+	ctx, keeper, bk := createTestInput(t)
+	keeper.SetQuarantined(ctx, addr1, true)
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	require.Nil(t, bk.SendCoins(ctx, addr2, addr1, amount))
+
+	genState := ExportGenesis(ctx, keeper)
+	require.NoError(t, ValidateGenesis(genState))
+	require.Equal(t, []sdk.AccAddress{addr1}, genState.QuarantinedAccounts)
+	require.Equal(t, QuarantinedFundsList{NewQuarantinedFunds(addr1, addr2, amount)}, genState.QuarantinedFunds)
+
+	ctx2, keeper2, _ := createTestInput(t)
+	InitGenesis(ctx2, keeper2, genState)
+	require.Equal(t, genState, ExportGenesis(ctx2, keeper2))
+
+	genState.QuarantinedAccounts = append(genState.QuarantinedAccounts, addr1)
+	require.Error(t, ValidateGenesis(genState))
+	require.NoError(t, ValidateGenesis(DefaultGenesisState()))
+}
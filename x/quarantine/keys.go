@@ -0,0 +1,56 @@
+package quarantine
+
+import (
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "quarantine"
+
+	// StoreKey is the store key string for quarantine
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for quarantine
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for quarantine
+	QuerierRoute = ModuleName
+)
+
+// EscrowName is the name of the module account holding the quarantined funds
+const EscrowName = "quarantineEscrow"
+
+// EscrowAccAddr is the address holding the quarantined funds until they are
+// accepted or declined, the address of the EscrowName module account
+var EscrowAccAddr = sdk.AccAddress(crypto.AddressHash([]byte(EscrowName)))
+
+// Keys for quarantine store
+// Items are stored with the following key: values
+//
+// - 0x00<recipient_Bytes>: []byte{0x01}
+//
+// - 0x01<recipient_Bytes><sender_Bytes>: QuarantinedFunds
+var (
+	QuarantinedAccountKey = []byte{0x00} // prefix for each key to a quarantined account
+	QuarantinedFundsKey   = []byte{0x01} // prefix for each key to quarantined funds
+)
+
+// GetQuarantinedAccountKey returns the store key of a quarantined account
+func GetQuarantinedAccountKey(addr sdk.AccAddress) []byte {
+	return append(QuarantinedAccountKey, addr.Bytes()...)
+}
+
+// GetQuarantinedFundsKey returns the store key of the funds quarantined for a
+// recipient from a sender
+func GetQuarantinedFundsKey(recipient, sender sdk.AccAddress) []byte {
+	return append(GetQuarantinedFundsRecipientKey(recipient), sender.Bytes()...)
+}
+
+// GetQuarantinedFundsRecipientKey returns the prefix of the store keys of all
+// the funds quarantined for a recipient
+func GetQuarantinedFundsRecipientKey(recipient sdk.AccAddress) []byte {
+	return append(QuarantinedFundsKey, recipient.Bytes()...)
+}
@@ -0,0 +1,155 @@
+package quarantine
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ensure Msg interface compliance at compile time
+var (
+	_ sdk.Msg = MsgOptIn{}
+	_ sdk.Msg = MsgOptOut{}
+	_ sdk.Msg = MsgAccept{}
+	_ sdk.Msg = MsgDecline{}
+)
+
+// MsgOptIn - message struct for an account to require the explicit
+// acceptance of incoming transfers
+type MsgOptIn struct {
+	ToAddress sdk.AccAddress `json:"to_address"`
+}
+
+// NewMsgOptIn creates a new MsgOptIn object
+func NewMsgOptIn(toAddr sdk.AccAddress) MsgOptIn {
+	return MsgOptIn{ToAddress: toAddr}
+}
+
+// nolint
+func (msg MsgOptIn) Route() string { return RouterKey }
+func (msg MsgOptIn) Type() string  { return "opt_in" }
+
+// get the bytes for the message signer to sign on
+func (msg MsgOptIn) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.ToAddress} }
+
+// GetSignBytes gets the sign bytes for the msg MsgOptIn
+func (msg MsgOptIn) GetSignBytes() []byte {
+	bz := moduleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgOptIn) ValidateBasic() sdk.Error {
+	if msg.ToAddress.Empty() {
+		return sdk.ErrInvalidAddress(msg.ToAddress.String())
+	}
+	return nil
+}
+
+// MsgOptOut - message struct for an account to stop requiring the explicit
+// acceptance of incoming transfers
+type MsgOptOut struct {
+	ToAddress sdk.AccAddress `json:"to_address"`
+}
+
+// NewMsgOptOut creates a new MsgOptOut object
+func NewMsgOptOut(toAddr sdk.AccAddress) MsgOptOut {
+	return MsgOptOut{ToAddress: toAddr}
+}
+
+// nolint
+func (msg MsgOptOut) Route() string { return RouterKey }
+func (msg MsgOptOut) Type() string  { return "opt_out" }
+
+// get the bytes for the message signer to sign on
+func (msg MsgOptOut) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.ToAddress} }
+
+// GetSignBytes gets the sign bytes for the msg MsgOptOut
+func (msg MsgOptOut) GetSignBytes() []byte {
+	bz := moduleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgOptOut) ValidateBasic() sdk.Error {
+	if msg.ToAddress.Empty() {
+		return sdk.ErrInvalidAddress(msg.ToAddress.String())
+	}
+	return nil
+}
+
+// MsgAccept - message struct to accept the funds quarantined for an account
+// from a sender
+type MsgAccept struct {
+	ToAddress   sdk.AccAddress `json:"to_address"`
+	FromAddress sdk.AccAddress `json:"from_address"`
+}
+
+// NewMsgAccept creates a new MsgAccept object
+func NewMsgAccept(toAddr, fromAddr sdk.AccAddress) MsgAccept {
+	return MsgAccept{
+		ToAddress:   toAddr,
+		FromAddress: fromAddr,
+	}
+}
+
+// nolint
+func (msg MsgAccept) Route() string { return RouterKey }
+func (msg MsgAccept) Type() string  { return "accept" }
+
+// get the bytes for the message signer to sign on
+func (msg MsgAccept) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.ToAddress} }
+
+// GetSignBytes gets the sign bytes for the msg MsgAccept
+func (msg MsgAccept) GetSignBytes() []byte {
+	bz := moduleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgAccept) ValidateBasic() sdk.Error {
+	if msg.ToAddress.Empty() {
+		return sdk.ErrInvalidAddress(msg.ToAddress.String())
+	}
+	if msg.FromAddress.Empty() {
+		return sdk.ErrInvalidAddress(msg.FromAddress.String())
+	}
+	return nil
+}
+
+// MsgDecline - message struct to decline the funds quarantined for an account
+// from a sender, returning them to the sender
+type MsgDecline struct {
+	ToAddress   sdk.AccAddress `json:"to_address"`
+	FromAddress sdk.AccAddress `json:"from_address"`
+}
+
+// NewMsgDecline creates a new MsgDecline object
+func NewMsgDecline(toAddr, fromAddr sdk.AccAddress) MsgDecline {
+	return MsgDecline{
+		ToAddress:   toAddr,
+		FromAddress: fromAddr,
+	}
+}
+
+// nolint
+func (msg MsgDecline) Route() string { return RouterKey }
+func (msg MsgDecline) Type() string  { return "decline" }
+
+// get the bytes for the message signer to sign on
+func (msg MsgDecline) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.ToAddress} }
+
+// GetSignBytes gets the sign bytes for the msg MsgDecline
+func (msg MsgDecline) GetSignBytes() []byte {
+	bz := moduleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgDecline) ValidateBasic() sdk.Error {
+	if msg.ToAddress.Empty() {
+		return sdk.ErrInvalidAddress(msg.ToAddress.String())
+	}
+	if msg.FromAddress.Empty() {
+		return sdk.ErrInvalidAddress(msg.FromAddress.String())
+	}
+	return nil
+}
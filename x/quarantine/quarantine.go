@@ -0,0 +1,63 @@
+package quarantine
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// QuarantinedFunds are the coins sent from a sender to a quarantined
+// recipient, held in escrow until the recipient accepts or declines them
+type QuarantinedFunds struct {
+	Recipient sdk.AccAddress `json:"recipient"`
+	Sender    sdk.AccAddress `json:"sender"`
+	Coins     sdk.Coins      `json:"coins"`
+}
+
+// NewQuarantinedFunds creates a new QuarantinedFunds object
+func NewQuarantinedFunds(recipient, sender sdk.AccAddress, coins sdk.Coins) QuarantinedFunds {
+	return QuarantinedFunds{
+		Recipient: recipient,
+		Sender:    sender,
+		Coins:     coins,
+	}
+}
+
+// Validate performs a basic validation of the quarantined funds
+func (qf QuarantinedFunds) Validate() error {
+	if qf.Recipient.Empty() {
+		return fmt.Errorf("quarantined funds recipient cannot be empty")
+	}
+	if qf.Sender.Empty() {
+		return fmt.Errorf("quarantined funds sender cannot be empty")
+	}
+	if !qf.Coins.IsValid() || qf.Coins.Empty() {
+		return fmt.Errorf("invalid quarantined coins %s", qf.Coins)
+	}
+	return nil
+}
+
+// nolint
+func (qf QuarantinedFunds) String() string {
+	return fmt.Sprintf(`Quarantined Funds:
+  Recipient: %s
+  Sender:    %s
+  Coins:     %s`, qf.Recipient, qf.Sender, qf.Coins)
+}
+
+// QuarantinedFundsList is a collection of QuarantinedFunds
+type QuarantinedFundsList []QuarantinedFunds
+
+// nolint
+func (qfl QuarantinedFundsList) String() string {
+	if len(qfl) == 0 {
+		return "[]"
+	}
+
+	out := make([]string, len(qfl))
+	for i, qf := range qfl {
+		out[i] = qf.String()
+	}
+	return strings.Join(out, "\n")
+}
@@ -0,0 +1,75 @@
+package quarantine
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// query endpoints supported by the quarantine Querier
+const (
+	QueryIsQuarantined = "is_quarantined"
+	QueryFunds         = "funds"
+)
+
+// NewQuerier creates a querier for quarantine REST endpoints
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QueryIsQuarantined:
+			return queryIsQuarantined(ctx, req, k)
+		case QueryFunds:
+			return queryFunds(ctx, req, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown quarantine query endpoint")
+		}
+	}
+}
+
+// QueryAccountParams defines the params for the following queries:
+// - 'custom/quarantine/is_quarantined'
+// - 'custom/quarantine/funds'
+type QueryAccountParams struct {
+	Address sdk.AccAddress
+}
+
+// NewQueryAccountParams creates a new instance of QueryAccountParams
+func NewQueryAccountParams(addr sdk.AccAddress) QueryAccountParams {
+	return QueryAccountParams{
+		Address: addr,
+	}
+}
+
+func queryIsQuarantined(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryAccountParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, k.IsQuarantined(ctx, params.Address))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryFunds(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryAccountParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	funds := k.GetRecipientQuarantinedFunds(ctx, params.Address)
+	if funds == nil {
+		funds = QuarantinedFundsList{}
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, funds)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -0,0 +1,13 @@
+package tags
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Quarantine module tags
+var (
+	Category   = sdk.TagCategory
+	Sender     = sdk.TagSender
+	FromAddr   = "from-address"
+	TxCategory = "quarantine"
+)
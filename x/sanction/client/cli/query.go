@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/sanction"
+)
+
+// GetCmdQueryIsSanctioned implements the query is-sanctioned command.
+func GetCmdQueryIsSanctioned(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "is-sanctioned [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query whether an account is prevented from sending funds",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether an account is sanctioned, either by a passed sanction
+proposal or temporarily while a sanction proposal with a large enough deposit
+is pending.
+
+Example:
+$ %s query sanction is-sanctioned cosmos1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(sanction.NewQueryAddressParams(addr))
+			if err != nil {
+				return err
+			}
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, sanction.QueryIsSanctioned), bz)
+			if err != nil {
+				return err
+			}
+
+			var sanctioned bool
+			cdc.MustUnmarshalJSON(res, &sanctioned)
+			return cliCtx.PrintOutput(sanctioned)
+		},
+	}
+}
+
+// GetCmdQuerySanctioned implements the query sanctioned addresses command.
+func GetCmdQuerySanctioned(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "sanctioned",
+		Args:  cobra.NoArgs,
+		Short: "Query all the addresses sanctioned by governance",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, sanction.QuerySanctioned), nil)
+			if err != nil {
+				return err
+			}
+
+			var addresses []sdk.AccAddress
+			cdc.MustUnmarshalJSON(res, &addresses)
+			return cliCtx.PrintOutput(addresses)
+		},
+	}
+}
@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/sanction"
+	sanctioncutils "github.com/cosmos/cosmos-sdk/x/sanction/client/utils"
+)
+
+// GetCmdSubmitSanctionProposal implements the command to submit a sanction
+// proposal.
+func GetCmdSubmitSanctionProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "sanction [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a sanction proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to prevent addresses from sending funds, along with an
+initial deposit. If the deposit reaches the immediate sanction minimum deposit
+the addresses are sanctioned until the proposal ends. The proposal details
+must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal sanction <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Sanction Stolen Funds",
+  "description": "Freeze the funds stolen from the exchange hot wallet",
+  "addresses": [
+    "cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq"
+  ],
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			proposal, err := sanctioncutils.ParseSanctionProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := sanction.NewSanctionProposal(proposal.Title, proposal.Description, proposal.Addresses)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSubmitUnsanctionProposal implements the command to submit an
+// unsanction proposal.
+func GetCmdSubmitUnsanctionProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "unsanction [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an unsanction proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to allow sanctioned addresses to send funds again,
+along with an initial deposit. The proposal details must be supplied via a
+JSON file.
+
+Example:
+$ %s tx gov submit-proposal unsanction <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Unsanction Recovered Funds",
+  "description": "The stolen funds were returned to the exchange",
+  "addresses": [
+    "cosmos1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq"
+  ],
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			proposal, err := sanctioncutils.ParseSanctionProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := sanction.NewUnsanctionProposal(proposal.Title, proposal.Description, proposal.Addresses)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -0,0 +1,44 @@
+package client
+
+import (
+	"github.com/spf13/cobra"
+	amino "github.com/tendermint/go-amino"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/x/sanction"
+	"github.com/cosmos/cosmos-sdk/x/sanction/client/cli"
+)
+
+// ModuleClient exports all client functionality from this module
+type ModuleClient struct {
+	storeKey string
+	cdc      *amino.Codec
+}
+
+// NewModuleClient creates a new ModuleClient object
+func NewModuleClient(storeKey string, cdc *amino.Codec) ModuleClient {
+	return ModuleClient{
+		storeKey: storeKey,
+		cdc:      cdc,
+	}
+}
+
+// GetQueryCmd returns the cli query commands for this module
+func (mc ModuleClient) GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:   sanction.ModuleName,
+		Short: "Querying commands for the sanction module",
+	}
+
+	queryCmd.AddCommand(client.GetCommands(
+		cli.GetCmdQueryIsSanctioned(mc.storeKey, mc.cdc),
+		cli.GetCmdQuerySanctioned(mc.storeKey, mc.cdc),
+	)...)
+	return queryCmd
+}
+
+// GetTxCmd returns the transaction commands for this module, sanctions are
+// only managed through governance proposals
+func (ModuleClient) GetTxCmd() *cobra.Command {
+	return nil
+}
@@ -0,0 +1,34 @@
+package utils
+
+import (
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SanctionProposalJSON defines a SanctionProposal or an UnsanctionProposal
+// with a deposit used to parse sanction proposals from a JSON file.
+type SanctionProposalJSON struct {
+	Title       string           `json:"title"`
+	Description string           `json:"description"`
+	Addresses   []sdk.AccAddress `json:"addresses"`
+	Deposit     sdk.Coins        `json:"deposit"`
+}
+
+// ParseSanctionProposalJSON reads and parses a SanctionProposalJSON from
+// file.
+func ParseSanctionProposalJSON(cdc *codec.Codec, proposalFile string) (SanctionProposalJSON, error) {
+	proposal := SanctionProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
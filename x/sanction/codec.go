@@ -0,0 +1,21 @@
+package sanction
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// Register concrete types on codec codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(SanctionProposal{}, "cosmos-sdk/SanctionProposal", nil)
+	cdc.RegisterConcrete(UnsanctionProposal{}, "cosmos-sdk/UnsanctionProposal", nil)
+}
+
+// generic sealed codec to be used throughout module
+var moduleCdc *codec.Codec
+
+func init() {
+	cdc := codec.New()
+	RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	moduleCdc = cdc.Seal()
+}
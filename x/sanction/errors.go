@@ -0,0 +1,25 @@
+package sanction
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeSanctionedAddress sdk.CodeType = 1
+	CodeInvalidProposal   sdk.CodeType = 2
+)
+
+// ErrSanctionedAddress returns an error for a transfer or delegation from a
+// sanctioned address
+func ErrSanctionedAddress(codespace sdk.CodespaceType, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeSanctionedAddress, fmt.Sprintf("address %s is sanctioned", addr))
+}
+
+// ErrInvalidProposal returns an error for an invalid sanction proposal
+func ErrInvalidProposal(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidProposal, msg)
+}
@@ -0,0 +1,93 @@
+package sanction
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - sanction genesis state
+type GenesisState struct {
+	ImmediateSanctionMinDeposit sdk.Coins           `json:"immediate_sanction_min_deposit"`
+	SanctionedAddresses         []sdk.AccAddress    `json:"sanctioned_addresses"`
+	TemporarySanctions          []TemporarySanction `json:"temporary_sanctions"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(minDeposit sdk.Coins, addresses []sdk.AccAddress,
+	temporarySanctions []TemporarySanction) GenesisState {
+
+	return GenesisState{
+		ImmediateSanctionMinDeposit: minDeposit,
+		SanctionedAddresses:         addresses,
+		TemporarySanctions:          temporarySanctions,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object, with temporary
+// sanctions disabled
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		ImmediateSanctionMinDeposit: sdk.Coins{},
+		SanctionedAddresses:         []sdk.AccAddress{},
+		TemporarySanctions:          []TemporarySanction{},
+	}
+}
+
+// InitGenesis sets the sanction parameters and sanctions from a genesis state
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	k.SetImmediateSanctionMinDeposit(ctx, data.ImmediateSanctionMinDeposit)
+	for _, addr := range data.SanctionedAddresses {
+		k.SetSanctioned(ctx, addr, true)
+	}
+	for _, ts := range data.TemporarySanctions {
+		k.SetTemporarySanction(ctx, ts.Address, ts.ProposalID)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	addresses := []sdk.AccAddress{}
+	k.IterateSanctionedAddresses(ctx, func(addr sdk.AccAddress) bool {
+		addresses = append(addresses, addr)
+		return false
+	})
+
+	temporarySanctions := []TemporarySanction{}
+	k.IterateTemporarySanctions(ctx, func(ts TemporarySanction) bool {
+		temporarySanctions = append(temporarySanctions, ts)
+		return false
+	})
+
+	return NewGenesisState(k.GetImmediateSanctionMinDeposit(ctx), addresses, temporarySanctions)
+}
+
+// ValidateGenesis performs basic validation of the sanction genesis data
+// returning an error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	if !data.ImmediateSanctionMinDeposit.IsValid() {
+		return fmt.Errorf("invalid immediate sanction min deposit %s", data.ImmediateSanctionMinDeposit)
+	}
+
+	seen := make(map[string]bool)
+	for _, addr := range data.SanctionedAddresses {
+		if addr.Empty() {
+			return fmt.Errorf("sanctioned address cannot be empty")
+		}
+		if seen[addr.String()] {
+			return fmt.Errorf("duplicate sanctioned address %s", addr)
+		}
+		seen[addr.String()] = true
+	}
+
+	for _, ts := range data.TemporarySanctions {
+		if ts.Address.Empty() {
+			return fmt.Errorf("temporarily sanctioned address cannot be empty")
+		}
+		if ts.ProposalID == 0 {
+			return fmt.Errorf("temporary sanction of %s must have a proposal ID", ts.Address)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package sanction
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewSanctionProposalHandler returns a handler for the sanction proposals
+func NewSanctionProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case SanctionProposal:
+			return handleSanctionProposal(ctx, k, c)
+
+		case UnsanctionProposal:
+			return handleUnsanctionProposal(ctx, k, c)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized sanction proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
+
+func handleSanctionProposal(ctx sdk.Context, k Keeper, p SanctionProposal) sdk.Error {
+	for _, addr := range p.Addresses {
+		k.SetSanctioned(ctx, addr, true)
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("sanctioned addresses %s", p.Addresses))
+	return nil
+}
+
+func handleUnsanctionProposal(ctx sdk.Context, k Keeper, p UnsanctionProposal) sdk.Error {
+	for _, addr := range p.Addresses {
+		k.SetSanctioned(ctx, addr, false)
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("unsanctioned addresses %s", p.Addresses))
+	return nil
+}
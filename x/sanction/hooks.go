@@ -0,0 +1,49 @@
+package sanction
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+// Hooks wrapper struct for sanction keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ gov.GovHooks = Hooks{}
+
+// Return the wrapper struct
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterProposalDeposit temporarily sanctions the addresses of a sanction
+// proposal once its deposit reaches the immediate sanction minimum deposit
+func (h Hooks) AfterProposalDeposit(ctx sdk.Context, proposal gov.Proposal) {
+	sp, ok := proposal.Content.(SanctionProposal)
+	if !ok {
+		return
+	}
+
+	minDeposit := h.k.GetImmediateSanctionMinDeposit(ctx)
+	if minDeposit.Empty() || !proposal.TotalDeposit.IsAllGTE(minDeposit) {
+		return
+	}
+
+	for _, addr := range sp.Addresses {
+		h.k.SetTemporarySanction(ctx, addr, proposal.ProposalID)
+	}
+}
+
+// AfterProposalEnded lifts the temporary sanctions of a sanction proposal. The
+// addresses of a passed proposal are sanctioned by the proposal handler.
+func (h Hooks) AfterProposalEnded(ctx sdk.Context, proposal gov.Proposal) {
+	sp, ok := proposal.Content.(SanctionProposal)
+	if !ok {
+		return
+	}
+
+	for _, addr := range sp.Addresses {
+		h.k.DeleteTemporarySanction(ctx, addr, proposal.ProposalID)
+	}
+}
@@ -0,0 +1,114 @@
+package sanction
+
+import (
+	"encoding/binary"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Keeper of the sanction store
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	cdc        *codec.Codec
+	paramSpace params.Subspace
+	codespace  sdk.CodespaceType
+}
+
+// NewKeeper creates a new sanction Keeper instance
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:   key,
+		cdc:        cdc,
+		paramSpace: paramSpace.WithKeyTable(ParamKeyTable()),
+		codespace:  codespace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+ModuleName)
+}
+
+// IsSanctioned returns true if the address is sanctioned, either by a passed
+// proposal or temporarily by a pending one
+func (k Keeper) IsSanctioned(ctx sdk.Context, addr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(GetSanctionedAddressKey(addr)) {
+		return true
+	}
+
+	iterator := sdk.KVStorePrefixIterator(store, GetTemporarySanctionsKey(addr))
+	defer iterator.Close()
+	return iterator.Valid()
+}
+
+// SetSanctioned sets whether the address is sanctioned
+func (k Keeper) SetSanctioned(ctx sdk.Context, addr sdk.AccAddress, sanctioned bool) {
+	store := ctx.KVStore(k.storeKey)
+	if sanctioned {
+		store.Set(GetSanctionedAddressKey(addr), []byte{0x01})
+	} else {
+		store.Delete(GetSanctionedAddressKey(addr))
+	}
+}
+
+// IterateSanctionedAddresses iterates over the addresses sanctioned by passed
+// proposals and performs a callback function until it returns true
+func (k Keeper) IterateSanctionedAddresses(ctx sdk.Context, cb func(addr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, SanctionedAddressKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		addr := sdk.AccAddress(iterator.Key()[len(SanctionedAddressKey):])
+		if cb(addr) {
+			break
+		}
+	}
+}
+
+// SetTemporarySanction sanctions an address until the given proposal ends
+func (k Keeper) SetTemporarySanction(ctx sdk.Context, addr sdk.AccAddress, proposalID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetTemporarySanctionKey(addr, proposalID), []byte{0x01})
+}
+
+// DeleteTemporarySanction deletes the temporary sanction of an address by the
+// given proposal
+func (k Keeper) DeleteTemporarySanction(ctx sdk.Context, addr sdk.AccAddress, proposalID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetTemporarySanctionKey(addr, proposalID))
+}
+
+// IterateTemporarySanctions iterates over the temporary sanctions and
+// performs a callback function until it returns true
+func (k Keeper) IterateTemporarySanctions(ctx sdk.Context, cb func(sanction TemporarySanction) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, TemporarySanctionKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(TemporarySanctionKey):]
+		addr := sdk.AccAddress(key[:len(key)-8])
+		proposalID := binary.BigEndian.Uint64(key[len(key)-8:])
+		if cb(NewTemporarySanction(addr, proposalID)) {
+			break
+		}
+	}
+}
+
+// SendRestriction is the bank send restriction preventing sanctioned
+// addresses from sending coins and delegating
+func (k Keeper) SendRestriction(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, _ sdk.Coins,
+) (sdk.AccAddress, sdk.Error) {
+
+	if !fromAddr.Empty() && k.IsSanctioned(ctx, fromAddr) {
+		return nil, ErrSanctionedAddress(k.codespace, fromAddr)
+	}
+	return toAddr, nil
+}
@@ -0,0 +1,105 @@
+package sanction
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+)
+
+func TestSanctionProposals(t *testing.T) {
+	ctx, keeper, bk := createTestInput(t)
+	proposalHandler := NewSanctionProposalHandler(keeper)
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	require.NotNil(t, NewSanctionProposal("title", "description", nil).ValidateBasic())
+	require.NotNil(t, NewSanctionProposal("title", "description", []sdk.AccAddress{addr1, addr1}).ValidateBasic())
+
+	proposal := NewSanctionProposal("title", "description", []sdk.AccAddress{addr1})
+	require.Nil(t, proposal.ValidateBasic())
+	require.Nil(t, proposalHandler(ctx, proposal))
+	require.True(t, keeper.IsSanctioned(ctx, addr1))
+
+	// sanctioned addresses can neither send nor delegate, but still receive
+	err := bk.SendCoins(ctx, addr1, addr2, amount)
+	require.NotNil(t, err)
+	require.Equal(t, CodeSanctionedAddress, err.Code())
+
+	_, err = bk.InputOutputCoins(ctx,
+		[]bank.Input{bank.NewInput(addr1, amount), bank.NewInput(addr2, amount)},
+		[]bank.Output{bank.NewOutput(addr3, amount.Add(amount))})
+	require.NotNil(t, err)
+
+	_, err = bk.DelegateCoins(ctx, addr1, amount)
+	require.NotNil(t, err)
+
+	require.Nil(t, bk.SendCoins(ctx, addr2, addr1, amount))
+	require.True(t, bk.GetCoins(ctx, addr1).IsEqual(initCoins.Add(amount)))
+
+	unsanction := NewUnsanctionProposal("title", "description", []sdk.AccAddress{addr1})
+	require.Nil(t, proposalHandler(ctx, unsanction))
+	require.False(t, keeper.IsSanctioned(ctx, addr1))
+	require.Nil(t, bk.SendCoins(ctx, addr1, addr2, amount))
+}
+
+func TestTemporarySanctions(t *testing.T) {
+	ctx, keeper, bk := createTestInput(t)
+	hooks := keeper.Hooks()
+	amount := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	content := NewSanctionProposal("title", "description", []sdk.AccAddress{addr1})
+	proposal := gov.NewProposal(content, 1, time.Time{}, time.Time{})
+	proposal.TotalDeposit = amount
+
+	// temporary sanctions are disabled by default
+	hooks.AfterProposalDeposit(ctx, proposal)
+	require.False(t, keeper.IsSanctioned(ctx, addr1))
+
+	keeper.SetImmediateSanctionMinDeposit(ctx, amount.Add(amount))
+	hooks.AfterProposalDeposit(ctx, proposal)
+	require.False(t, keeper.IsSanctioned(ctx, addr1))
+
+	// the deposit reaches the immediate sanction minimum deposit
+	proposal.TotalDeposit = amount.Add(amount)
+	hooks.AfterProposalDeposit(ctx, proposal)
+	require.True(t, keeper.IsSanctioned(ctx, addr1))
+	require.NotNil(t, bk.SendCoins(ctx, addr1, addr2, amount))
+
+	// a second pending proposal keeps the address sanctioned
+	other := gov.NewProposal(content, 2, time.Time{}, time.Time{})
+	other.TotalDeposit = amount.Add(amount)
+	hooks.AfterProposalDeposit(ctx, other)
+
+	hooks.AfterProposalEnded(ctx, proposal)
+	require.True(t, keeper.IsSanctioned(ctx, addr1))
+
+	hooks.AfterProposalEnded(ctx, other)
+	require.False(t, keeper.IsSanctioned(ctx, addr1))
+	require.Nil(t, bk.SendCoins(ctx, addr1, addr2, amount))
+}
+
+func TestExportImportGenesis(t *testing.T) {
+	ctx, keeper, _ := createTestInput(t)
+	minDeposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+
+	InitGenesis(ctx, keeper, NewGenesisState(minDeposit, []sdk.AccAddress{addr1},
+		[]TemporarySanction{NewTemporarySanction(addr2, 3)}))
+	require.True(t, keeper.IsSanctioned(ctx, addr1))
+	require.True(t, keeper.IsSanctioned(ctx, addr2))
+	require.False(t, keeper.IsSanctioned(ctx, addr3))
+
+	genState := ExportGenesis(ctx, keeper)
+	require.NoError(t, ValidateGenesis(genState))
+
+	ctx2, keeper2, _ := createTestInput(t)
+	InitGenesis(ctx2, keeper2, genState)
+	require.Equal(t, genState, ExportGenesis(ctx2, keeper2))
+
+	genState.SanctionedAddresses = append(genState.SanctionedAddresses, addr1)
+	require.Error(t, ValidateGenesis(genState))
+	require.NoError(t, ValidateGenesis(DefaultGenesisState()))
+}
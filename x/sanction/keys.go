@@ -0,0 +1,50 @@
+package sanction
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "sanction"
+
+	// StoreKey is the store key string for sanction
+	StoreKey = ModuleName
+
+	// RouterKey is the proposal route for sanction
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for sanction
+	QuerierRoute = ModuleName
+
+	// DefaultParamspace is the default parameter namespace
+	DefaultParamspace = ModuleName
+)
+
+// Keys for sanction store
+// Items are stored with the following key: values
+//
+// - 0x00<address_Bytes>: []byte{0x01}
+//
+// - 0x01<address_Bytes><proposalID_Bytes>: []byte{0x01}
+var (
+	SanctionedAddressKey = []byte{0x00} // prefix for each key to a sanctioned address
+	TemporarySanctionKey = []byte{0x01} // prefix for each key to a temporary sanction
+)
+
+// GetSanctionedAddressKey returns the store key of a sanctioned address
+func GetSanctionedAddressKey(addr sdk.AccAddress) []byte {
+	return append(SanctionedAddressKey, addr.Bytes()...)
+}
+
+// GetTemporarySanctionKey returns the store key of the temporary sanction of
+// an address by a proposal
+func GetTemporarySanctionKey(addr sdk.AccAddress, proposalID uint64) []byte {
+	return append(GetTemporarySanctionsKey(addr), sdk.Uint64ToBigEndian(proposalID)...)
+}
+
+// GetTemporarySanctionsKey returns the prefix of the store keys of all the
+// temporary sanctions of an address
+func GetTemporarySanctionsKey(addr sdk.AccAddress) []byte {
+	return append(TemporarySanctionKey, addr.Bytes()...)
+}
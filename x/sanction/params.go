@@ -0,0 +1,33 @@
+package sanction
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+var (
+	// key for the deposit from which the addresses of a sanction proposal are
+	// sanctioned until the proposal ends
+	ParamStoreKeyImmediateSanctionMinDeposit = []byte("ImmediateSanctionMinDeposit")
+)
+
+// type declaration for parameters
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable(
+		ParamStoreKeyImmediateSanctionMinDeposit, sdk.Coins{},
+	)
+}
+
+// GetImmediateSanctionMinDeposit gets the deposit from which the addresses of
+// a sanction proposal are temporarily sanctioned. Temporary sanctions are
+// disabled if it is empty.
+func (k Keeper) GetImmediateSanctionMinDeposit(ctx sdk.Context) (minDeposit sdk.Coins) {
+	k.paramSpace.GetIfExists(ctx, ParamStoreKeyImmediateSanctionMinDeposit, &minDeposit)
+	return
+}
+
+// SetImmediateSanctionMinDeposit sets the deposit from which the addresses of
+// a sanction proposal are temporarily sanctioned
+func (k Keeper) SetImmediateSanctionMinDeposit(ctx sdk.Context, minDeposit sdk.Coins) {
+	k.paramSpace.Set(ctx, ParamStoreKeyImmediateSanctionMinDeposit, minDeposit)
+}
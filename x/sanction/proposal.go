@@ -0,0 +1,133 @@
+package sanction
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeSanction defines the type for a SanctionProposal
+	ProposalTypeSanction = "Sanction"
+
+	// ProposalTypeUnsanction defines the type for an UnsanctionProposal
+	ProposalTypeUnsanction = "Unsanction"
+)
+
+// Assert the proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = SanctionProposal{}
+	_ govtypes.Content = UnsanctionProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSanction)
+	govtypes.RegisterProposalTypeCodec(SanctionProposal{}, "cosmos-sdk/SanctionProposal")
+	govtypes.RegisterProposalType(ProposalTypeUnsanction)
+	govtypes.RegisterProposalTypeCodec(UnsanctionProposal{}, "cosmos-sdk/UnsanctionProposal")
+}
+
+// SanctionProposal defines a proposal to prevent addresses from sending coins
+// and delegating. The addresses are sanctioned until the proposal ends once
+// its deposit reaches the immediate sanction minimum deposit, if set.
+type SanctionProposal struct {
+	Title       string           `json:"title"`
+	Description string           `json:"description"`
+	Addresses   []sdk.AccAddress `json:"addresses"`
+}
+
+func NewSanctionProposal(title, description string, addresses []sdk.AccAddress) SanctionProposal {
+	return SanctionProposal{title, description, addresses}
+}
+
+// GetTitle returns the title of a sanction proposal.
+func (sp SanctionProposal) GetTitle() string { return sp.Title }
+
+// GetDescription returns the description of a sanction proposal.
+func (sp SanctionProposal) GetDescription() string { return sp.Description }
+
+// ProposalRoute returns the routing key of a sanction proposal.
+func (sp SanctionProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a sanction proposal.
+func (sp SanctionProposal) ProposalType() string { return ProposalTypeSanction }
+
+// ValidateBasic validates the sanction proposal
+func (sp SanctionProposal) ValidateBasic() sdk.Error {
+	err := govtypes.ValidateAbstract(DefaultCodespace, sp)
+	if err != nil {
+		return err
+	}
+	return validateAddresses(sp.Addresses)
+}
+
+// String implements the Stringer interface.
+func (sp SanctionProposal) String() string {
+	return fmt.Sprintf(`Sanction Proposal:
+  Title:       %s
+  Description: %s
+  Addresses:   %s
+`, sp.Title, sp.Description, sp.Addresses)
+}
+
+// UnsanctionProposal defines a proposal to lift the sanction of addresses.
+type UnsanctionProposal struct {
+	Title       string           `json:"title"`
+	Description string           `json:"description"`
+	Addresses   []sdk.AccAddress `json:"addresses"`
+}
+
+func NewUnsanctionProposal(title, description string, addresses []sdk.AccAddress) UnsanctionProposal {
+	return UnsanctionProposal{title, description, addresses}
+}
+
+// GetTitle returns the title of an unsanction proposal.
+func (up UnsanctionProposal) GetTitle() string { return up.Title }
+
+// GetDescription returns the description of an unsanction proposal.
+func (up UnsanctionProposal) GetDescription() string { return up.Description }
+
+// ProposalRoute returns the routing key of an unsanction proposal.
+func (up UnsanctionProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an unsanction proposal.
+func (up UnsanctionProposal) ProposalType() string { return ProposalTypeUnsanction }
+
+// ValidateBasic validates the unsanction proposal
+func (up UnsanctionProposal) ValidateBasic() sdk.Error {
+	err := govtypes.ValidateAbstract(DefaultCodespace, up)
+	if err != nil {
+		return err
+	}
+	return validateAddresses(up.Addresses)
+}
+
+// String implements the Stringer interface.
+func (up UnsanctionProposal) String() string {
+	return fmt.Sprintf(`Unsanction Proposal:
+  Title:       %s
+  Description: %s
+  Addresses:   %s
+`, up.Title, up.Description, up.Addresses)
+}
+
+// validateAddresses checks that a proposal lists at least one address and
+// that the addresses are not empty nor duplicated
+func validateAddresses(addresses []sdk.AccAddress) sdk.Error {
+	if len(addresses) == 0 {
+		return ErrInvalidProposal(DefaultCodespace, "proposal must list at least one address")
+	}
+
+	seen := make(map[string]bool)
+	for _, addr := range addresses {
+		if addr.Empty() {
+			return ErrInvalidProposal(DefaultCodespace, "proposal address cannot be empty")
+		}
+		if seen[addr.String()] {
+			return ErrInvalidProposal(DefaultCodespace, fmt.Sprintf("duplicate proposal address %s", addr))
+		}
+		seen[addr.String()] = true
+	}
+	return nil
+}
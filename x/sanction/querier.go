@@ -0,0 +1,69 @@
+package sanction
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// query endpoints supported by the sanction Querier
+const (
+	QueryIsSanctioned = "is_sanctioned"
+	QuerySanctioned   = "sanctioned"
+)
+
+// NewQuerier creates a querier for sanction REST endpoints
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QueryIsSanctioned:
+			return queryIsSanctioned(ctx, req, k)
+		case QuerySanctioned:
+			return querySanctioned(ctx, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown sanction query endpoint")
+		}
+	}
+}
+
+// QueryAddressParams defines the params for the following queries:
+// - 'custom/sanction/is_sanctioned'
+type QueryAddressParams struct {
+	Address sdk.AccAddress
+}
+
+// NewQueryAddressParams creates a new instance of QueryAddressParams
+func NewQueryAddressParams(addr sdk.AccAddress) QueryAddressParams {
+	return QueryAddressParams{
+		Address: addr,
+	}
+}
+
+func queryIsSanctioned(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryAddressParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, k.IsSanctioned(ctx, params.Address))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func querySanctioned(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	addresses := []sdk.AccAddress{}
+	k.IterateSanctionedAddresses(ctx, func(addr sdk.AccAddress) bool {
+		addresses = append(addresses, addr)
+		return false
+	})
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, addresses)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -0,0 +1,27 @@
+package sanction
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TemporarySanction is the sanction of an address by a pending sanction
+// proposal, lifted when the proposal ends
+type TemporarySanction struct {
+	Address    sdk.AccAddress `json:"address"`
+	ProposalID uint64         `json:"proposal_id"`
+}
+
+// NewTemporarySanction creates a new TemporarySanction object
+func NewTemporarySanction(addr sdk.AccAddress, proposalID uint64) TemporarySanction {
+	return TemporarySanction{
+		Address:    addr,
+		ProposalID: proposalID,
+	}
+}
+
+// nolint
+func (ts TemporarySanction) String() string {
+	return fmt.Sprintf("%s (proposal %d)", ts.Address, ts.ProposalID)
+}
@@ -0,0 +1,64 @@
+// nolint
+package sanction
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+var (
+	addr1 = sdk.AccAddress([]byte("addr1_______________"))
+	addr2 = sdk.AccAddress([]byte("addr2_______________"))
+	addr3 = sdk.AccAddress([]byte("addr3_______________"))
+
+	initCoins = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+)
+
+// createTestInput returns a sanction keeper along with a bank keeper running
+// its send restriction, with the test addresses funded with initCoins
+func createTestInput(t *testing.T) (sdk.Context, Keeper, bank.Keeper) {
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	keySanction := sdk.NewKVStoreKey(StoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(keySanction, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "sanction-chain"}, false, log.NewNopLogger())
+
+	cdc := codec.New()
+	auth.RegisterBaseAccount(cdc)
+	RegisterCodec(cdc)
+
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams, params.DefaultCodespace)
+	ak := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bk := bank.NewBaseKeeper(ak, pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace)
+
+	keeper := NewKeeper(cdc, keySanction, pk.Subspace(DefaultParamspace), DefaultCodespace)
+	restricted := bk.WithSendRestriction(keeper.SendRestriction)
+
+	for _, addr := range []sdk.AccAddress{addr1, addr2, addr3} {
+		_, err := bk.AddCoins(ctx, addr, initCoins)
+		require.Nil(t, err)
+	}
+
+	return ctx, keeper, restricted
+}
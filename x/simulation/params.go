@@ -55,6 +55,12 @@ var (
 		"SigVerifyCostSecp256k1": func(r *rand.Rand) interface{} {
 			return uint64(RandIntBetween(r, 500, 1000))
 		},
+		"MaxMsgsPerTx": func(r *rand.Rand) interface{} {
+			return uint64(RandIntBetween(r, 10, 100))
+		},
+		"MaxTxBytes": func(r *rand.Rand) interface{} {
+			return uint64(RandIntBetween(r, 100000, 1000000))
+		},
 		"DepositParams/MinDeposit": func(r *rand.Rand) interface{} {
 			return sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(RandIntBetween(r, 1, 1e3)))}
 		},
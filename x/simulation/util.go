@@ -6,8 +6,10 @@ import (
 	"testing"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -91,3 +93,10 @@ func PeriodicInvariants(invariants []sdk.Invariant, period int, offset int) []sd
 	}
 	return outInvariants
 }
+
+// GetSimulationLog returns the human readable representation of the first
+// key/value pairs at which two stores differ, using the decoder registered for
+// the store.
+func GetSimulationLog(storeName string, sdr sdk.StoreDecoderRegistry, cdc *codec.Codec, kvA, kvB cmn.KVPair) string {
+	return fmt.Sprintf("store A %s\nstore B %s", sdr.Decode(cdc, storeName, kvA), sdr.Decode(cdc, storeName, kvB))
+}
@@ -3,4 +3,8 @@ package cli
 // nolint
 const (
 	FlagAddressValidator = "validator"
+	FlagPage             = "page"
+	FlagLimit            = "limit"
+	FlagMinHeight        = "min-height"
+	FlagMaxHeight        = "max-height"
 )
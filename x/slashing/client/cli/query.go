@@ -5,32 +5,38 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec" // XXX fix
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 )
 
 // GetCmdQuerySigningInfo implements the command to query signing info.
 func GetCmdQuerySigningInfo(storeName string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:   "signing-info [validator-conspub]",
+		Use:   "signing-info [validator-consaddr|validator-conspub]",
 		Short: "Query a validator's signing information",
-		Long: strings.TrimSpace(`Use a validators' consensus public key to find the signing-info for that validator:
+		Long: strings.TrimSpace(`Use a validators' consensus address or public key to find the signing-info for that validator:
 
+$ <appcli> query slashing signing-info cosmosvalcons1ms9ekz9lzsx5z8tg6hhkp9fwmvc3k8zehns9mj
 $ <appcli> query slashing signing-info cosmosvalconspub1zcjduepqfhvwcmt7p06fvdgexxhmz0l8c7sgswl7ulv7aulk364x4g5xsw7sr0k2g5
 `),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
-			pk, err := sdk.GetConsPubKeyBech32(args[0])
+			consAddr, err := sdk.ConsAddressFromBech32(args[0])
 			if err != nil {
-				return err
+				pk, pkErr := sdk.GetConsPubKeyBech32(args[0])
+				if pkErr != nil {
+					return fmt.Errorf("%s is neither a consensus address nor a consensus public key", args[0])
+				}
+				consAddr = sdk.ConsAddress(pk.Address())
 			}
 
-			consAddr := sdk.ConsAddress(pk.Address())
 			key := slashing.GetValidatorSigningInfoKey(consAddr)
 
 			res, err := cliCtx.QueryStore(key, storeName)
@@ -49,6 +55,85 @@ $ <appcli> query slashing signing-info cosmosvalconspub1zcjduepqfhvwcmt7p06fvdge
 	}
 }
 
+// GetCmdQuerySigningInfos implements the command to query the signing infos of
+// all the validators, paginated.
+func GetCmdQuerySigningInfos(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-infos",
+		Short: "Query the signing information of all validators",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(`Query the signing-infos of all the validators, paginated:
+
+$ <appcli> query slashing signing-infos --page 2 --limit 50
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := slashing.NewQuerySigningInfosParams(viper.GetInt(FlagPage), viper.GetInt(FlagLimit))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", slashing.QuerierRoute, slashing.QuerySigningInfos)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var signingInfos []slashing.ValidatorSigningInfo
+			cdc.MustUnmarshalJSON(res, &signingInfos)
+			return cliCtx.PrintOutput(signingInfos)
+		},
+	}
+
+	cmd.Flags().Int(FlagPage, rest.DefaultPage, "Query a specific page of paginated results")
+	cmd.Flags().Int(FlagLimit, rest.DefaultLimit, "Query number of signing infos returned per page")
+	return cmd
+}
+
+// GetCmdQuerySlashEvents implements the command to query the slash events of
+// a validator between two heights.
+func GetCmdQuerySlashEvents(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-events [validator-consaddr]",
+		Short: "Query a validator's slash events between two heights",
+		Long: strings.TrimSpace(`Use a validator's consensus address to list its slash events, optionally between two heights:
+
+$ <appcli> query slashing slash-events cosmosvalcons1ms9ekz9lzsx5z8tg6hhkp9fwmvc3k8zehns9mj --min-height 1000 --max-height 2000
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			consAddr, err := sdk.ConsAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := slashing.NewQuerySlashEventsParams(consAddr, viper.GetInt64(FlagMinHeight), viper.GetInt64(FlagMaxHeight))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", slashing.QuerierRoute, slashing.QuerySlashEvents)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var events []slashing.SlashEvent
+			cdc.MustUnmarshalJSON(res, &events)
+			return cliCtx.PrintOutput(events)
+		},
+	}
+
+	cmd.Flags().Int64(FlagMinHeight, 0, "Query the slash events from this height on")
+	cmd.Flags().Int64(FlagMaxHeight, 0, "Query the slash events up to this height, defaults to the latest height")
+	return cmd
+}
+
 // GetCmdQueryParams implements a command to fetch slashing parameters.
 func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -74,3 +159,77 @@ $ <appcli> query slashing params
 		},
 	}
 }
+
+// GetCmdQueryOperator implements the command to query the operator address of
+// a validator from its consensus address.
+func GetCmdQueryOperator(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "operator [validator-consaddr]",
+		Short: "Query a validator's operator address from its consensus address",
+		Long: strings.TrimSpace(`Use a validator's consensus address, as found in slashing events, to find its operator address:
+
+$ <appcli> query slashing operator cosmosvalcons1ms9ekz9lzsx5z8tg6hhkp9fwmvc3k8zehns9mj
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			consAddr, err := sdk.ConsAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(slashing.NewQueryOperatorParams(consAddr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", slashing.QuerierRoute, slashing.QueryOperator)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var valAddr sdk.ValAddress
+			cdc.MustUnmarshalJSON(res, &valAddr)
+			return cliCtx.PrintOutput(valAddr)
+		},
+	}
+}
+
+// GetCmdQueryConsAddress implements the command to query the consensus address
+// of a validator from its operator address.
+func GetCmdQueryConsAddress(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cons-address [validator-addr]",
+		Short: "Query a validator's consensus address from its operator address",
+		Long: strings.TrimSpace(`Use a validator's operator address to find its consensus address:
+
+$ <appcli> query slashing cons-address cosmosvaloper1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(slashing.NewQueryConsAddressParams(valAddr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", slashing.QuerierRoute, slashing.QueryConsAddress)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var consAddr sdk.ConsAddress
+			cdc.MustUnmarshalJSON(res, &consAddr)
+			return cliCtx.PrintOutput(consAddr)
+		},
+	}
+}
@@ -30,7 +30,11 @@ func (mc ModuleClient) GetQueryCmd() *cobra.Command {
 	slashingQueryCmd.AddCommand(
 		client.GetCommands(
 			cli.GetCmdQuerySigningInfo(mc.storeKey, mc.cdc),
+			cli.GetCmdQuerySigningInfos(mc.cdc),
+			cli.GetCmdQuerySlashEvents(mc.cdc),
 			cli.GetCmdQueryParams(mc.cdc),
+			cli.GetCmdQueryOperator(mc.cdc),
+			cli.GetCmdQueryConsAddress(mc.cdc),
 		)...,
 	)
 
@@ -15,7 +15,7 @@ import (
 
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec) {
 	r.HandleFunc(
-		"/slashing/validators/{validatorPubKey}/signing_info",
+		"/slashing/validators/{validator}/signing_info",
 		signingInfoHandlerFn(cliCtx, cdc),
 	).Methods("GET")
 
@@ -24,23 +24,44 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Co
 		signingInfoHandlerListFn(cliCtx, cdc),
 	).Methods("GET")
 
+	r.HandleFunc(
+		"/slashing/validators/{validator}/slash_events",
+		slashEventsHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
 	r.HandleFunc(
 		"/slashing/parameters",
 		queryParamsHandlerFn(cdc, cliCtx),
 	).Methods("GET")
+
+	r.HandleFunc(
+		"/slashing/consensus_addresses/{consAddr}/operator",
+		operatorHandlerFn(cliCtx, cdc),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/slashing/validators/{validatorAddr}/consensus_address",
+		consAddressHandlerFn(cliCtx, cdc),
+	).Methods("GET")
 }
 
-// http request handler to query signing info
+// http request handler to query signing info, the validator is identified by
+// either its consensus address or its consensus public key
 func signingInfoHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		vars := mux.Vars(r)
-		pk, err := sdk.GetConsPubKeyBech32(vars["validatorPubKey"])
+		validator := mux.Vars(r)["validator"]
+		consAddr, err := sdk.ConsAddressFromBech32(validator)
 		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
-			return
+			pk, pkErr := sdk.GetConsPubKeyBech32(validator)
+			if pkErr != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest,
+					fmt.Sprintf("%s is neither a consensus address nor a consensus public key", validator))
+				return
+			}
+			consAddr = sdk.ConsAddress(pk.Address())
 		}
 
-		params := slashing.NewQuerySigningInfoParams(sdk.ConsAddress(pk.Address()))
+		params := slashing.NewQuerySigningInfoParams(consAddr)
 
 		bz, err := cdc.MarshalJSON(params)
 		if err != nil {
@@ -86,6 +107,47 @@ func signingInfoHandlerListFn(cliCtx context.CLIContext, cdc *codec.Codec) http.
 	}
 }
 
+// http request handler to query the slash events of a validator, optionally
+// between the heights given by the min_height and max_height parameters
+func slashEventsHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		consAddr, err := sdk.ConsAddressFromBech32(mux.Vars(r)["validator"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var minHeight, maxHeight int64
+		if s := r.FormValue("min_height"); s != "" {
+			var ok bool
+			if minHeight, ok = rest.ParseInt64OrReturnBadRequest(w, s); !ok {
+				return
+			}
+		}
+		if s := r.FormValue("max_height"); s != "" {
+			var ok bool
+			if maxHeight, ok = rest.ParseInt64OrReturnBadRequest(w, s); !ok {
+				return
+			}
+		}
+
+		bz, err := cdc.MarshalJSON(slashing.NewQuerySlashEventsParams(consAddr, minHeight, maxHeight))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", slashing.QuerierRoute, slashing.QuerySlashEvents)
+		res, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
 func queryParamsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		route := fmt.Sprintf("custom/%s/parameters", slashing.QuerierRoute)
@@ -99,3 +161,55 @@ func queryParamsHandlerFn(cdc *codec.Codec, cliCtx context.CLIContext) http.Hand
 		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
 	}
 }
+
+// http request handler to query the operator address of a validator
+func operatorHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		consAddr, err := sdk.ConsAddressFromBech32(mux.Vars(r)["consAddr"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		bz, err := cdc.MarshalJSON(slashing.NewQueryOperatorParams(consAddr))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", slashing.QuerierRoute, slashing.QueryOperator)
+		res, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+// http request handler to query the consensus address of a validator
+func consAddressHandlerFn(cliCtx context.CLIContext, cdc *codec.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		valAddr, err := sdk.ValAddressFromBech32(mux.Vars(r)["validatorAddr"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		bz, err := cdc.MarshalJSON(slashing.NewQueryConsAddressParams(valAddr))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", slashing.QuerierRoute, slashing.QueryConsAddress)
+		res, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
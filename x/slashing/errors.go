@@ -14,12 +14,15 @@ const (
 	// Default slashing codespace
 	DefaultCodespace sdk.CodespaceType = ModuleName
 
-	CodeInvalidValidator      CodeType = 101
-	CodeValidatorJailed       CodeType = 102
-	CodeValidatorNotJailed    CodeType = 103
-	CodeMissingSelfDelegation CodeType = 104
-	CodeSelfDelegationTooLow  CodeType = 105
-	CodeMissingSigningInfo    CodeType = 106
+	CodeInvalidValidator       CodeType = 101
+	CodeValidatorJailed        CodeType = 102
+	CodeValidatorNotJailed     CodeType = 103
+	CodeMissingSelfDelegation  CodeType = 104
+	CodeSelfDelegationTooLow   CodeType = 105
+	CodeMissingSigningInfo     CodeType = 106
+	CodeValidatorTombstoned    CodeType = 107
+	CodeTombstonedRedelegation CodeType = 108
+	CodeUnknownInfraction      CodeType = 109
 )
 
 func ErrNoValidatorForAddress(codespace sdk.CodespaceType) sdk.Error {
@@ -34,6 +37,18 @@ func ErrValidatorJailed(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeValidatorJailed, "validator still jailed, cannot yet be unjailed")
 }
 
+func ErrValidatorTombstoned(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeValidatorTombstoned, "validator tombstoned for double signing, cannot be unjailed")
+}
+
+func ErrUnknownInfraction(codespace sdk.CodespaceType, infractionType string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownInfraction, fmt.Sprintf("unknown infraction type %s", infractionType))
+}
+
+func ErrRedelegationToTombstoned(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeTombstonedRedelegation, "cannot redelegate to a validator tombstoned for double signing")
+}
+
 func ErrValidatorNotJailed(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeValidatorNotJailed, "validator not jailed, cannot be unjailed")
 }
@@ -43,7 +58,7 @@ func ErrMissingSelfDelegation(codespace sdk.CodespaceType) sdk.Error {
 }
 
 func ErrSelfDelegationTooLowToUnjail(codespace sdk.CodespaceType) sdk.Error {
-	return sdk.NewError(codespace, CodeValidatorNotJailed, "validator's self delegation less than MinSelfDelegation, cannot be unjailed")
+	return sdk.NewError(codespace, CodeSelfDelegationTooLow, "validator's self delegation less than MinSelfDelegation, cannot be unjailed")
 }
 
 func ErrNoSigningInfoFound(codespace sdk.CodespaceType, consAddr sdk.ConsAddress) sdk.Error {
@@ -0,0 +1,77 @@
+package slashing
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/tags"
+)
+
+// Event is a slashing event. Until the ABCI events are available, the events
+// are reported in the tags returned by BeginBlocker and SlashWithInfraction,
+// each one as a run of tags starting with the key of its type, eg. tags.Slash
+// followed by the attributes of the slash.
+type Event interface {
+	Tags() sdk.Tags
+}
+
+var (
+	_ Event = EventLiveness{}
+	_ Event = EventSlash{}
+	_ Event = EventJail{}
+)
+
+// EventLiveness is reported when a validator misses a block
+type EventLiveness struct {
+	Validator    sdk.ConsAddress // consensus address of the validator
+	MissedBlocks int64           // missed blocks in the signed blocks window
+}
+
+// Tags implements Event
+func (e EventLiveness) Tags() sdk.Tags {
+	return sdk.NewTags(
+		tags.Liveness, e.Validator.String(),
+		tags.MissedBlocks, strconv.FormatInt(e.MissedBlocks, 10),
+	)
+}
+
+// EventSlash is reported when a validator is slashed
+type EventSlash struct {
+	Validator sdk.ConsAddress // consensus address of the validator
+	Power     int64           // power of the validator when committing the infraction
+	Fraction  sdk.Dec         // slash fraction
+	Reason    string          // infraction type, eg. tags.ReasonDoubleSign
+}
+
+// Tags implements Event
+func (e EventSlash) Tags() sdk.Tags {
+	return sdk.NewTags(
+		tags.Slash, e.Validator.String(),
+		tags.SlashReason, e.Reason,
+		tags.SlashFraction, e.Fraction.String(),
+		tags.SlashPower, strconv.FormatInt(e.Power, 10),
+	)
+}
+
+// EventJail is reported when a validator is jailed
+type EventJail struct {
+	Validator sdk.ConsAddress // consensus address of the validator
+	Reason    string          // infraction type, eg. tags.ReasonDoubleSign
+}
+
+// Tags implements Event
+func (e EventJail) Tags() sdk.Tags {
+	return sdk.NewTags(
+		tags.Jail, e.Validator.String(),
+		tags.JailReason, e.Reason,
+	)
+}
+
+// emitEvents returns the tags reporting the given events, in order
+func emitEvents(events ...Event) sdk.Tags {
+	resTags := sdk.EmptyTags()
+	for _, event := range events {
+		resTags = resTags.AppendTags(event.Tags())
+	}
+	return resTags
+}
@@ -0,0 +1,40 @@
+package slashing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/tags"
+)
+
+func TestEventTags(t *testing.T) {
+	consAddr := sdk.ConsAddress(pks[0].Address())
+	fraction := sdk.NewDecWithPrec(5, 2)
+
+	liveness := EventLiveness{consAddr, 12}
+	require.Equal(t, sdk.NewTags(
+		tags.Liveness, consAddr.String(),
+		tags.MissedBlocks, "12",
+	), liveness.Tags())
+
+	slash := EventSlash{consAddr, 100, fraction, tags.ReasonDoubleSign}
+	require.Equal(t, sdk.NewTags(
+		tags.Slash, consAddr.String(),
+		tags.SlashReason, tags.ReasonDoubleSign,
+		tags.SlashFraction, "0.050000000000000000",
+		tags.SlashPower, "100",
+	), slash.Tags())
+
+	jail := EventJail{consAddr, tags.ReasonMissingSignature}
+	require.Equal(t, sdk.NewTags(
+		tags.Jail, consAddr.String(),
+		tags.JailReason, tags.ReasonMissingSignature,
+	), jail.Tags())
+
+	// the events are reported in order, each one starting with its type key
+	require.Equal(t, liveness.Tags().AppendTags(slash.Tags()).AppendTags(jail.Tags()),
+		emitEvents(liveness, slash, jail))
+	require.Empty(t, emitEvents())
+}
@@ -12,16 +12,18 @@ type GenesisState struct {
 	Params       Params                          `json:"params"`
 	SigningInfos map[string]ValidatorSigningInfo `json:"signing_infos"`
 	MissedBlocks map[string][]MissedBlock        `json:"missed_blocks"`
+	SlashEvents  []SlashEvent                    `json:"slash_events"`
 }
 
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(params Params, signingInfos map[string]ValidatorSigningInfo,
-	missedBlocks map[string][]MissedBlock) GenesisState {
+	missedBlocks map[string][]MissedBlock, slashEvents []SlashEvent) GenesisState {
 
 	return GenesisState{
 		Params:       params,
 		SigningInfos: signingInfos,
 		MissedBlocks: missedBlocks,
+		SlashEvents:  slashEvents,
 	}
 }
 
@@ -37,6 +39,7 @@ func DefaultGenesisState() GenesisState {
 		Params:       DefaultParams(),
 		SigningInfos: make(map[string]ValidatorSigningInfo),
 		MissedBlocks: make(map[string][]MissedBlock),
+		SlashEvents:  []SlashEvent{},
 	}
 }
 
@@ -44,17 +47,17 @@ func DefaultGenesisState() GenesisState {
 func ValidateGenesis(data GenesisState) error {
 	downtime := data.Params.SlashFractionDowntime
 	if downtime.IsNegative() || downtime.GT(sdk.OneDec()) {
-		return fmt.Errorf("Slashing fraction downtime should be less than or equal to one and greater than zero, is %s", downtime.String())
+		return fmt.Errorf("Slashing fraction downtime should be between zero and one, is %s", downtime.String())
 	}
 
 	dblSign := data.Params.SlashFractionDoubleSign
 	if dblSign.IsNegative() || dblSign.GT(sdk.OneDec()) {
-		return fmt.Errorf("Slashing fraction double sign should be less than or equal to one and greater than zero, is %s", dblSign.String())
+		return fmt.Errorf("Slashing fraction double sign should be between zero and one, is %s", dblSign.String())
 	}
 
 	minSign := data.Params.MinSignedPerWindow
 	if minSign.IsNegative() || minSign.GT(sdk.OneDec()) {
-		return fmt.Errorf("Min signed per window should be less than or equal to one and greater than zero, is %s", minSign.String())
+		return fmt.Errorf("Min signed per window should be between zero and one, is %s", minSign.String())
 	}
 
 	maxEvidence := data.Params.MaxEvidenceAge
@@ -72,6 +75,35 @@ func ValidateGenesis(data GenesisState) error {
 		return fmt.Errorf("Signed blocks window must be at least 10, is %d", signedWindow)
 	}
 
+	for addr := range data.SigningInfos {
+		if _, err := sdk.ConsAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("Invalid signing info address %s: %s", addr, err)
+		}
+	}
+
+	for addr, array := range data.MissedBlocks {
+		if _, err := sdk.ConsAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("Invalid missed blocks address %s: %s", addr, err)
+		}
+		for _, missed := range array {
+			if missed.Index < 0 || missed.Index >= signedWindow {
+				return fmt.Errorf("Missed block index %d of %s is out of the signed blocks window", missed.Index, addr)
+			}
+		}
+	}
+
+	for _, event := range data.SlashEvents {
+		if event.Validator.Empty() {
+			return fmt.Errorf("Slash event at height %d has no validator", event.Height)
+		}
+		if event.Height < 0 {
+			return fmt.Errorf("Slash event of %s has a negative height %d", event.Validator, event.Height)
+		}
+		if event.Fraction.IsNegative() || event.Fraction.GT(sdk.OneDec()) {
+			return fmt.Errorf("Slash event fraction of %s should be between zero and one, is %s", event.Validator, event.Fraction)
+		}
+	}
+
 	return nil
 }
 
@@ -81,6 +113,7 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, stakingKeeper StakingKeeper, da
 	stakingKeeper.IterateValidators(ctx,
 		func(index int64, validator sdk.Validator) bool {
 			keeper.addPubkey(ctx, validator.GetConsPubKey())
+			keeper.setValidatorAddresses(ctx, validator.GetConsAddr(), validator.GetOperator())
 			return false
 		},
 	)
@@ -103,15 +136,18 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, stakingKeeper StakingKeeper, da
 		}
 	}
 
-	keeper.paramspace.SetParamSet(ctx, &data.Params)
+	for _, event := range data.SlashEvents {
+		keeper.SetSlashEvent(ctx, event)
+	}
+
+	keeper.SetParams(ctx, data.Params)
 }
 
 // ExportGenesis writes the current store values
 // to a genesis file, which can be imported again
 // with InitGenesis
 func ExportGenesis(ctx sdk.Context, keeper Keeper) (data GenesisState) {
-	var params Params
-	keeper.paramspace.GetParamSet(ctx, &params)
+	params := keeper.GetParams(ctx)
 
 	signingInfos := make(map[string]ValidatorSigningInfo)
 	missedBlocks := make(map[string][]MissedBlock)
@@ -129,9 +165,16 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) (data GenesisState) {
 		return false
 	})
 
+	slashEvents := []SlashEvent{}
+	keeper.IterateAllSlashEvents(ctx, func(event SlashEvent) (stop bool) {
+		slashEvents = append(slashEvents, event)
+		return false
+	})
+
 	return GenesisState{
 		Params:       params,
 		SigningInfos: signingInfos,
 		MissedBlocks: missedBlocks,
+		SlashEvents:  slashEvents,
 	}
 }
@@ -0,0 +1,62 @@
+package slashing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestExportAndInitGenesis(t *testing.T) {
+	ctx, _, _, _, keeper := createTestInput(t, keeperTestParams())
+
+	consAddr := sdk.ConsAddress(pks[0].Address())
+	info := NewValidatorSigningInfo(consAddr, 3, 5, time.Unix(100, 0).UTC(), false, 2)
+	keeper.SetValidatorSigningInfo(ctx, consAddr, info)
+	keeper.setValidatorMissedBlockBitArray(ctx, consAddr, 1, true)
+	keeper.setValidatorMissedBlockBitArray(ctx, consAddr, 4, true)
+	keeper.SetSlashEvent(ctx, NewSlashEvent(consAddr, 7, sdk.NewDecWithPrec(1, 2), sdk.NewInt(10), "missing_signature"))
+
+	genState := ExportGenesis(ctx, keeper)
+	require.NoError(t, ValidateGenesis(genState))
+	require.Equal(t, info, genState.SigningInfos[consAddr.String()])
+	require.Equal(t, []MissedBlock{{1, true}, {4, true}}, genState.MissedBlocks[consAddr.String()])
+	require.Len(t, genState.SlashEvents, 1)
+
+	// the downtime counters survive an export and import
+	ctx2, _, sk2, _, keeper2 := createTestInput(t, DefaultParams())
+	InitGenesis(ctx2, keeper2, sk2, genState)
+	require.Equal(t, genState, ExportGenesis(ctx2, keeper2))
+	require.Equal(t, keeperTestParams(), keeper2.GetParams(ctx2))
+
+	genState.MissedBlocks[consAddr.String()] = []MissedBlock{{genState.Params.SignedBlocksWindow, true}}
+	require.Error(t, ValidateGenesis(genState))
+}
+
+func TestValidateGenesisSlashEventFraction(t *testing.T) {
+	consAddr := sdk.ConsAddress(pks[0].Address())
+
+	tests := []struct {
+		fraction  sdk.Dec
+		expectErr bool
+	}{
+		{sdk.ZeroDec(), false},
+		{sdk.NewDecWithPrec(1, 2), false},
+		{sdk.OneDec(), false},
+		{sdk.NewDecWithPrec(-1, 2), true},
+		{sdk.NewDecWithPrec(101, 2), true},
+	}
+
+	for _, tc := range tests {
+		genState := DefaultGenesisState()
+		genState.SlashEvents = []SlashEvent{NewSlashEvent(consAddr, 7, tc.fraction, sdk.NewInt(10), "missing_signature")}
+
+		if tc.expectErr {
+			require.Error(t, ValidateGenesis(genState), tc.fraction.String())
+		} else {
+			require.NoError(t, ValidateGenesis(genState), tc.fraction.String())
+		}
+	}
+}
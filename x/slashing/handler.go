@@ -46,14 +46,14 @@ func handleMsgUnjail(ctx sdk.Context, msg MsgUnjail, k Keeper) sdk.Result {
 
 	consAddr := sdk.ConsAddress(validator.GetConsPubKey().Address())
 
-	info, found := k.getValidatorSigningInfo(ctx, consAddr)
+	info, found := k.GetValidatorSigningInfo(ctx, consAddr)
 	if !found {
-		return ErrNoValidatorForAddress(k.codespace).Result()
+		return ErrNoSigningInfoFound(k.codespace, consAddr).Result()
 	}
 
 	// cannot be unjailed if tombstoned
 	if info.Tombstoned {
-		return ErrValidatorJailed(k.codespace).Result()
+		return ErrValidatorTombstoned(k.codespace).Result()
 	}
 
 	// cannot be unjailed until out of jail
@@ -63,6 +63,7 @@ func handleMsgUnjail(ctx sdk.Context, msg MsgUnjail, k Keeper) sdk.Result {
 
 	// unjail the validator
 	k.validatorSet.Unjail(ctx, consAddr)
+	k.AfterValidatorUnjailed(ctx, consAddr)
 
 	tags := sdk.NewTags(
 		tags.Category, tags.TxCategory,
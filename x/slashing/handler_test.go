@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
@@ -35,6 +36,39 @@ func TestCannotUnjailUnlessJailed(t *testing.T) {
 	require.EqualValues(t, DefaultCodespace, got.Codespace)
 }
 
+func TestCannotUnjailUntilJailTimeElapsed(t *testing.T) {
+	// initial setup
+	ctx, _, sk, _, keeper := createTestInput(t, DefaultParams())
+	slh := NewHandler(keeper)
+	amt := sdk.TokensFromTendermintPower(100)
+	addr, val := addrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	got := staking.NewHandler(sk)(ctx, NewTestMsgCreateValidator(addr, val, amt))
+	require.True(t, got.IsOK(), "%v", got)
+	staking.EndBlocker(ctx, sk)
+
+	// jail the validator for an hour
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(0, 0)})
+	info, found := keeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	info.JailedUntil = ctx.BlockHeader().Time.Add(time.Hour)
+	keeper.SetValidatorSigningInfo(ctx, consAddr, info)
+	sk.Jail(ctx, consAddr)
+
+	got = slh(ctx, NewMsgUnjail(addr))
+	require.EqualValues(t, CodeValidatorJailed, got.Code)
+
+	// the signing info is required to unjail
+	ctx.KVStore(keeper.storeKey).Delete(GetValidatorSigningInfoKey(consAddr))
+	got = slh(ctx.WithBlockHeader(abci.Header{Time: info.JailedUntil}), NewMsgUnjail(addr))
+	require.EqualValues(t, CodeMissingSigningInfo, got.Code)
+
+	keeper.SetValidatorSigningInfo(ctx, consAddr, info)
+	got = slh(ctx.WithBlockHeader(abci.Header{Time: info.JailedUntil}), NewMsgUnjail(addr))
+	require.True(t, got.IsOK(), "%v", got)
+	require.False(t, sk.Validator(ctx, addr).IsJailed())
+}
+
 func TestCannotUnjailUnlessMeetMinSelfDelegation(t *testing.T) {
 	// initial setup
 	ctx, ck, sk, _, keeper := createTestInput(t, DefaultParams())
@@ -61,12 +95,14 @@ func TestCannotUnjailUnlessMeetMinSelfDelegation(t *testing.T) {
 	// assert non-jailed validator can't be unjailed
 	got = slh(ctx, NewMsgUnjail(addr))
 	require.False(t, got.IsOK(), "allowed unjail of validator with less than MinSelfDelegation")
-	require.EqualValues(t, CodeValidatorNotJailed, got.Code)
+	require.EqualValues(t, CodeSelfDelegationTooLow, got.Code)
 	require.EqualValues(t, DefaultCodespace, got.Codespace)
 }
 
 func TestJailedValidatorDelegations(t *testing.T) {
 	ctx, _, stakingKeeper, _, slashingKeeper := createTestInput(t, DefaultParams())
+	hooks := &mockSlashingHooks{}
+	slashingKeeper.SetHooks(hooks)
 
 	stakingParams := stakingKeeper.GetParams(ctx)
 	stakingParams.UnbondingTime = 0
@@ -121,6 +157,7 @@ func TestJailedValidatorDelegations(t *testing.T) {
 	// verify the validator can now unjail itself
 	got = NewHandler(slashingKeeper)(ctx, NewMsgUnjail(valAddr))
 	require.True(t, got.IsOK(), "expected jailed validator to be able to unjail, got: %v", got)
+	require.Equal(t, []sdk.ConsAddress{consAddr}, hooks.unjailed)
 }
 
 func TestInvalidMsg(t *testing.T) {
@@ -10,10 +10,15 @@ import (
 )
 
 func (k Keeper) AfterValidatorBonded(ctx sdk.Context, address sdk.ConsAddress, _ sdk.ValAddress) {
-	// Update the signing info start height or create a new signing info
-	_, found := k.getValidatorSigningInfo(ctx, address)
-	if !found {
-		signingInfo := NewValidatorSigningInfo(
+	// Update the signing info start height or create a new signing info. A
+	// validator (re)bonded in the middle of a signed blocks window is thus not
+	// punished for downtime before a full window has passed, and the blocks it
+	// could not have signed have been overwritten in its missed blocks bitmap.
+	signingInfo, found := k.GetValidatorSigningInfo(ctx, address)
+	if found {
+		signingInfo.StartHeight = ctx.BlockHeight()
+	} else {
+		signingInfo = NewValidatorSigningInfo(
 			address,
 			ctx.BlockHeight(),
 			0,
@@ -21,19 +26,23 @@ func (k Keeper) AfterValidatorBonded(ctx sdk.Context, address sdk.ConsAddress, _
 			false,
 			0,
 		)
-		k.SetValidatorSigningInfo(ctx, address, signingInfo)
 	}
+	k.SetValidatorSigningInfo(ctx, address, signingInfo)
 }
 
-// When a validator is created, add the address-pubkey relation.
+// When a validator is created, add the address-pubkey relation and the
+// consensus address to operator address index.
 func (k Keeper) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) {
 	validator := k.validatorSet.Validator(ctx, valAddr)
 	k.addPubkey(ctx, validator.GetConsPubKey())
+	k.setValidatorAddresses(ctx, sdk.ConsAddress(validator.GetConsPubKey().Address()), valAddr)
 }
 
-// When a validator is removed, delete the address-pubkey relation.
+// When a validator is removed, delete the address-pubkey relation and the
+// consensus address to operator address index.
 func (k Keeper) AfterValidatorRemoved(ctx sdk.Context, address sdk.ConsAddress) {
 	k.deleteAddrPubkeyRelation(ctx, crypto.Address(address))
+	k.deleteValidatorAddresses(ctx, address)
 }
 
 //_________________________________________________________________________________________
@@ -0,0 +1,112 @@
+package slashing
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/tags"
+)
+
+// Infraction defines the punishment of a custom infraction type, eg. an oracle
+// misreporting, registered by another module
+type Infraction struct {
+	SlashFraction sdk.Dec       `json:"slash_fraction"` // fraction of the validator tokens slashed
+	JailDuration  time.Duration `json:"jail_duration"`  // validator jail duration, not jailed if zero
+}
+
+// NewInfraction creates a new Infraction object
+func NewInfraction(slashFraction sdk.Dec, jailDuration time.Duration) Infraction {
+	return Infraction{
+		SlashFraction: slashFraction,
+		JailDuration:  jailDuration,
+	}
+}
+
+// RegisterInfraction registers a custom infraction type with its punishment,
+// validators are then punished for it with SlashWithInfraction. As the keeper
+// is passed by value, it must be called when the app is built, and panics if
+// the infraction type is already registered or the punishment is invalid.
+func (k Keeper) RegisterInfraction(infractionType string, infraction Infraction) {
+	switch {
+	case infractionType == "":
+		panic("infraction type cannot be empty")
+	case infractionType == tags.ReasonDoubleSign || infractionType == tags.ReasonMissingSignature:
+		panic(fmt.Sprintf("infraction type %s is handled by the slashing module", infractionType))
+	case infraction.SlashFraction.IsNil() || infraction.SlashFraction.IsNegative() || infraction.SlashFraction.GT(sdk.OneDec()):
+		panic(fmt.Sprintf("invalid slash fraction for infraction type %s: %s", infractionType, infraction.SlashFraction))
+	case infraction.JailDuration < 0:
+		panic(fmt.Sprintf("negative jail duration for infraction type %s: %s", infractionType, infraction.JailDuration))
+	}
+
+	if _, ok := k.infractions[infractionType]; ok {
+		panic(fmt.Sprintf("infraction type %s already registered", infractionType))
+	}
+	k.infractions[infractionType] = infraction
+}
+
+// GetInfraction returns the punishment of a registered infraction type
+func (k Keeper) GetInfraction(infractionType string) (infraction Infraction, found bool) {
+	infraction, found = k.infractions[infractionType]
+	return
+}
+
+// SlashWithInfraction slashes the validator with the given consensus address
+// for a registered infraction type committed at the given height, while it
+// had the given power, and jails it for the infraction jail duration.
+// Unbonded and tombstoned validators are not punished. It returns the tags of
+// the slashing of the validator, if any.
+func (k Keeper) SlashWithInfraction(ctx sdk.Context, consAddr sdk.ConsAddress, infractionType string,
+	power, height int64) (sdk.Tags, sdk.Error) {
+
+	logger := k.Logger(ctx)
+
+	infraction, found := k.GetInfraction(infractionType)
+	if !found {
+		return nil, ErrUnknownInfraction(k.codespace, infractionType)
+	}
+
+	validator := k.validatorSet.ValidatorByConsAddr(ctx, consAddr)
+	if validator == nil {
+		return nil, ErrNoValidatorForAddress(k.codespace)
+	}
+	if validator.GetStatus() == sdk.Unbonded || k.IsTombstoned(ctx, consAddr) {
+		logger.Info(fmt.Sprintf("Ignored %s infraction from %s at height %d, validator unbonded or tombstoned",
+			infractionType, consAddr, height))
+		return nil, nil
+	}
+
+	logger.Info(fmt.Sprintf("Confirmed %s infraction from %s at height %d", infractionType, consAddr, height))
+
+	// the stake distribution which committed the infraction is the one of
+	// ValidatorUpdateDelay blocks before, as for double signs
+	distributionHeight := height - sdk.ValidatorUpdateDelay
+
+	k.validatorSet.Slash(ctx, consAddr, distributionHeight, power, infraction.SlashFraction)
+	k.metrics.SlashedTokens.With("validator", validatorLabel(consAddr), "reason", infractionType).
+		Add(slashedTokens(power, infraction.SlashFraction))
+	k.recordSlashEvent(ctx, consAddr, power, infraction.SlashFraction, infractionType)
+	k.AfterValidatorSlashed(ctx, consAddr, infraction.SlashFraction, infractionType)
+	events := []Event{EventSlash{consAddr, power, infraction.SlashFraction, infractionType}}
+
+	if infraction.JailDuration > 0 {
+		if !validator.IsJailed() {
+			k.validatorSet.Jail(ctx, consAddr)
+			k.metrics.Jails.With("validator", validatorLabel(consAddr), "reason", infractionType).Add(1)
+			k.AfterValidatorJailed(ctx, consAddr, infractionType)
+			events = append(events, EventJail{consAddr, infractionType})
+		}
+
+		// never shorten a longer jail period, eg. of a downtime
+		signInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
+		if found {
+			jailedUntil := ctx.BlockHeader().Time.Add(infraction.JailDuration)
+			if jailedUntil.After(signInfo.JailedUntil) {
+				signInfo.JailedUntil = jailedUntil
+				k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+			}
+		}
+	}
+
+	return emitEvents(events...), nil
+}
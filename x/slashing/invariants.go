@@ -0,0 +1,92 @@
+package slashing
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// register all slashing invariants
+func RegisterInvariants(ir sdk.InvariantRouter, k Keeper) {
+	ir.RegisterRoute(ModuleName, "bonded-signing-info",
+		BondedSigningInfoInvariant(k))
+	ir.RegisterRoute(ModuleName, "missed-blocks-counter",
+		MissedBlocksCounterInvariant(k))
+	ir.RegisterRoute(ModuleName, "tombstoned-not-bonded",
+		TombstonedNotBondedInvariant(k))
+}
+
+// AllInvariants runs all invariants of the slashing module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		err := BondedSigningInfoInvariant(k)(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = MissedBlocksCounterInvariant(k)(ctx)
+		if err != nil {
+			return err
+		}
+
+		return TombstonedNotBondedInvariant(k)(ctx)
+	}
+}
+
+// BondedSigningInfoInvariant checks that every bonded validator has a signing info
+func BondedSigningInfoInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (err error) {
+		k.validatorSet.IterateValidators(ctx, func(_ int64, validator sdk.Validator) (stop bool) {
+			if validator.GetStatus() != sdk.Bonded {
+				return false
+			}
+
+			consAddr := sdk.ConsAddress(validator.GetConsPubKey().Address())
+			if _, found := k.GetValidatorSigningInfo(ctx, consAddr); !found {
+				err = fmt.Errorf("bonded validator %s has no signing info", validator.GetOperator())
+				return true
+			}
+			return false
+		})
+		return err
+	}
+}
+
+// MissedBlocksCounterInvariant checks that the missed blocks counter of every
+// signing info is within the signed blocks window
+func MissedBlocksCounterInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (err error) {
+		window := k.SignedBlocksWindow(ctx)
+		k.IterateValidatorSigningInfos(ctx, func(address sdk.ConsAddress, info ValidatorSigningInfo) (stop bool) {
+			if info.MissedBlocksCounter < 0 || info.MissedBlocksCounter > window {
+				err = fmt.Errorf("missed blocks counter of validator %s is %d, should be between 0 and the signed blocks window %d",
+					address, info.MissedBlocksCounter, window)
+				return true
+			}
+			return false
+		})
+		return err
+	}
+}
+
+// TombstonedNotBondedInvariant checks that no tombstoned validator is bonded.
+// A validator tombstoned during the block is jailed and only unbonded by the
+// staking end blocker, so tombstoned validators are only required to be
+// jailed while still bonded.
+func TombstonedNotBondedInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (err error) {
+		k.IterateValidatorSigningInfos(ctx, func(address sdk.ConsAddress, info ValidatorSigningInfo) (stop bool) {
+			if !info.Tombstoned {
+				return false
+			}
+
+			validator := k.validatorSet.ValidatorByConsAddr(ctx, address)
+			if validator != nil && validator.GetStatus() == sdk.Bonded && !validator.IsJailed() {
+				err = fmt.Errorf("tombstoned validator %s is bonded", validator.GetOperator())
+				return true
+			}
+			return false
+		})
+		return err
+	}
+}
@@ -0,0 +1,46 @@
+package slashing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func TestInvariants(t *testing.T) {
+	ctx, _, sk, _, keeper := createTestInput(t, keeperTestParams())
+	amt := sdk.TokensFromTendermintPower(100)
+	addr, val := addrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	got := staking.NewHandler(sk)(ctx, NewTestMsgCreateValidator(addr, val, amt))
+	require.True(t, got.IsOK(), "%v", got)
+	staking.EndBlocker(ctx, sk)
+	require.NoError(t, AllInvariants(keeper)(ctx))
+
+	info, found := keeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+
+	// missed blocks counter out of the window
+	broken := info
+	broken.MissedBlocksCounter = keeper.SignedBlocksWindow(ctx) + 1
+	keeper.SetValidatorSigningInfo(ctx, consAddr, broken)
+	require.Error(t, MissedBlocksCounterInvariant(keeper)(ctx))
+
+	// tombstoned but bonded and not jailed
+	broken = info
+	broken.Tombstoned = true
+	keeper.SetValidatorSigningInfo(ctx, consAddr, broken)
+	require.Error(t, TombstonedNotBondedInvariant(keeper)(ctx))
+
+	// jailed validators are unbonded by the staking end blocker
+	sk.Jail(ctx, consAddr)
+	require.NoError(t, TombstonedNotBondedInvariant(keeper)(ctx))
+	sk.Unjail(ctx, consAddr)
+
+	// bonded without signing info
+	ctx.KVStore(keeper.storeKey).Delete(GetValidatorSigningInfoKey(consAddr))
+	require.Error(t, BondedSigningInfoInvariant(keeper)(ctx))
+	require.Error(t, AllInvariants(keeper)(ctx))
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/slashing/tags"
 )
 
 // Keeper of the slashing store
@@ -18,6 +19,9 @@ type Keeper struct {
 	cdc          *codec.Codec
 	validatorSet sdk.ValidatorSet
 	paramspace   params.Subspace
+	metrics      *Metrics
+	hooks        SlashingHooks
+	infractions  map[string]Infraction // custom infraction types, shared by the keeper copies
 
 	// codespace
 	codespace sdk.CodespaceType
@@ -30,17 +34,27 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, vs sdk.ValidatorSet, paramspa
 		cdc:          cdc,
 		validatorSet: vs,
 		paramspace:   paramspace.WithKeyTable(ParamKeyTable()),
+		metrics:      NopMetrics(),
+		infractions:  make(map[string]Infraction),
 		codespace:    codespace,
 	}
 	return keeper
 }
 
+// WithMetrics returns a copy of the keeper reporting to the given metrics, eg.
+// PrometheusMetrics, instead of discarding them
+func (k Keeper) WithMetrics(metrics *Metrics) Keeper {
+	k.metrics = metrics
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger { return ctx.Logger().With("module", "x/slashing") }
 
 // handle a validator signing two blocks at the same height
 // power: power of the double-signing validator at the height of infraction
-func (k Keeper) handleDoubleSign(ctx sdk.Context, addr crypto.Address, infractionHeight int64, timestamp time.Time, power int64) {
+// returns the tags of the slashing and tombstoning of the validator, if any
+func (k Keeper) handleDoubleSign(ctx sdk.Context, addr crypto.Address, infractionHeight int64, timestamp time.Time, power int64) sdk.Tags {
 	logger := k.Logger(ctx)
 
 	// calculate the age of the evidence
@@ -60,14 +74,14 @@ func (k Keeper) handleDoubleSign(ctx sdk.Context, addr crypto.Address, infractio
 		// allowable but none of the disallowed evidence types.  Instead of
 		// getting this coordination right, it is easier to relax the
 		// constraints and ignore evidence that cannot be handled.
-		return
+		return nil
 	}
 
 	// Reject evidence if the double-sign is too old
 	if age > k.MaxEvidenceAge(ctx) {
 		logger.Info(fmt.Sprintf("Ignored double sign from %s at height %d, age of %d past max age of %d",
 			pubkey.Address(), infractionHeight, age, k.MaxEvidenceAge(ctx)))
-		return
+		return nil
 	}
 
 	// Get validator and signing info
@@ -76,11 +90,11 @@ func (k Keeper) handleDoubleSign(ctx sdk.Context, addr crypto.Address, infractio
 		// Defensive.
 		// Simulation doesn't take unbonding periods into account, and
 		// Tendermint might break this assumption at some point.
-		return
+		return nil
 	}
 
 	// fetch the validator signing info
-	signInfo, found := k.getValidatorSigningInfo(ctx, consAddr)
+	signInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
 	if !found {
 		panic(fmt.Sprintf("Expected signing info for validator %s but not found", consAddr))
 	}
@@ -88,7 +102,7 @@ func (k Keeper) handleDoubleSign(ctx sdk.Context, addr crypto.Address, infractio
 	// validator is already tombstoned
 	if signInfo.Tombstoned {
 		logger.Info(fmt.Sprintf("Ignored double sign from %s at height %d, validator already tombstoned", pubkey.Address(), infractionHeight))
-		return
+		return nil
 	}
 
 	// double sign confirmed
@@ -109,27 +123,33 @@ func (k Keeper) handleDoubleSign(ctx sdk.Context, addr crypto.Address, infractio
 	// ABCI, and now received as evidence.
 	// The fraction is passed in to separately to slash unbonding and rebonding delegations.
 	k.validatorSet.Slash(ctx, consAddr, distributionHeight, power, fraction)
+	k.metrics.SlashedTokens.With("validator", validatorLabel(consAddr), "reason", tags.ReasonDoubleSign).
+		Add(slashedTokens(power, fraction))
+	k.recordSlashEvent(ctx, consAddr, power, fraction, tags.ReasonDoubleSign)
+	k.AfterValidatorSlashed(ctx, consAddr, fraction, tags.ReasonDoubleSign)
+	events := []Event{EventSlash{consAddr, power, fraction, tags.ReasonDoubleSign}}
 
 	// Jail validator if not already jailed
 	// begin unbonding validator if not already unbonding (tombstone)
 	if !validator.IsJailed() {
 		k.validatorSet.Jail(ctx, consAddr)
+		k.metrics.Jails.With("validator", validatorLabel(consAddr), "reason", tags.ReasonDoubleSign).Add(1)
+		k.AfterValidatorJailed(ctx, consAddr, tags.ReasonDoubleSign)
+		events = append(events, EventJail{consAddr, tags.ReasonDoubleSign})
 	}
 
-	// Set tombstoned to be true
-	signInfo.Tombstoned = true
-
-	// Set jailed until to be forever (max time)
-	signInfo.JailedUntil = DoubleSignJailEndTime
+	// Set tombstoned to be true and jailed until to be forever (max time)
+	k.Tombstone(ctx, consAddr)
 
-	// Set validator signing info
-	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+	return emitEvents(events...).AppendTag(tags.Tombstone, consAddr.String())
 }
 
 // handle a validator signature, must be called once per validator per block
+// returns the tags of the slashing of the validator for downtime, if any
 // TODO refactor to take in a consensus address, additionally should maybe just take in the pubkey too
-func (k Keeper) handleValidatorSignature(ctx sdk.Context, addr crypto.Address, power int64, signed bool) {
+func (k Keeper) handleValidatorSignature(ctx sdk.Context, addr crypto.Address, power int64, signed bool) sdk.Tags {
 	logger := k.Logger(ctx)
+	resTags := sdk.EmptyTags()
 	height := ctx.BlockHeight()
 	consAddr := sdk.ConsAddress(addr)
 	pubkey, err := k.getPubkey(ctx, addr)
@@ -138,7 +158,7 @@ func (k Keeper) handleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 	}
 
 	// fetch signing info
-	signInfo, found := k.getValidatorSigningInfo(ctx, consAddr)
+	signInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
 	if !found {
 		panic(fmt.Sprintf("Expected signing info for validator %s but not found", consAddr))
 	}
@@ -168,6 +188,7 @@ func (k Keeper) handleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 
 	if missed {
 		logger.Info(fmt.Sprintf("Absent validator %s (%v) at height %d, %d missed, threshold %d", addr, pubkey, height, signInfo.MissedBlocksCounter, k.MinSignedPerWindow(ctx)))
+		resTags = resTags.AppendTags(emitEvents(EventLiveness{consAddr, signInfo.MissedBlocksCounter}))
 	}
 
 	minHeight := signInfo.StartHeight + k.SignedBlocksWindow(ctx)
@@ -190,12 +211,23 @@ func (k Keeper) handleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 			distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 			k.validatorSet.Slash(ctx, consAddr, distributionHeight, power, k.SlashFractionDowntime(ctx))
 			k.validatorSet.Jail(ctx, consAddr)
+			k.metrics.SlashedTokens.With("validator", validatorLabel(consAddr), "reason", tags.ReasonMissingSignature).
+				Add(slashedTokens(power, k.SlashFractionDowntime(ctx)))
+			k.metrics.Jails.With("validator", validatorLabel(consAddr), "reason", tags.ReasonMissingSignature).Add(1)
+			k.recordSlashEvent(ctx, consAddr, power, k.SlashFractionDowntime(ctx), tags.ReasonMissingSignature)
+			k.AfterValidatorSlashed(ctx, consAddr, k.SlashFractionDowntime(ctx), tags.ReasonMissingSignature)
+			k.AfterValidatorJailed(ctx, consAddr, tags.ReasonMissingSignature)
 			signInfo.JailedUntil = ctx.BlockHeader().Time.Add(k.DowntimeJailDuration(ctx))
 
 			// We need to reset the counter & array so that the validator won't be immediately slashed for downtime upon rebonding.
 			signInfo.MissedBlocksCounter = 0
 			signInfo.IndexOffset = 0
 			k.clearValidatorMissedBlockBitArray(ctx, consAddr)
+
+			resTags = resTags.AppendTags(emitEvents(
+				EventSlash{consAddr, power, k.SlashFractionDowntime(ctx), tags.ReasonMissingSignature},
+				EventJail{consAddr, tags.ReasonMissingSignature},
+			))
 		} else {
 			// Validator was (a) not found or (b) already jailed, don't slash
 			logger.Info(fmt.Sprintf("Validator %s would have been slashed for downtime, but was either not found in store or already jailed",
@@ -205,6 +237,15 @@ func (k Keeper) handleValidatorSignature(ctx sdk.Context, addr crypto.Address, p
 
 	// Set the updated signing info
 	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+	k.metrics.MissedBlocks.With("validator", validatorLabel(consAddr)).Set(float64(signInfo.MissedBlocksCounter))
+
+	return resTags
+}
+
+// slashedTokens approximates the tokens slashed from a validator with the
+// given power, disregarding its unbonding delegations and redelegations
+func slashedTokens(power int64, fraction sdk.Dec) float64 {
+	return float64(fraction.MulInt(sdk.TokensFromTendermintPower(power)).TruncateInt64())
 }
 
 func (k Keeper) addPubkey(ctx sdk.Context, pubkey crypto.PubKey) {
@@ -232,3 +273,61 @@ func (k Keeper) deleteAddrPubkeyRelation(ctx sdk.Context, addr crypto.Address) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(getAddrPubkeyRelationKey(addr))
 }
+
+// GetValidatorOperator returns the operator address of the validator with the
+// given consensus address.
+func (k Keeper) GetValidatorOperator(ctx sdk.Context, consAddr sdk.ConsAddress) (sdk.ValAddress, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetConsAddrOperatorKey(consAddr))
+	if bz == nil {
+		return nil, false
+	}
+	return sdk.ValAddress(bz), true
+}
+
+// GetValidatorConsAddress returns the consensus address of the validator with
+// the given operator address.
+func (k Keeper) GetValidatorConsAddress(ctx sdk.Context, valAddr sdk.ValAddress) (sdk.ConsAddress, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetOperatorConsAddrKey(valAddr))
+	if bz == nil {
+		return nil, false
+	}
+	return sdk.ConsAddress(bz), true
+}
+
+// RedelegationRestriction forbids redelegating to a tombstoned validator,
+// which can never be unjailed. It is meant to be set as the staking keeper
+// redelegation restriction.
+func (k Keeper) RedelegationRestriction(ctx sdk.Context, _ sdk.AccAddress, _, valDstAddr sdk.ValAddress) sdk.Error {
+	consAddr, found := k.GetValidatorConsAddress(ctx, valDstAddr)
+	if !found {
+		return nil
+	}
+	info, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	if found && info.Tombstoned {
+		return ErrRedelegationToTombstoned(k.codespace)
+	}
+	return nil
+}
+
+// set the bidirectional index between the consensus and operator addresses of
+// a validator
+func (k Keeper) setValidatorAddresses(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetConsAddrOperatorKey(consAddr), valAddr.Bytes())
+	store.Set(GetOperatorConsAddrKey(valAddr), consAddr.Bytes())
+}
+
+// delete the bidirectional index between the consensus and operator addresses
+// of a validator
+func (k Keeper) deleteValidatorAddresses(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	valAddr, found := k.GetValidatorOperator(ctx, consAddr)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetConsAddrOperatorKey(consAddr))
+	store.Delete(GetOperatorConsAddrKey(valAddr))
+}
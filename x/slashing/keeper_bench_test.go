@@ -0,0 +1,48 @@
+package slashing
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func BenchmarkSetValidatorMissedBlockBitArray(b *testing.B) {
+	ctx, _, _, _, keeper := createTestInput(b, keeperTestParams())
+	consAddr := sdk.ConsAddress(addrs[0])
+	window := keeper.SignedBlocksWindow(ctx)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		index := int64(i) % window
+		keeper.setValidatorMissedBlockBitArray(ctx, consAddr, index, i%2 == 0)
+	}
+}
+
+func BenchmarkGetValidatorMissedBlockBitArray(b *testing.B) {
+	ctx, _, _, _, keeper := createTestInput(b, keeperTestParams())
+	consAddr := sdk.ConsAddress(addrs[0])
+	window := keeper.SignedBlocksWindow(ctx)
+	for index := int64(0); index < window; index += 2 {
+		keeper.setValidatorMissedBlockBitArray(ctx, consAddr, index, true)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		keeper.getValidatorMissedBlockBitArray(ctx, consAddr, int64(i)%window)
+	}
+}
+
+func BenchmarkClearValidatorMissedBlockBitArray(b *testing.B) {
+	ctx, _, _, _, keeper := createTestInput(b, keeperTestParams())
+	consAddr := sdk.ConsAddress(addrs[0])
+	window := keeper.SignedBlocksWindow(ctx)
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		for index := int64(0); index < window; index += 2 {
+			keeper.setValidatorMissedBlockBitArray(ctx, consAddr, index, true)
+		}
+		b.StartTimer()
+		keeper.clearValidatorMissedBlockBitArray(ctx, consAddr)
+	}
+}
@@ -1,13 +1,16 @@
 package slashing
 
 import (
+	"strconv"
 	"testing"
 	"time"
 
+	"github.com/go-kit/kit/metrics/generic"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/tags"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
@@ -28,6 +31,13 @@ func TestHandleDoubleSign(t *testing.T) {
 
 	// initial setup
 	ctx, ck, sk, _, keeper := createTestInput(t, keeperTestParams())
+	metrics := &Metrics{
+		MissedBlocks:  generic.NewGauge("missed_blocks"),
+		Jails:         generic.NewCounter("jails"),
+		SlashedTokens: generic.NewCounter("slashed_tokens"),
+	}
+	hooks := &mockSlashingHooks{}
+	keeper = *keeper.WithMetrics(metrics).SetHooks(hooks)
 	// validator added pre-genesis
 	ctx = ctx.WithBlockHeight(-1)
 	power := int64(100)
@@ -48,7 +58,22 @@ func TestHandleDoubleSign(t *testing.T) {
 	oldTokens := sk.Validator(ctx, operatorAddr).GetTokens()
 
 	// double sign less than max age
-	keeper.handleDoubleSign(ctx, val.Address(), 0, time.Unix(0, 0), power)
+	resTags := keeper.handleDoubleSign(ctx, val.Address(), 0, time.Unix(0, 0), power)
+	consAddr := sdk.ConsAddress(val.Address()).String()
+	require.Equal(t, sdk.NewTags(
+		tags.Slash, consAddr,
+		tags.SlashReason, tags.ReasonDoubleSign,
+		tags.SlashFraction, keeper.SlashFractionDoubleSign(ctx).String(),
+		tags.SlashPower, strconv.FormatInt(power, 10),
+		tags.Jail, consAddr,
+		tags.JailReason, tags.ReasonDoubleSign,
+		tags.Tombstone, consAddr,
+	), resTags)
+	require.Equal(t, float64(1), metrics.Jails.(*generic.Counter).Value())
+	require.Equal(t, []string{tags.ReasonDoubleSign}, hooks.slashed)
+	require.Equal(t, []string{tags.ReasonDoubleSign}, hooks.jailed)
+	require.Equal(t, float64(keeper.SlashFractionDoubleSign(ctx).MulInt(amt).TruncateInt64()),
+		metrics.SlashedTokens.(*generic.Counter).Value())
 
 	// should be jailed
 	require.True(t, sk.Validator(ctx, operatorAddr).IsJailed())
@@ -57,8 +82,9 @@ func TestHandleDoubleSign(t *testing.T) {
 	newTokens := sk.Validator(ctx, operatorAddr).GetTokens()
 	require.True(t, newTokens.LT(oldTokens))
 
-	// New evidence
-	keeper.handleDoubleSign(ctx, val.Address(), 0, time.Unix(0, 0), power)
+	// New evidence, ignored as the validator is tombstoned
+	require.Empty(t, keeper.handleDoubleSign(ctx, val.Address(), 0, time.Unix(0, 0), power))
+	require.Len(t, hooks.slashed, 1)
 
 	// tokens should be the same (capped slash)
 	require.True(t, sk.Validator(ctx, operatorAddr).GetTokens().Equal(newTokens))
@@ -67,9 +93,15 @@ func TestHandleDoubleSign(t *testing.T) {
 	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(1, 0).Add(sk.GetParams(ctx).UnbondingTime)})
 
 	// Still shouldn't be able to unjail
+	require.True(t, keeper.IsTombstoned(ctx, sdk.ConsAddress(val.Address())))
 	msgUnjail := NewMsgUnjail(operatorAddr)
 	res := handleMsgUnjail(ctx, msgUnjail, keeper)
-	require.False(t, res.IsOK())
+	require.Equal(t, CodeValidatorTombstoned, res.Code)
+
+	// Nor to receive redelegations
+	err := keeper.RedelegationRestriction(ctx, sdk.AccAddress(addrs[1]), addrs[1], operatorAddr)
+	require.Equal(t, CodeTombstonedRedelegation, err.Code())
+	require.Nil(t, keeper.RedelegationRestriction(ctx, sdk.AccAddress(operatorAddr), operatorAddr, addrs[1]))
 
 	// Should be able to unbond now
 	del, _ := sk.GetDelegation(ctx, sdk.AccAddress(operatorAddr), operatorAddr)
@@ -81,6 +113,64 @@ func TestHandleDoubleSign(t *testing.T) {
 	require.True(t, res.IsOK())
 }
 
+// Test that a validator is punished for a custom infraction type
+func TestSlashWithInfraction(t *testing.T) {
+
+	// initial setup
+	ctx, _, sk, _, keeper := createTestInput(t, keeperTestParams())
+	hooks := &mockSlashingHooks{}
+	keeper.SetHooks(hooks)
+	power := int64(100)
+	amt := sdk.TokensFromTendermintPower(power)
+	operatorAddr, val := addrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	got := staking.NewHandler(sk)(ctx, NewTestMsgCreateValidator(operatorAddr, val, amt))
+	require.True(t, got.IsOK())
+	staking.EndBlocker(ctx, sk)
+
+	fraction := sdk.NewDecWithPrec(1, 2)
+	keeper.RegisterInfraction("oracle", NewInfraction(fraction, time.Hour))
+	infraction, found := keeper.GetInfraction("oracle")
+	require.True(t, found)
+	require.Equal(t, time.Hour, infraction.JailDuration)
+
+	// invalid registrations
+	require.Panics(t, func() { keeper.RegisterInfraction("oracle", NewInfraction(fraction, time.Hour)) })
+	require.Panics(t, func() { keeper.RegisterInfraction(tags.ReasonDoubleSign, NewInfraction(fraction, 0)) })
+	require.Panics(t, func() { keeper.RegisterInfraction("other", NewInfraction(sdk.NewDec(2), 0)) })
+	require.Panics(t, func() { keeper.RegisterInfraction("other", NewInfraction(fraction, -time.Hour)) })
+
+	_, err := keeper.SlashWithInfraction(ctx, consAddr, "other", power, 0)
+	require.Equal(t, CodeUnknownInfraction, err.Code())
+
+	// slash and jail the validator
+	ctx = ctx.WithBlockHeader(abci.Header{Time: time.Unix(100, 0)})
+	resTags, err := keeper.SlashWithInfraction(ctx, consAddr, "oracle", power, 0)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewTags(
+		tags.Slash, consAddr.String(),
+		tags.SlashReason, "oracle",
+		tags.SlashFraction, fraction.String(),
+		tags.SlashPower, "100",
+		tags.Jail, consAddr.String(),
+		tags.JailReason, "oracle",
+	), resTags)
+	require.Equal(t, []string{"oracle"}, hooks.slashed)
+	require.Equal(t, []string{"oracle"}, hooks.jailed)
+
+	validator := sk.Validator(ctx, operatorAddr)
+	require.True(t, validator.IsJailed())
+	require.Equal(t, amt.Sub(fraction.MulInt(amt).TruncateInt()), validator.GetTokens())
+
+	info, found := keeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.True(t, info.JailedUntil.Equal(time.Unix(100, 0).Add(time.Hour)))
+
+	events := keeper.GetSlashEvents(ctx, consAddr, 0, ctx.BlockHeight())
+	require.Len(t, events, 1)
+	require.Equal(t, "oracle", events[0].Reason)
+}
+
 // ______________________________________________________________
 
 // Test that a validator is slashed correctly
@@ -111,7 +201,7 @@ func TestPastMaxEvidenceAge(t *testing.T) {
 	oldPower := sk.Validator(ctx, operatorAddr).GetTendermintPower()
 
 	// double sign past max age
-	keeper.handleDoubleSign(ctx, val.Address(), 0, time.Unix(0, 0), power)
+	require.Empty(t, keeper.handleDoubleSign(ctx, val.Address(), 0, time.Unix(0, 0), power))
 
 	// should still be bonded
 	require.True(t, sk.Validator(ctx, operatorAddr).GetStatus() == sdk.Bonded)
@@ -129,6 +219,8 @@ func TestHandleAbsentValidator(t *testing.T) {
 	power := int64(100)
 	amt := sdk.TokensFromTendermintPower(power)
 	addr, val := addrs[0], pks[0]
+	hooks := &mockSlashingHooks{}
+	keeper.SetHooks(hooks)
 	sh := staking.NewHandler(sk)
 	slh := NewHandler(keeper)
 	got := sh(ctx, NewTestMsgCreateValidator(addr, val, amt))
@@ -142,7 +234,7 @@ func TestHandleAbsentValidator(t *testing.T) {
 	require.Equal(t, amt, sk.Validator(ctx, addr).GetBondedTokens())
 
 	// will exist since the validator has been bonded
-	info, found := keeper.getValidatorSigningInfo(ctx, sdk.ConsAddress(val.Address()))
+	info, found := keeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(val.Address()))
 	require.True(t, found)
 	require.Equal(t, int64(0), info.StartHeight)
 	require.Equal(t, int64(0), info.IndexOffset)
@@ -155,7 +247,7 @@ func TestHandleAbsentValidator(t *testing.T) {
 		ctx = ctx.WithBlockHeight(height)
 		keeper.handleValidatorSignature(ctx, val.Address(), power, true)
 	}
-	info, found = keeper.getValidatorSigningInfo(ctx, sdk.ConsAddress(val.Address()))
+	info, found = keeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(val.Address()))
 	require.True(t, found)
 	require.Equal(t, int64(0), info.StartHeight)
 	require.Equal(t, int64(0), info.MissedBlocksCounter)
@@ -165,7 +257,7 @@ func TestHandleAbsentValidator(t *testing.T) {
 		ctx = ctx.WithBlockHeight(height)
 		keeper.handleValidatorSignature(ctx, val.Address(), power, false)
 	}
-	info, found = keeper.getValidatorSigningInfo(ctx, sdk.ConsAddress(val.Address()))
+	info, found = keeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(val.Address()))
 	require.True(t, found)
 	require.Equal(t, int64(0), info.StartHeight)
 	require.Equal(t, keeper.SignedBlocksWindow(ctx)-keeper.MinSignedPerWindow(ctx), info.MissedBlocksCounter)
@@ -178,8 +270,21 @@ func TestHandleAbsentValidator(t *testing.T) {
 
 	// 501st block missed
 	ctx = ctx.WithBlockHeight(height)
-	keeper.handleValidatorSignature(ctx, val.Address(), power, false)
-	info, found = keeper.getValidatorSigningInfo(ctx, sdk.ConsAddress(val.Address()))
+	resTags := keeper.handleValidatorSignature(ctx, val.Address(), power, false)
+	consAddr := sdk.ConsAddress(val.Address()).String()
+	require.Equal(t, sdk.NewTags(
+		tags.Liveness, consAddr,
+		tags.MissedBlocks, strconv.FormatInt(keeper.SignedBlocksWindow(ctx)-keeper.MinSignedPerWindow(ctx)+1, 10),
+		tags.Slash, consAddr,
+		tags.SlashReason, tags.ReasonMissingSignature,
+		tags.SlashFraction, keeper.SlashFractionDowntime(ctx).String(),
+		tags.SlashPower, strconv.FormatInt(power, 10),
+		tags.Jail, consAddr,
+		tags.JailReason, tags.ReasonMissingSignature,
+	), resTags)
+	require.Equal(t, []string{tags.ReasonMissingSignature}, hooks.slashed)
+	require.Equal(t, []string{tags.ReasonMissingSignature}, hooks.jailed)
+	info, found = keeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(val.Address()))
 	require.True(t, found)
 	require.Equal(t, int64(0), info.StartHeight)
 	// counter now reset to zero
@@ -197,11 +302,20 @@ func TestHandleAbsentValidator(t *testing.T) {
 	// validator should have been slashed
 	require.Equal(t, amt.Int64()-slashAmt, validator.GetTokens().Int64())
 
+	// and the slash recorded
+	events := keeper.GetSlashEvents(ctx, sdk.ConsAddress(val.Address()), 0, height)
+	require.Len(t, events, 1)
+	require.Equal(t, height, events[0].Height)
+	require.Equal(t, tags.ReasonMissingSignature, events[0].Reason)
+	require.True(t, events[0].Fraction.Equal(keeper.SlashFractionDowntime(ctx)))
+	require.True(t, events[0].Burned.Equal(sdk.NewInt(slashAmt)))
+	require.Empty(t, keeper.GetSlashEvents(ctx, sdk.ConsAddress(val.Address()), 0, height-1))
+
 	// 502nd block *also* missed (since the LastCommit would have still included the just-unbonded validator)
 	height++
 	ctx = ctx.WithBlockHeight(height)
 	keeper.handleValidatorSignature(ctx, val.Address(), power, false)
-	info, found = keeper.getValidatorSigningInfo(ctx, sdk.ConsAddress(val.Address()))
+	info, found = keeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(val.Address()))
 	require.True(t, found)
 	require.Equal(t, int64(0), info.StartHeight)
 	require.Equal(t, int64(1), info.MissedBlocksCounter)
@@ -233,8 +347,9 @@ func TestHandleAbsentValidator(t *testing.T) {
 	pool = sk.GetPool(ctx)
 	require.Equal(t, amt.Int64()-slashAmt, pool.BondedTokens.Int64())
 
-	// Validator start height should not have been changed
-	info, found = keeper.getValidatorSigningInfo(ctx, sdk.ConsAddress(val.Address()))
+	// Validator start height is reset to the height it was rebonded at, 0 as
+	// the block header was replaced above
+	info, found = keeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(val.Address()))
 	require.True(t, found)
 	require.Equal(t, int64(0), info.StartHeight)
 	// we've missed 2 blocks more than the maximum, so the counter was reset to 0 at 1 block more and is now 1
@@ -300,7 +415,7 @@ func TestHandleNewValidator(t *testing.T) {
 	ctx = ctx.WithBlockHeight(keeper.SignedBlocksWindow(ctx) + 2)
 	keeper.handleValidatorSignature(ctx, val.Address(), 100, false)
 
-	info, found := keeper.getValidatorSigningInfo(ctx, sdk.ConsAddress(val.Address()))
+	info, found := keeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(val.Address()))
 	require.True(t, found)
 	require.Equal(t, keeper.SignedBlocksWindow(ctx)+1, info.StartHeight)
 	require.Equal(t, int64(2), info.IndexOffset)
@@ -421,6 +536,11 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	validator, _ = sk.GetValidator(ctx, addr)
 	require.Equal(t, sdk.Bonded, validator.Status)
 
+	// the start height was reset when the validator was bonded again
+	signInfo, found := keeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, int64(700), signInfo.StartHeight)
+
 	// validator misses 500 more blocks, 501 total
 	latest := height
 	for ; height < latest+500; height++ {
@@ -428,13 +548,25 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 		keeper.handleValidatorSignature(ctx, val.Address(), newPower, false)
 	}
 
+	// shouldn't be jailed/kicked yet as a full window has not passed since it was bonded again
+	staking.EndBlocker(ctx, sk)
+	validator, _ = sk.GetValidator(ctx, addr)
+	require.Equal(t, sdk.Bonded, validator.Status)
+
+	// validator keeps missing blocks until a full window has passed
+	latest = signInfo.StartHeight + keeper.SignedBlocksWindow(ctx)
+	for ; height <= latest+1; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		keeper.handleValidatorSignature(ctx, val.Address(), newPower, false)
+	}
+
 	// should now be jailed & kicked
 	staking.EndBlocker(ctx, sk)
 	validator, _ = sk.GetValidator(ctx, addr)
 	require.Equal(t, sdk.Unbonding, validator.Status)
 
 	// check all the signing information
-	signInfo, found := keeper.getValidatorSigningInfo(ctx, consAddr)
+	signInfo, found = keeper.GetValidatorSigningInfo(ctx, consAddr)
 	require.True(t, found)
 	require.Equal(t, int64(0), signInfo.MissedBlocksCounter)
 	require.Equal(t, int64(0), signInfo.IndexOffset)
@@ -458,9 +590,14 @@ func TestValidatorDippingInAndOut(t *testing.T) {
 	validator, _ = sk.GetValidator(ctx, addr)
 	require.Equal(t, sdk.Bonded, validator.Status)
 
-	// validator misses 501 blocks
-	latest = height
-	for ; height < latest+501; height++ {
+	// the start height was reset when the validator was unjailed
+	signInfo, found = keeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, int64(5000), signInfo.StartHeight)
+
+	// validator misses 501 blocks, once a full window has passed
+	latest = signInfo.StartHeight + keeper.SignedBlocksWindow(ctx)
+	for ; height <= latest+501; height++ {
 		ctx = ctx.WithBlockHeight(height)
 		keeper.handleValidatorSignature(ctx, val.Address(), newPower, false)
 	}
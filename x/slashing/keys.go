@@ -20,11 +20,19 @@ const (
 // key prefix bytes
 var (
 	ValidatorSigningInfoKey         = []byte{0x01} // Prefix for signing info
-	ValidatorMissedBlockBitArrayKey = []byte{0x02} // Prefix for missed block bit array
+	ValidatorMissedBlockBitArrayKey = []byte{0x02} // Prefix for legacy missed block bit array, see MigrateMissedBlockBitArrays
 	ValidatorSlashingPeriodKey      = []byte{0x03} // Prefix for slashing period
 	AddrPubkeyRelationKey           = []byte{0x04} // Prefix for address-pubkey relation
+	ConsAddrOperatorKey             = []byte{0x05} // Prefix for consensus address to operator address index
+	OperatorConsAddrKey             = []byte{0x06} // Prefix for operator address to consensus address index
+	ValidatorMissedBlockBitmapKey   = []byte{0x07} // Prefix for missed block bitmap chunks
+	SlashEventKey                   = []byte{0x08} // Prefix for slash events
 )
 
+// MissedBlockBitmapChunkSize is the number of missed block bits stored
+// under a single missed block bitmap key
+const MissedBlockBitmapChunkSize = 1024
+
 // stored by *Tendermint* address (not operator address)
 func GetValidatorSigningInfoKey(v sdk.ConsAddress) []byte {
 	return append(ValidatorSigningInfoKey, v.Bytes()...)
@@ -51,6 +59,19 @@ func GetValidatorMissedBlockBitArrayKey(v sdk.ConsAddress, i int64) []byte {
 	return append(GetValidatorMissedBlockBitArrayPrefixKey(v), b...)
 }
 
+// stored by *Tendermint* address (not operator address)
+func GetValidatorMissedBlockBitmapPrefixKey(v sdk.ConsAddress) []byte {
+	return append(ValidatorMissedBlockBitmapKey, v.Bytes()...)
+}
+
+// stored by *Tendermint* address (not operator address) followed by the
+// big endian chunk index, so chunks are iterated in index order
+func GetValidatorMissedBlockBitmapKey(v sdk.ConsAddress, chunk int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(chunk))
+	return append(GetValidatorMissedBlockBitmapPrefixKey(v), b...)
+}
+
 // stored by *Tendermint* address (not operator address)
 func GetValidatorSlashingPeriodPrefix(v sdk.ConsAddress) []byte {
 	return append(ValidatorSlashingPeriodKey, v.Bytes()...)
@@ -64,6 +85,37 @@ func GetValidatorSlashingPeriodKey(v sdk.ConsAddress, startHeight int64) []byte
 	return append(GetValidatorSlashingPeriodPrefix(v), b...)
 }
 
+// stored by *Tendermint* address (not operator address)
+func GetSlashEventPrefixKey(v sdk.ConsAddress) []byte {
+	return append(SlashEventKey, v.Bytes()...)
+}
+
+// stored by *Tendermint* address (not operator address) followed by the
+// big endian height, so events are iterated in height order
+func GetSlashEventHeightKey(v sdk.ConsAddress, height int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(height))
+	return append(GetSlashEventPrefixKey(v), b...)
+}
+
+// stored by *Tendermint* address (not operator address), height and the
+// sequence of the event among the events of the same height
+func GetSlashEventKey(v sdk.ConsAddress, height int64, seq uint32) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, seq)
+	return append(GetSlashEventHeightKey(v, height), b...)
+}
+
 func getAddrPubkeyRelationKey(address []byte) []byte {
 	return append(AddrPubkeyRelationKey, address...)
 }
+
+// stored by *Tendermint* address (not operator address)
+func GetConsAddrOperatorKey(v sdk.ConsAddress) []byte {
+	return append(ConsAddrOperatorKey, v.Bytes()...)
+}
+
+// stored by operator address
+func GetOperatorConsAddrKey(v sdk.ValAddress) []byte {
+	return append(OperatorConsAddrKey, v.Bytes()...)
+}
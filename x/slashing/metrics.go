@@ -0,0 +1,76 @@
+package slashing
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	prometheus "github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+// module.
+const MetricsSubsystem = "slashing"
+
+// validatorLabelLen is the number of hash bytes kept in a validator label.
+const validatorLabelLen = 8
+
+// Metrics contains the metrics exposed by the slashing module. The validators
+// are labelled by a hash of their consensus address, see validatorLabel.
+type Metrics struct {
+	// Number of blocks missed by a validator in the signed blocks window.
+	MissedBlocks metrics.Gauge
+	// Number of times a validator was jailed, per reason.
+	Jails metrics.Counter
+	// Tokens slashed from a validator, per reason.
+	SlashedTokens metrics.Counter
+}
+
+// PrometheusMetrics returns Metrics built using the Prometheus client
+// library. Optionally, labels can be provided along with their values
+// ("foo", "fooValue").
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	withLabels := sdk.MetricLabels(labelsAndValues...)
+
+	return &Metrics{
+		MissedBlocks: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "missed_blocks",
+			Help:      "Number of blocks missed by a validator in the signed blocks window.",
+		}, withLabels("validator")).With(labelsAndValues...),
+		Jails: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "jails",
+			Help:      "Number of times a validator was jailed.",
+		}, withLabels("validator", "reason")).With(labelsAndValues...),
+		SlashedTokens: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "slashed_tokens",
+			Help:      "Tokens slashed from a validator.",
+		}, withLabels("validator", "reason")).With(labelsAndValues...),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		MissedBlocks:  discard.NewGauge(),
+		Jails:         discard.NewCounter(),
+		SlashedTokens: discard.NewCounter(),
+	}
+}
+
+// validatorLabel returns the metrics label of the validator with the given
+// consensus address: the hex encoded prefix of the address sha256 hash, which
+// keeps the label short and doesn't expose the address itself.
+func validatorLabel(consAddr sdk.ConsAddress) string {
+	hash := sha256.Sum256(consAddr)
+	return hex.EncodeToString(hash[:validatorLabelLen])
+}
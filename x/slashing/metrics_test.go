@@ -0,0 +1,20 @@
+package slashing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidatorLabel(t *testing.T) {
+	consAddr1 := sdk.ConsAddress(pks[0].Address())
+	consAddr2 := sdk.ConsAddress(pks[1].Address())
+
+	label := validatorLabel(consAddr1)
+	require.Len(t, label, 2*validatorLabelLen)
+	require.Equal(t, label, validatorLabel(consAddr1))
+	require.NotEqual(t, label, validatorLabel(consAddr2))
+	require.NotContains(t, label, consAddr1.String())
+}
@@ -0,0 +1,37 @@
+package slashing
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateMissedBlockBitArrays moves the missed block bit arrays stored with
+// one key per index under ValidatorMissedBlockBitArrayKey to the chunked
+// missed block bitmap and deletes the legacy entries. It must be run once,
+// eg. in the upgrade handler of the first version using the bitmap, before
+// the BeginBlocker of the upgrade height. It returns the number of legacy
+// entries migrated.
+func (k Keeper) MigrateMissedBlockBitArrays(ctx sdk.Context) (migrated int) {
+	store := ctx.KVStore(k.storeKey)
+
+	// collect the legacy entries first so the bitmap writes don't interleave with the iteration
+	var keys [][]byte
+	iter := sdk.KVStorePrefixIterator(store, ValidatorMissedBlockBitArrayKey)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		if len(key) == 1+sdk.AddrLen+8 {
+			var missed bool
+			k.cdc.MustUnmarshalBinaryLengthPrefixed(store.Get(key), &missed)
+			address := sdk.ConsAddress(key[1 : 1+sdk.AddrLen])
+			index := int64(binary.LittleEndian.Uint64(key[1+sdk.AddrLen:]))
+			k.setValidatorMissedBlockBitArray(ctx, address, index, missed)
+		}
+		store.Delete(key)
+	}
+	return len(keys)
+}
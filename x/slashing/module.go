@@ -9,8 +9,9 @@ import (
 )
 
 var (
-	_ sdk.AppModule      = AppModule{}
-	_ sdk.AppModuleBasic = AppModuleBasic{}
+	_ sdk.AppModule          = AppModule{}
+	_ sdk.AppModuleBasic     = AppModuleBasic{}
+	_ sdk.AppModuleDescriber = AppModule{}
 )
 
 // name of this module
@@ -69,7 +70,9 @@ func (AppModule) Name() string {
 }
 
 // register invariants
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRouter) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRouter) {
+	RegisterInvariants(ir, am.keeper)
+}
 
 // module message route name
 func (AppModule) Route() string {
@@ -91,6 +94,23 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 	return NewQuerier(am.keeper)
 }
 
+// module message types
+func (AppModule) MsgTypes() []string {
+	return []string{MsgUnjail{}.Type()}
+}
+
+// module query paths
+func (AppModule) QueryPaths() []string {
+	return []string{
+		QueryParameters,
+		QuerySigningInfo,
+		QuerySigningInfos,
+		QueryOperator,
+		QueryConsAddress,
+		QuerySlashEvents,
+	}
+}
+
 // module init-genesis
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
 	var genesisState GenesisState
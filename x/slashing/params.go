@@ -147,3 +147,8 @@ func (k Keeper) GetParams(ctx sdk.Context) (params Params) {
 	k.paramspace.GetParamSet(ctx, &params)
 	return params
 }
+
+// SetParams sets the slashing parameters to the param space.
+func (k Keeper) SetParams(ctx sdk.Context, params Params) {
+	k.paramspace.SetParamSet(ctx, &params)
+}
@@ -14,6 +14,9 @@ const (
 	QueryParameters   = "parameters"
 	QuerySigningInfo  = "signingInfo"
 	QuerySigningInfos = "signingInfos"
+	QueryOperator     = "operator"
+	QueryConsAddress  = "consAddress"
+	QuerySlashEvents  = "slashEvents"
 )
 
 // NewQuerier creates a new querier for slashing clients.
@@ -26,6 +29,12 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return querySigningInfo(ctx, req, k)
 		case QuerySigningInfos:
 			return querySigningInfos(ctx, req, k)
+		case QueryOperator:
+			return queryOperator(ctx, req, k)
+		case QueryConsAddress:
+			return queryConsAddress(ctx, req, k)
+		case QuerySlashEvents:
+			return querySlashEvents(ctx, req, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown staking query endpoint")
 		}
@@ -61,7 +70,7 @@ func querySigningInfo(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
 
-	signingInfo, found := k.getValidatorSigningInfo(ctx, params.ConsAddress)
+	signingInfo, found := k.GetValidatorSigningInfo(ctx, params.ConsAddress)
 	if !found {
 		return nil, ErrNoSigningInfoFound(DefaultCodespace, params.ConsAddress)
 	}
@@ -92,33 +101,127 @@ func querySigningInfos(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
 
-	if params.Limit == 0 {
+	if params.Limit <= 0 {
 		// set the default limit to max bonded if no limit was provided
 		params.Limit = int(k.validatorSet.MaxValidators(ctx))
 	}
+	if params.Page < 1 {
+		params.Page = 1
+	}
 
-	var signingInfos []ValidatorSigningInfo
+	// get pagination bounds
+	start := (params.Page - 1) * params.Limit
+	end := params.Limit + start
 
+	// stop iterating over the store at the end of the requested page
+	signingInfos := []ValidatorSigningInfo{}
+	index := 0
 	k.IterateValidatorSigningInfos(ctx, func(consAddr sdk.ConsAddress, info ValidatorSigningInfo) (stop bool) {
-		signingInfos = append(signingInfos, info)
-		return false
+		if index >= start {
+			signingInfos = append(signingInfos, info)
+		}
+		index++
+		return index >= end
 	})
 
-	// get pagination bounds
-	start := (params.Page - 1) * params.Limit
-	end := params.Limit + start
-	if end >= len(signingInfos) {
-		end = len(signingInfos)
+	res, err := codec.MarshalJSONIndent(moduleCdc, signingInfos)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+// QueryOperatorParams defines the params for the following queries:
+// - 'custom/slashing/operator'
+type QueryOperatorParams struct {
+	ConsAddress sdk.ConsAddress
+}
+
+func NewQueryOperatorParams(consAddr sdk.ConsAddress) QueryOperatorParams {
+	return QueryOperatorParams{consAddr}
+}
+
+func queryOperator(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryOperatorParams
+
+	err := moduleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
 
-	if start >= len(signingInfos) {
-		// page is out of bounds
-		signingInfos = []ValidatorSigningInfo{}
-	} else {
-		signingInfos = signingInfos[start:end]
+	valAddr, found := k.GetValidatorOperator(ctx, params.ConsAddress)
+	if !found {
+		return nil, ErrNoValidatorForAddress(DefaultCodespace)
 	}
 
-	res, err := codec.MarshalJSONIndent(moduleCdc, signingInfos)
+	res, err := codec.MarshalJSONIndent(moduleCdc, valAddr)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+// QueryConsAddressParams defines the params for the following queries:
+// - 'custom/slashing/consAddress'
+type QueryConsAddressParams struct {
+	ValidatorAddr sdk.ValAddress
+}
+
+func NewQueryConsAddressParams(valAddr sdk.ValAddress) QueryConsAddressParams {
+	return QueryConsAddressParams{valAddr}
+}
+
+func queryConsAddress(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryConsAddressParams
+
+	err := moduleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	consAddr, found := k.GetValidatorConsAddress(ctx, params.ValidatorAddr)
+	if !found {
+		return nil, ErrBadValidatorAddr(DefaultCodespace)
+	}
+
+	res, err := codec.MarshalJSONIndent(moduleCdc, consAddr)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+
+	return res, nil
+}
+
+// QuerySlashEventsParams defines the params for the following queries:
+// - 'custom/slashing/slashEvents'
+type QuerySlashEventsParams struct {
+	ConsAddress sdk.ConsAddress
+	MinHeight   int64
+	MaxHeight   int64
+}
+
+func NewQuerySlashEventsParams(consAddr sdk.ConsAddress, minHeight, maxHeight int64) QuerySlashEventsParams {
+	return QuerySlashEventsParams{consAddr, minHeight, maxHeight}
+}
+
+func querySlashEvents(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QuerySlashEventsParams
+
+	err := moduleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.MaxHeight <= 0 {
+		// query up to the current height if no max height was provided
+		params.MaxHeight = ctx.BlockHeight()
+	}
+
+	events := k.GetSlashEvents(ctx, params.ConsAddress, params.MinHeight, params.MaxHeight)
+
+	res, err := codec.MarshalJSONIndent(moduleCdc, events)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
 	}
@@ -2,11 +2,15 @@ package slashing
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/tags"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
 func TestNewQuerier(t *testing.T) {
@@ -34,4 +38,130 @@ func TestQueryParams(t *testing.T) {
 	err := cdc.UnmarshalJSON(res, &params)
 	require.NoError(t, err)
 	require.Equal(t, keeper.GetParams(ctx), params)
+
+	// parameters changed through the param space are queried right away
+	params.SignedBlocksWindow = 50
+	keeper.SetParams(ctx, params)
+
+	res, errRes = queryParams(ctx, keeper)
+	require.NoError(t, errRes)
+	require.NoError(t, cdc.UnmarshalJSON(res, &params))
+	require.Equal(t, int64(50), params.SignedBlocksWindow)
+	require.Equal(t, int64(50), keeper.SignedBlocksWindow(ctx))
+}
+
+func TestQueryValidatorAddresses(t *testing.T) {
+	ctx, _, sk, _, keeper := createTestInput(t, keeperTestParams())
+	querier := NewQuerier(keeper)
+
+	operatorAddr, val := addrs[0], pks[0]
+	consAddr := sdk.ConsAddress(val.Address())
+	amt := sdk.TokensFromTendermintPower(100)
+	got := staking.NewHandler(sk)(ctx, NewTestMsgCreateValidator(operatorAddr, val, amt))
+	require.True(t, got.IsOK())
+
+	// consensus address to operator address
+	bz, err := moduleCdc.MarshalJSON(NewQueryOperatorParams(consAddr))
+	require.NoError(t, err)
+	res, errRes := querier(ctx, []string{QueryOperator}, abci.RequestQuery{Data: bz})
+	require.NoError(t, errRes)
+
+	var valAddr sdk.ValAddress
+	require.NoError(t, moduleCdc.UnmarshalJSON(res, &valAddr))
+	require.Equal(t, operatorAddr, valAddr)
+
+	// operator address to consensus address
+	bz, err = moduleCdc.MarshalJSON(NewQueryConsAddressParams(operatorAddr))
+	require.NoError(t, err)
+	res, errRes = querier(ctx, []string{QueryConsAddress}, abci.RequestQuery{Data: bz})
+	require.NoError(t, errRes)
+
+	var resConsAddr sdk.ConsAddress
+	require.NoError(t, moduleCdc.UnmarshalJSON(res, &resConsAddr))
+	require.Equal(t, consAddr, resConsAddr)
+
+	// unknown addresses
+	bz, err = moduleCdc.MarshalJSON(NewQueryOperatorParams(sdk.ConsAddress(pks[1].Address())))
+	require.NoError(t, err)
+	_, errRes = querier(ctx, []string{QueryOperator}, abci.RequestQuery{Data: bz})
+	require.Error(t, errRes)
+
+	// the index is removed with the validator
+	keeper.AfterValidatorRemoved(ctx, consAddr)
+	_, found := keeper.GetValidatorOperator(ctx, consAddr)
+	require.False(t, found)
+	_, found = keeper.GetValidatorConsAddress(ctx, operatorAddr)
+	require.False(t, found)
+}
+
+func TestQuerySigningInfos(t *testing.T) {
+	ctx, _, _, _, keeper := createTestInput(t, keeperTestParams())
+	querier := NewQuerier(keeper)
+
+	for i, pk := range pks[:3] {
+		consAddr := sdk.ConsAddress(pk.Address())
+		keeper.SetValidatorSigningInfo(ctx, consAddr,
+			NewValidatorSigningInfo(consAddr, int64(i), 0, time.Unix(0, 0), i == 2, int64(i)))
+	}
+
+	querySigningInfos := func(page, limit int) []ValidatorSigningInfo {
+		bz, err := moduleCdc.MarshalJSON(NewQuerySigningInfosParams(page, limit))
+		require.NoError(t, err)
+		res, errRes := querier(ctx, []string{QuerySigningInfos}, abci.RequestQuery{Data: bz})
+		require.NoError(t, errRes)
+
+		var infos []ValidatorSigningInfo
+		require.NoError(t, moduleCdc.UnmarshalJSON(res, &infos))
+		return infos
+	}
+
+	require.Len(t, querySigningInfos(1, 0), 3)
+	require.Len(t, querySigningInfos(0, 2), 2)
+	require.Len(t, querySigningInfos(2, 2), 1)
+	require.Len(t, querySigningInfos(3, 2), 0)
+
+	// a single signing info, including its tombstone state
+	consAddr := sdk.ConsAddress(pks[2].Address())
+	bz, err := moduleCdc.MarshalJSON(NewQuerySigningInfoParams(consAddr))
+	require.NoError(t, err)
+	res, errRes := querier(ctx, []string{QuerySigningInfo}, abci.RequestQuery{Data: bz})
+	require.NoError(t, errRes)
+
+	var info ValidatorSigningInfo
+	require.NoError(t, moduleCdc.UnmarshalJSON(res, &info))
+	require.True(t, info.Tombstoned)
+	require.Equal(t, int64(2), info.MissedBlocksCounter)
+}
+
+func TestQuerySlashEvents(t *testing.T) {
+	ctx, _, _, _, keeper := createTestInput(t, keeperTestParams())
+	querier := NewQuerier(keeper)
+
+	consAddr, otherAddr := sdk.ConsAddress(pks[0].Address()), sdk.ConsAddress(pks[1].Address())
+	fraction := sdk.NewDecWithPrec(1, 2)
+	keeper.SetSlashEvent(ctx, NewSlashEvent(consAddr, 5, fraction, sdk.NewInt(10), tags.ReasonMissingSignature))
+	keeper.SetSlashEvent(ctx, NewSlashEvent(consAddr, 5, fraction, sdk.NewInt(10), tags.ReasonDoubleSign))
+	keeper.SetSlashEvent(ctx, NewSlashEvent(consAddr, 300, fraction, sdk.NewInt(10), tags.ReasonMissingSignature))
+	keeper.SetSlashEvent(ctx, NewSlashEvent(otherAddr, 7, fraction, sdk.NewInt(10), tags.ReasonMissingSignature))
+
+	querySlashEvents := func(minHeight, maxHeight int64) []SlashEvent {
+		bz, err := moduleCdc.MarshalJSON(NewQuerySlashEventsParams(consAddr, minHeight, maxHeight))
+		require.NoError(t, err)
+		res, errRes := querier(ctx.WithBlockHeight(1000), []string{QuerySlashEvents}, abci.RequestQuery{Data: bz})
+		require.NoError(t, errRes)
+
+		var events []SlashEvent
+		require.NoError(t, moduleCdc.UnmarshalJSON(res, &events))
+		return events
+	}
+
+	// events of the same height are kept in order
+	events := querySlashEvents(0, 0)
+	require.Len(t, events, 3)
+	require.Equal(t, tags.ReasonDoubleSign, events[1].Reason)
+	require.Equal(t, int64(300), events[2].Height)
+
+	require.Len(t, querySlashEvents(5, 5), 2)
+	require.Len(t, querySlashEvents(6, 300), 1)
+	require.Len(t, querySlashEvents(301, 1000), 0)
 }
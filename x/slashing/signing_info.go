@@ -1,6 +1,7 @@
 package slashing
 
 import (
+	"encoding/binary"
 	"fmt"
 	"time"
 
@@ -8,7 +9,7 @@ import (
 )
 
 // Stored by *validator* address (not operator address)
-func (k Keeper) getValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress) (info ValidatorSigningInfo, found bool) {
+func (k Keeper) GetValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress) (info ValidatorSigningInfo, found bool) {
 	store := ctx.KVStore(k.storeKey)
 	bz := store.Get(GetValidatorSigningInfoKey(address))
 	if bz == nil {
@@ -42,33 +43,72 @@ func (k Keeper) SetValidatorSigningInfo(ctx sdk.Context, address sdk.ConsAddress
 	store.Set(GetValidatorSigningInfoKey(address), bz)
 }
 
+// Tombstone permanently bars a validator from being unjailed, eg. after it
+// has been slashed for double signing
+func (k Keeper) Tombstone(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	signInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		panic(fmt.Sprintf("Expected signing info for validator %s but not found", consAddr))
+	}
+
+	if signInfo.Tombstoned {
+		panic("cannot tombstone validator that is already tombstoned")
+	}
+
+	signInfo.Tombstoned = true
+	signInfo.JailedUntil = DoubleSignJailEndTime
+	k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
+}
+
+// IsTombstoned returns true if the validator is tombstoned, false if it is not
+// or if its signing info cannot be found
+func (k Keeper) IsTombstoned(ctx sdk.Context, consAddr sdk.ConsAddress) bool {
+	signInfo, found := k.GetValidatorSigningInfo(ctx, consAddr)
+	if !found {
+		return false
+	}
+	return signInfo.Tombstoned
+}
+
+// The missed block bit array is stored as a bitmap split in chunks of
+// MissedBlockBitmapChunkSize bits, so updating an index reads and writes a
+// single chunk regardless of the signed blocks window. Chunks without any
+// missed block are not stored.
+
 // Stored by *validator* address (not operator address)
 func (k Keeper) getValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress, index int64) (missed bool) {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(GetValidatorMissedBlockBitArrayKey(address, index))
-	if bz == nil {
-		// lazy: treat empty key as not missed
-		missed = false
-		return
+	chunk := store.Get(GetValidatorMissedBlockBitmapKey(address, index/MissedBlockBitmapChunkSize))
+	if chunk == nil {
+		// lazy: treat empty chunk as not missed
+		return false
 	}
-	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &missed)
-	return
+	bit := index % MissedBlockBitmapChunkSize
+	return chunk[bit/8]&(1<<uint(bit%8)) != 0
 }
 
+// IterateValidatorMissedBlockBitArray calls the handler in index order for
+// every missed block within the signed blocks window
 // Stored by *validator* address (not operator address)
 func (k Keeper) IterateValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress, handler func(index int64, missed bool) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
-	index := int64(0)
-	// Array may be sparse
-	for ; index < k.SignedBlocksWindow(ctx); index++ {
-		var missed bool
-		bz := store.Get(GetValidatorMissedBlockBitArrayKey(address, index))
-		if bz == nil {
-			continue
-		}
-		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &missed)
-		if handler(index, missed) {
-			break
+	window := k.SignedBlocksWindow(ctx)
+	prefix := GetValidatorMissedBlockBitmapPrefixKey(address)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		offset := int64(binary.BigEndian.Uint64(iter.Key()[len(prefix):])) * MissedBlockBitmapChunkSize
+		chunk := iter.Value()
+		for bit := int64(0); bit < MissedBlockBitmapChunkSize; bit++ {
+			if offset+bit >= window {
+				return
+			}
+			if chunk[bit/8]&(1<<uint(bit%8)) == 0 {
+				continue
+			}
+			if handler(offset+bit, true) {
+				return
+			}
 		}
 	}
 }
@@ -76,20 +116,51 @@ func (k Keeper) IterateValidatorMissedBlockBitArray(ctx sdk.Context, address sdk
 // Stored by *validator* address (not operator address)
 func (k Keeper) setValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress, index int64, missed bool) {
 	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshalBinaryLengthPrefixed(missed)
-	store.Set(GetValidatorMissedBlockBitArrayKey(address, index), bz)
+	key := GetValidatorMissedBlockBitmapKey(address, index/MissedBlockBitmapChunkSize)
+	chunk := store.Get(key)
+	if chunk == nil {
+		if !missed {
+			return
+		}
+		chunk = make([]byte, MissedBlockBitmapChunkSize/8)
+	} else {
+		// don't modify the slice owned by the store
+		chunk = append([]byte(nil), chunk...)
+	}
+
+	bit := index % MissedBlockBitmapChunkSize
+	if missed {
+		chunk[bit/8] |= 1 << uint(bit%8)
+	} else {
+		chunk[bit/8] &^= 1 << uint(bit%8)
+	}
+
+	if isZeroBitmapChunk(chunk) {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, chunk)
 }
 
 // Stored by *validator* address (not operator address)
 func (k Keeper) clearValidatorMissedBlockBitArray(ctx sdk.Context, address sdk.ConsAddress) {
 	store := ctx.KVStore(k.storeKey)
-	iter := sdk.KVStorePrefixIterator(store, GetValidatorMissedBlockBitArrayPrefixKey(address))
+	iter := sdk.KVStorePrefixIterator(store, GetValidatorMissedBlockBitmapPrefixKey(address))
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
 		store.Delete(iter.Key())
 	}
 }
 
+func isZeroBitmapChunk(chunk []byte) bool {
+	for _, b := range chunk {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
 // Signing info for a validator
 type ValidatorSigningInfo struct {
 	Address             sdk.ConsAddress `json:"address"`               // validator consensus address
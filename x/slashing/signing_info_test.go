@@ -11,7 +11,7 @@ import (
 
 func TestGetSetValidatorSigningInfo(t *testing.T) {
 	ctx, _, _, _, keeper := createTestInput(t, DefaultParams())
-	info, found := keeper.getValidatorSigningInfo(ctx, sdk.ConsAddress(addrs[0]))
+	info, found := keeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(addrs[0]))
 	require.False(t, found)
 	newInfo := NewValidatorSigningInfo(
 		sdk.ConsAddress(addrs[0]),
@@ -22,7 +22,7 @@ func TestGetSetValidatorSigningInfo(t *testing.T) {
 		int64(10),
 	)
 	keeper.SetValidatorSigningInfo(ctx, sdk.ConsAddress(addrs[0]), newInfo)
-	info, found = keeper.getValidatorSigningInfo(ctx, sdk.ConsAddress(addrs[0]))
+	info, found = keeper.GetValidatorSigningInfo(ctx, sdk.ConsAddress(addrs[0]))
 	require.True(t, found)
 	require.Equal(t, info.StartHeight, int64(4))
 	require.Equal(t, info.IndexOffset, int64(3))
@@ -38,3 +38,59 @@ func TestGetSetValidatorMissedBlockBitArray(t *testing.T) {
 	missed = keeper.getValidatorMissedBlockBitArray(ctx, sdk.ConsAddress(addrs[0]), 0)
 	require.True(t, missed) // now should be missed
 }
+
+func TestMissedBlockBitmapChunks(t *testing.T) {
+	params := keeperTestParams()
+	params.SignedBlocksWindow = 2 * MissedBlockBitmapChunkSize
+	ctx, _, _, _, keeper := createTestInput(t, params)
+	consAddr := sdk.ConsAddress(addrs[0])
+	store := ctx.KVStore(keeper.storeKey)
+
+	// indexes on both sides of a chunk boundary
+	indexes := []int64{3, MissedBlockBitmapChunkSize - 1, MissedBlockBitmapChunkSize}
+	for _, index := range indexes {
+		keeper.setValidatorMissedBlockBitArray(ctx, consAddr, index, true)
+	}
+	for _, index := range indexes {
+		require.True(t, keeper.getValidatorMissedBlockBitArray(ctx, consAddr, index))
+	}
+	require.False(t, keeper.getValidatorMissedBlockBitArray(ctx, consAddr, 4))
+	require.NotNil(t, store.Get(GetValidatorMissedBlockBitmapKey(consAddr, 1)))
+
+	// only missed blocks within the window are iterated
+	keeper.setValidatorMissedBlockBitArray(ctx, consAddr, keeper.SignedBlocksWindow(ctx)+1, true)
+	var iterated []int64
+	keeper.IterateValidatorMissedBlockBitArray(ctx, consAddr, func(index int64, missed bool) bool {
+		require.True(t, missed)
+		iterated = append(iterated, index)
+		return false
+	})
+	require.Equal(t, indexes, iterated)
+
+	// a chunk without missed blocks is deleted
+	keeper.setValidatorMissedBlockBitArray(ctx, consAddr, MissedBlockBitmapChunkSize, false)
+	require.False(t, keeper.getValidatorMissedBlockBitArray(ctx, consAddr, MissedBlockBitmapChunkSize))
+	require.Nil(t, store.Get(GetValidatorMissedBlockBitmapKey(consAddr, 1)))
+
+	keeper.clearValidatorMissedBlockBitArray(ctx, consAddr)
+	require.False(t, keeper.getValidatorMissedBlockBitArray(ctx, consAddr, 3))
+}
+
+func TestMigrateMissedBlockBitArrays(t *testing.T) {
+	ctx, _, _, _, keeper := createTestInput(t, keeperTestParams())
+	consAddr := sdk.ConsAddress(addrs[0])
+	store := ctx.KVStore(keeper.storeKey)
+
+	// legacy entries, one per index
+	legacy := map[int64]bool{0: false, 2: true, MissedBlockBitmapChunkSize + 5: true}
+	for index, missed := range legacy {
+		store.Set(GetValidatorMissedBlockBitArrayKey(consAddr, index), keeper.cdc.MustMarshalBinaryLengthPrefixed(missed))
+	}
+
+	require.Equal(t, len(legacy), keeper.MigrateMissedBlockBitArrays(ctx))
+	for index, missed := range legacy {
+		require.Equal(t, missed, keeper.getValidatorMissedBlockBitArray(ctx, consAddr, index))
+		require.Nil(t, store.Get(GetValidatorMissedBlockBitArrayKey(consAddr, index)))
+	}
+	require.Equal(t, 0, keeper.MigrateMissedBlockBitArrays(ctx))
+}
@@ -0,0 +1,71 @@
+package simulation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+)
+
+// DecodeStore unmarshals the KVPair's Value to the corresponding slashing type
+// and returns its human readable representation. It panics on unknown keys.
+func DecodeStore(cdc *codec.Codec, kvPair cmn.KVPair) string {
+	if len(kvPair.Key) == 0 {
+		panic("empty slashing key")
+	}
+
+	switch prefix := kvPair.Key[:1]; {
+	case bytes.Equal(prefix, slashing.ValidatorSigningInfoKey):
+		var info slashing.ValidatorSigningInfo
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvPair.Value, &info)
+		return fmt.Sprintf("SigningInfo %s: %v", sdk.ConsAddress(kvPair.Key[1:]), info)
+
+	case bytes.Equal(prefix, slashing.ValidatorMissedBlockBitArrayKey):
+		if len(kvPair.Key) != 1+sdk.AddrLen+8 {
+			return fmt.Sprintf("MissedBlock %X: %X", kvPair.Key[1:], kvPair.Value)
+		}
+		var missed bool
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvPair.Value, &missed)
+		addr := sdk.ConsAddress(kvPair.Key[1 : 1+sdk.AddrLen])
+		index := int64(binary.LittleEndian.Uint64(kvPair.Key[1+sdk.AddrLen:]))
+		return fmt.Sprintf("MissedBlock %s #%d: %v", addr, index, missed)
+
+	case bytes.Equal(prefix, slashing.ValidatorMissedBlockBitmapKey):
+		if len(kvPair.Key) != 1+sdk.AddrLen+8 {
+			return fmt.Sprintf("MissedBlockBitmap %X: %X", kvPair.Key[1:], kvPair.Value)
+		}
+		addr := sdk.ConsAddress(kvPair.Key[1 : 1+sdk.AddrLen])
+		chunk := int64(binary.BigEndian.Uint64(kvPair.Key[1+sdk.AddrLen:]))
+		return fmt.Sprintf("MissedBlockBitmap %s chunk #%d: %X", addr, chunk, kvPair.Value)
+
+	case bytes.Equal(prefix, slashing.ValidatorSlashingPeriodKey):
+		// slashing periods are no longer stored, they are only found in the
+		// stores of older versions
+		return fmt.Sprintf("SlashingPeriod %X: %X", kvPair.Key[1:], kvPair.Value)
+
+	case bytes.Equal(prefix, slashing.SlashEventKey):
+		var event slashing.SlashEvent
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvPair.Value, &event)
+		return fmt.Sprintf("%v", event)
+
+	case bytes.Equal(prefix, slashing.AddrPubkeyRelationKey):
+		var pubKey crypto.PubKey
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvPair.Value, &pubKey)
+		return fmt.Sprintf("PubKey %X: %s", kvPair.Key[1:], sdk.MustBech32ifyConsPub(pubKey))
+
+	case bytes.Equal(prefix, slashing.ConsAddrOperatorKey):
+		return fmt.Sprintf("Operator %s: %s", sdk.ConsAddress(kvPair.Key[1:]), sdk.ValAddress(kvPair.Value))
+
+	case bytes.Equal(prefix, slashing.OperatorConsAddrKey):
+		return fmt.Sprintf("ConsAddress %s: %s", sdk.ValAddress(kvPair.Key[1:]), sdk.ConsAddress(kvPair.Value))
+
+	default:
+		panic(fmt.Sprintf("invalid slashing key %X", kvPair.Key))
+	}
+}
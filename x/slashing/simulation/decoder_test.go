@@ -0,0 +1,79 @@
+package simulation
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+)
+
+var (
+	delPk1    = ed25519.GenPrivKey().PubKey()
+	valAddr1  = sdk.ValAddress(delPk1.Address())
+	consAddr1 = sdk.ConsAddress(delPk1.Address().Bytes())
+)
+
+func makeTestCodec() (cdc *codec.Codec) {
+	cdc = codec.New()
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	slashing.RegisterCodec(cdc)
+	return
+}
+
+func TestDecodeStore(t *testing.T) {
+	cdc := makeTestCodec()
+
+	info := slashing.NewValidatorSigningInfo(consAddr1, 0, 1, time.Unix(0, 0).UTC(), false, 0)
+	event := slashing.NewSlashEvent(consAddr1, 10, sdk.NewDecWithPrec(5, 2), sdk.NewInt(100), "double_sign")
+	bitmap := []byte{0x01, 0x02}
+
+	kvPairs := cmn.KVPairs{
+		cmn.KVPair{Key: slashing.GetValidatorSigningInfoKey(consAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(info)},
+		cmn.KVPair{Key: slashing.GetValidatorMissedBlockBitArrayKey(consAddr1, 6), Value: cdc.MustMarshalBinaryLengthPrefixed(true)},
+		cmn.KVPair{Key: append(slashing.ValidatorMissedBlockBitArrayKey, 0x01, 0x02), Value: []byte{0x03}},
+		cmn.KVPair{Key: slashing.GetValidatorMissedBlockBitmapKey(consAddr1, 3), Value: bitmap},
+		cmn.KVPair{Key: slashing.GetValidatorSlashingPeriodKey(consAddr1, 0), Value: []byte{0x03}},
+		cmn.KVPair{Key: slashing.GetSlashEventKey(consAddr1, 10, 0), Value: cdc.MustMarshalBinaryLengthPrefixed(event)},
+		cmn.KVPair{Key: append(slashing.AddrPubkeyRelationKey, delPk1.Address()...), Value: cdc.MustMarshalBinaryLengthPrefixed(delPk1)},
+		cmn.KVPair{Key: slashing.GetConsAddrOperatorKey(consAddr1), Value: valAddr1.Bytes()},
+		cmn.KVPair{Key: slashing.GetOperatorConsAddrKey(valAddr1), Value: consAddr1.Bytes()},
+		cmn.KVPair{Key: []byte{0x99}, Value: []byte{0x99}},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"ValidatorSigningInfo", fmt.Sprintf("SigningInfo %s: %v", consAddr1, info)},
+		{"ValidatorMissedBlockBitArray", fmt.Sprintf("MissedBlock %s #6: true", consAddr1)},
+		{"LegacyValidatorMissedBlockBitArray", "MissedBlock 0102: 03"},
+		{"ValidatorMissedBlockBitmap", fmt.Sprintf("MissedBlockBitmap %s chunk #3: %X", consAddr1, bitmap)},
+		{"ValidatorSlashingPeriod", fmt.Sprintf("SlashingPeriod %X: 03", slashing.GetValidatorSlashingPeriodKey(consAddr1, 0)[1:])},
+		{"SlashEvent", fmt.Sprintf("%v", event)},
+		{"AddrPubkeyRelation", fmt.Sprintf("PubKey %X: %s", delPk1.Address(), sdk.MustBech32ifyConsPub(delPk1))},
+		{"ConsAddrOperator", fmt.Sprintf("Operator %s: %s", consAddr1, valAddr1)},
+		{"OperatorConsAddr", fmt.Sprintf("ConsAddress %s: %s", valAddr1, consAddr1)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { DecodeStore(cdc, kvPairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, DecodeStore(cdc, kvPairs[i]), tt.name)
+			}
+		})
+	}
+}
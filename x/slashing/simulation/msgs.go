@@ -8,15 +8,47 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 )
 
-// SimulateMsgUnjail
-func SimulateMsgUnjail(k slashing.Keeper) simulation.Operation {
+// SimulateMsgUnjail tries to unjail a random jailed validator. An attempt made
+// before the jail period of the validator has ended is retried once it has.
+func SimulateMsgUnjail(k slashing.Keeper, sk staking.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		var jailed []staking.Validator
+		for _, val := range sk.GetAllValidators(ctx) {
+			if val.IsJailed() {
+				jailed = append(jailed, val)
+			}
+		}
+		if len(jailed) == 0 {
+			return simulation.NoOpMsg(), nil, nil
+		}
+
+		val := jailed[r.Intn(len(jailed))]
+		opMsg, err = operationSimulateMsgUnjail(k, val.GetOperator())(r, app, ctx, accs)
+		if err != nil || opMsg.OK {
+			return opMsg, nil, err
+		}
+
+		// retry once the validator can be unjailed, tombstoned validators never can
+		info, found := k.GetValidatorSigningInfo(ctx, val.GetConsAddr())
+		if found && !info.Tombstoned && ctx.BlockHeader().Time.Before(info.JailedUntil) {
+			fOps = []simulation.FutureOperation{{
+				BlockTime: info.JailedUntil,
+				Op:        operationSimulateMsgUnjail(k, val.GetOperator()),
+			}}
+		}
+		return opMsg, fOps, nil
+	}
+}
+
+func operationSimulateMsgUnjail(k slashing.Keeper, address sdk.ValAddress) simulation.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
 		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
 
-		acc := simulation.RandomAcc(r, accs)
-		address := sdk.ValAddress(acc.Address)
 		msg := slashing.NewMsgUnjail(address)
 		if msg.ValidateBasic() != nil {
 			return simulation.NoOpMsg(), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
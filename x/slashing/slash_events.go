@@ -0,0 +1,109 @@
+package slashing
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SlashEvent records a slash of a validator
+type SlashEvent struct {
+	Validator sdk.ConsAddress `json:"validator"` // consensus address of the slashed validator
+	Height    int64           `json:"height"`    // height at which the validator was slashed
+	Fraction  sdk.Dec         `json:"fraction"`  // slash fraction
+	Burned    sdk.Int         `json:"burned"`    // tokens burned, approximated from the validator power
+	Reason    string          `json:"reason"`    // reason of the slash, see the slashing tags
+}
+
+// NewSlashEvent creates a new SlashEvent object
+func NewSlashEvent(validator sdk.ConsAddress, height int64, fraction sdk.Dec,
+	burned sdk.Int, reason string) SlashEvent {
+
+	return SlashEvent{
+		Validator: validator,
+		Height:    height,
+		Fraction:  fraction,
+		Burned:    burned,
+		Reason:    reason,
+	}
+}
+
+// nolint
+func (e SlashEvent) String() string {
+	return fmt.Sprintf(`Slash Event:
+  Validator: %s
+  Height:    %d
+  Fraction:  %s
+  Burned:    %s
+  Reason:    %s`, e.Validator, e.Height, e.Fraction, e.Burned, e.Reason)
+}
+
+// recordSlashEvent records the slash of a validator with the given power at
+// the current height
+func (k Keeper) recordSlashEvent(ctx sdk.Context, consAddr sdk.ConsAddress, power int64, fraction sdk.Dec, reason string) {
+	burned := fraction.MulInt(sdk.TokensFromTendermintPower(power)).TruncateInt()
+	k.SetSlashEvent(ctx, NewSlashEvent(consAddr, ctx.BlockHeight(), fraction, burned, reason))
+}
+
+// SetSlashEvent stores a slash event, after the events already stored for
+// the same validator and height
+func (k Keeper) SetSlashEvent(ctx sdk.Context, event SlashEvent) {
+	store := ctx.KVStore(k.storeKey)
+
+	var seq uint32
+	for store.Has(GetSlashEventKey(event.Validator, event.Height, seq)) {
+		seq++
+	}
+	store.Set(GetSlashEventKey(event.Validator, event.Height, seq), k.cdc.MustMarshalBinaryLengthPrefixed(event))
+}
+
+// IterateSlashEvents iterates over the slash events of a validator between
+// the given heights, both inclusive, in height order
+func (k Keeper) IterateSlashEvents(ctx sdk.Context, consAddr sdk.ConsAddress, minHeight, maxHeight int64,
+	handler func(event SlashEvent) (stop bool)) {
+
+	if minHeight < 0 {
+		minHeight = 0
+	}
+	if maxHeight < minHeight {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	start := GetSlashEventHeightKey(consAddr, minHeight)
+	end := sdk.PrefixEndBytes(GetSlashEventHeightKey(consAddr, maxHeight))
+	iter := store.Iterator(start, end)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var event SlashEvent
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &event)
+		if handler(event) {
+			break
+		}
+	}
+}
+
+// GetSlashEvents returns the slash events of a validator between the given
+// heights, both inclusive
+func (k Keeper) GetSlashEvents(ctx sdk.Context, consAddr sdk.ConsAddress, minHeight, maxHeight int64) []SlashEvent {
+	events := []SlashEvent{}
+	k.IterateSlashEvents(ctx, consAddr, minHeight, maxHeight, func(event SlashEvent) (stop bool) {
+		events = append(events, event)
+		return false
+	})
+	return events
+}
+
+// IterateAllSlashEvents iterates over the slash events of all validators
+func (k Keeper) IterateAllSlashEvents(ctx sdk.Context, handler func(event SlashEvent) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, SlashEventKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var event SlashEvent
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &event)
+		if handler(event) {
+			break
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package slashing
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SlashingHooks event hooks for the slashing and jailing of validators, the
+// reasons are the slash reason tag values, eg. tags.ReasonDoubleSign
+type SlashingHooks interface {
+	AfterValidatorSlashed(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.Dec, reason string) // Must be called after a validator is slashed
+	AfterValidatorJailed(ctx sdk.Context, consAddr sdk.ConsAddress, reason string)                    // Must be called after a validator is jailed
+	AfterValidatorUnjailed(ctx sdk.Context, consAddr sdk.ConsAddress)                                 // Must be called after a validator is unjailed
+}
+
+// MultiSlashingHooks combines multiple slashing hooks, all hook functions are
+// run in array sequence
+type MultiSlashingHooks []SlashingHooks
+
+// NewMultiSlashingHooks creates a new MultiSlashingHooks object
+func NewMultiSlashingHooks(hooks ...SlashingHooks) MultiSlashingHooks {
+	return hooks
+}
+
+// nolint
+func (h MultiSlashingHooks) AfterValidatorSlashed(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.Dec, reason string) {
+	for i := range h {
+		h[i].AfterValidatorSlashed(ctx, consAddr, fraction, reason)
+	}
+}
+func (h MultiSlashingHooks) AfterValidatorJailed(ctx sdk.Context, consAddr sdk.ConsAddress, reason string) {
+	for i := range h {
+		h[i].AfterValidatorJailed(ctx, consAddr, reason)
+	}
+}
+func (h MultiSlashingHooks) AfterValidatorUnjailed(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	for i := range h {
+		h[i].AfterValidatorUnjailed(ctx, consAddr)
+	}
+}
+
+// SetHooks sets the slashing hooks
+func (k *Keeper) SetHooks(sh SlashingHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set slashing hooks twice")
+	}
+	k.hooks = sh
+	return k
+}
+
+// AfterValidatorSlashed - call hook if registered
+func (k Keeper) AfterValidatorSlashed(ctx sdk.Context, consAddr sdk.ConsAddress, fraction sdk.Dec, reason string) {
+	if k.hooks != nil {
+		k.hooks.AfterValidatorSlashed(ctx, consAddr, fraction, reason)
+	}
+}
+
+// AfterValidatorJailed - call hook if registered
+func (k Keeper) AfterValidatorJailed(ctx sdk.Context, consAddr sdk.ConsAddress, reason string) {
+	if k.hooks != nil {
+		k.hooks.AfterValidatorJailed(ctx, consAddr, reason)
+	}
+}
+
+// AfterValidatorUnjailed - call hook if registered
+func (k Keeper) AfterValidatorUnjailed(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	if k.hooks != nil {
+		k.hooks.AfterValidatorUnjailed(ctx, consAddr)
+	}
+}
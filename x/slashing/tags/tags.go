@@ -10,4 +10,17 @@ var (
 
 	Category = sdk.TagCategory
 	Sender   = sdk.TagSender
+
+	Slash         = "slash"
+	SlashReason   = "slash_reason"
+	SlashFraction = "slash_fraction"
+	SlashPower    = "slash_power"
+	Jail          = "jail"
+	JailReason    = "jail_reason"
+	Liveness      = "liveness"
+	MissedBlocks  = "missed_blocks"
+	Tombstone     = "tombstone"
+
+	ReasonDoubleSign       = "double_sign"
+	ReasonMissingSignature = "missing_signature"
 )
@@ -48,7 +48,7 @@ func createTestCodec() *codec.Codec {
 	return cdc
 }
 
-func createTestInput(t *testing.T, defaults Params) (sdk.Context, bank.Keeper, staking.Keeper, params.Subspace, Keeper) {
+func createTestInput(t testing.TB, defaults Params) (sdk.Context, bank.Keeper, staking.Keeper, params.Subspace, Keeper) {
 	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
 	keyStaking := sdk.NewKVStoreKey(staking.StoreKey)
 	tkeyStaking := sdk.NewTransientStoreKey(staking.TStoreKey)
@@ -118,6 +118,25 @@ func NewTestMsgCreateValidator(address sdk.ValAddress, pubKey crypto.PubKey, amt
 	)
 }
 
+// mockSlashingHooks records the slashing hook calls
+type mockSlashingHooks struct {
+	slashed  []string
+	jailed   []string
+	unjailed []sdk.ConsAddress
+}
+
+var _ SlashingHooks = &mockSlashingHooks{}
+
+func (h *mockSlashingHooks) AfterValidatorSlashed(_ sdk.Context, _ sdk.ConsAddress, _ sdk.Dec, reason string) {
+	h.slashed = append(h.slashed, reason)
+}
+func (h *mockSlashingHooks) AfterValidatorJailed(_ sdk.Context, _ sdk.ConsAddress, reason string) {
+	h.jailed = append(h.jailed, reason)
+}
+func (h *mockSlashingHooks) AfterValidatorUnjailed(_ sdk.Context, consAddr sdk.ConsAddress) {
+	h.unjailed = append(h.unjailed, consAddr)
+}
+
 func newTestMsgDelegate(delAddr sdk.AccAddress, valAddr sdk.ValAddress, delAmount sdk.Int) staking.MsgDelegate {
 	amount := sdk.NewCoin(sdk.DefaultBondDenom, delAmount)
 	return staking.NewMsgDelegate(delAddr, valAddr, amount)
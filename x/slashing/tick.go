@@ -11,12 +11,14 @@ import (
 
 // slashing begin block functionality
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, sk Keeper) sdk.Tags {
+	resTags := sdk.EmptyTags()
 
 	// Iterate over all the validators which *should* have signed this block
 	// store whether or not they have actually signed it and slash/unbond any
 	// which have missed too many blocks in a row (downtime slashing)
 	for _, voteInfo := range req.LastCommitInfo.GetVotes() {
-		sk.handleValidatorSignature(ctx, voteInfo.Validator.Address, voteInfo.Validator.Power, voteInfo.SignedLastBlock)
+		resTags = resTags.AppendTags(sk.handleValidatorSignature(ctx, voteInfo.Validator.Address,
+			voteInfo.Validator.Power, voteInfo.SignedLastBlock))
 	}
 
 	// Iterate through any newly discovered evidence of infraction
@@ -25,11 +27,12 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, sk Keeper) sdk.Ta
 	for _, evidence := range req.ByzantineValidators {
 		switch evidence.Type {
 		case tmtypes.ABCIEvidenceTypeDuplicateVote:
-			sk.handleDoubleSign(ctx, evidence.Validator.Address, evidence.Height, evidence.Time, evidence.Validator.Power)
+			resTags = resTags.AppendTags(sk.handleDoubleSign(ctx, evidence.Validator.Address,
+				evidence.Height, evidence.Time, evidence.Validator.Power))
 		default:
 			sk.Logger(ctx).Error(fmt.Sprintf("ignored unknown evidence type: %s", evidence.Type))
 		}
 	}
 
-	return sdk.EmptyTags()
+	return resTags
 }
@@ -28,6 +28,7 @@ const (
 	QueryDelegatorValidator            = querier.QueryDelegatorValidator
 	QueryPool                          = querier.QueryPool
 	QueryParameters                    = querier.QueryParameters
+	QueryValidatorsBelowMinSelfDel     = querier.QueryValidatorsBelowMinSelfDel
 	DefaultCodespace                   = types.DefaultCodespace
 	CodeInvalidValidator               = types.CodeInvalidValidator
 	CodeInvalidDelegation              = types.CodeInvalidDelegation
@@ -163,6 +164,7 @@ var (
 	ErrBadRedelegationDst              = types.ErrBadRedelegationDst
 	ErrTransitiveRedelegation          = types.ErrTransitiveRedelegation
 	ErrMaxRedelegationEntries          = types.ErrMaxRedelegationEntries
+	ErrRedelegationFromJailed          = types.ErrRedelegationFromJailed
 	ErrDelegatorShareExRateInvalid     = types.ErrDelegatorShareExRateInvalid
 	ErrBothShareMsgsGiven              = types.ErrBothShareMsgsGiven
 	ErrNeitherShareMsgsGiven           = types.ErrNeitherShareMsgsGiven
@@ -211,6 +213,7 @@ var (
 	KeyMaxValidators                 = types.KeyMaxValidators
 	KeyMaxEntries                    = types.KeyMaxEntries
 	KeyBondDenom                     = types.KeyBondDenom
+	KeyRestrictJailedRedelegations   = types.KeyRestrictJailedRedelegations
 )
 
 type (
@@ -245,6 +248,7 @@ type (
 	GenesisState              = types.GenesisState
 	LastValidatorPower        = types.LastValidatorPower
 	MultiStakingHooks         = types.MultiStakingHooks
+	RedelegationRestriction   = types.RedelegationRestriction
 	MsgCreateValidator        = types.MsgCreateValidator
 	MsgEditValidator          = types.MsgEditValidator
 	MsgDelegate               = types.MsgDelegate
@@ -84,6 +84,39 @@ $ %s query staking validators
 	}
 }
 
+// GetCmdQueryValidatorsBelowMinSelfDelegation implements the query for all
+// validators whose self delegation is below their minimum self delegation.
+func GetCmdQueryValidatorsBelowMinSelfDelegation(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validators-below-min-self-delegation",
+		Short: "Query for all validators with a self delegation below their minimum",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query details about all validators whose operator self delegation is
+currently below their declared minimum self delegation.
+
+Example:
+$ %s query staking validators-below-min-self-delegation
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", storeName, staking.QueryValidatorsBelowMinSelfDel)
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var validators staking.Validators
+			cdc.MustUnmarshalJSON(res, &validators)
+			return cliCtx.PrintOutput(validators)
+		},
+	}
+}
+
 // GetCmdQueryValidatorUnbondingDelegations implements the query all unbonding delegatations from a validator command.
 func GetCmdQueryValidatorUnbondingDelegations(storeKey string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -34,6 +34,7 @@ func (mc ModuleClient) GetQueryCmd() *cobra.Command {
 		cli.GetCmdQueryRedelegations(mc.storeKey, mc.cdc),
 		cli.GetCmdQueryValidator(mc.storeKey, mc.cdc),
 		cli.GetCmdQueryValidators(mc.storeKey, mc.cdc),
+		cli.GetCmdQueryValidatorsBelowMinSelfDelegation(mc.storeKey, mc.cdc),
 		cli.GetCmdQueryValidatorDelegations(mc.storeKey, mc.cdc),
 		cli.GetCmdQueryValidatorUnbondingDelegations(mc.storeKey, mc.cdc),
 		cli.GetCmdQueryValidatorRedelegations(mc.storeKey, mc.cdc),
@@ -244,6 +244,8 @@ func handleMsgUndelegate(ctx sdk.Context, msg types.MsgUndelegate, k keeper.Keep
 		return err.Result()
 	}
 
+	breachTags := selfDelegationBreachTags(ctx, k, msg.DelegatorAddress, msg.ValidatorAddress, shares)
+
 	completionTime, err := k.Undelegate(ctx, msg.DelegatorAddress, msg.ValidatorAddress, shares)
 	if err != nil {
 		return err.Result()
@@ -255,7 +257,7 @@ func handleMsgUndelegate(ctx sdk.Context, msg types.MsgUndelegate, k keeper.Keep
 		tags.Sender, msg.DelegatorAddress.String(),
 		tags.SrcValidator, msg.ValidatorAddress.String(),
 		tags.EndTime, completionTime.Format(time.RFC3339),
-	)
+	).AppendTags(breachTags)
 
 	return sdk.Result{Data: finishTime, Tags: resTags}
 }
@@ -268,6 +270,8 @@ func handleMsgBeginRedelegate(ctx sdk.Context, msg types.MsgBeginRedelegate, k k
 		return err.Result()
 	}
 
+	breachTags := selfDelegationBreachTags(ctx, k, msg.DelegatorAddress, msg.ValidatorSrcAddress, shares)
+
 	completionTime, err := k.BeginRedelegation(
 		ctx, msg.DelegatorAddress, msg.ValidatorSrcAddress, msg.ValidatorDstAddress, shares,
 	)
@@ -282,7 +286,27 @@ func handleMsgBeginRedelegate(ctx sdk.Context, msg types.MsgBeginRedelegate, k k
 		tags.SrcValidator, msg.ValidatorSrcAddress.String(),
 		tags.DstValidator, msg.ValidatorDstAddress.String(),
 		tags.EndTime, completionTime.Format(time.RFC3339),
-	)
+	).AppendTags(breachTags)
 
 	return sdk.Result{Data: finishTime, Tags: resTags}
 }
+
+// selfDelegationBreachTags returns the tags describing a validator being
+// jailed because removing the given shares pushes the operator's self
+// delegation below its minimum. It returns no tags if no breach occurs.
+func selfDelegationBreachTags(ctx sdk.Context, k keeper.Keeper, delAddr sdk.AccAddress,
+	valAddr sdk.ValAddress, shares sdk.Dec) sdk.Tags {
+
+	before, after, breach := k.SelfDelegationBreach(ctx, delAddr, valAddr, shares)
+	if !breach {
+		return sdk.EmptyTags()
+	}
+
+	validator, _ := k.GetValidator(ctx, valAddr)
+	return sdk.NewTags(
+		tags.JailedValidator, valAddr.String(),
+		tags.PrevSelfDelegation, before.String(),
+		tags.NewSelfDelegation, after.String(),
+		tags.MinSelfDelegation, validator.MinSelfDelegation.String(),
+	)
+}
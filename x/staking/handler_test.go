@@ -14,6 +14,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	keep "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/tags"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -408,6 +409,50 @@ func TestEditValidatorIncreaseMinSelfDelegationBeyondCurrentBond(t *testing.T) {
 	require.False(t, got.IsOK(), "should not be able to increase minSelfDelegation above current self delegation")
 }
 
+func TestUndelegateSelfDelegationBelowMinSelfDelegation(t *testing.T) {
+	validatorAddr := sdk.ValAddress(keep.Addrs[0])
+
+	initPower := int64(100)
+	initBond := sdk.TokensFromTendermintPower(100)
+	ctx, _, keeper := keep.CreateTestInput(t, false, initPower)
+	_ = setInstantUnbondPeriod(keeper, ctx)
+
+	// create validator
+	msgCreateValidator := NewTestMsgCreateValidator(validatorAddr, keep.PKs[0], initBond)
+	msgCreateValidator.MinSelfDelegation = initBond
+	got := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.True(t, got.IsOK(), "expected create-validator to be ok, got %v", got)
+
+	// must end-block
+	updates := keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.Equal(t, 1, len(updates))
+	require.Empty(t, keeper.GetValidatorsBelowMinSelfDelegation(ctx))
+
+	// undelegate a single token, pushing the self delegation below the minimum
+	unbondAmt := sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())
+	msgUndelegate := NewMsgUndelegate(sdk.AccAddress(validatorAddr), validatorAddr, unbondAmt)
+	got = handleMsgUndelegate(ctx, msgUndelegate, keeper)
+	require.True(t, got.IsOK(), "expected undelegate to be ok, got %v", got)
+
+	validator, found := keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.True(t, validator.Jailed)
+
+	expTags := sdk.NewTags(
+		tags.JailedValidator, validatorAddr.String(),
+		tags.PrevSelfDelegation, initBond.String(),
+		tags.NewSelfDelegation, initBond.Sub(sdk.OneInt()).String(),
+		tags.MinSelfDelegation, initBond.String(),
+	)
+	for _, tag := range expTags {
+		require.Contains(t, got.Tags, tag)
+	}
+
+	belowMin := keeper.GetValidatorsBelowMinSelfDelegation(ctx)
+	require.Len(t, belowMin, 1)
+	require.Equal(t, validatorAddr, belowMin[0].OperatorAddress)
+}
+
 func TestIncrementsMsgUnbond(t *testing.T) {
 	initPower := int64(1000)
 	initBond := sdk.TokensFromTendermintPower(initPower)
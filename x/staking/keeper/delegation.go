@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"bytes"
+	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -513,12 +514,13 @@ func (k Keeper) unbond(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValA
 	// subtract shares from delegation
 	delegation.Shares = delegation.Shares.Sub(shares)
 
-	isValidatorOperator := bytes.Equal(delegation.DelegatorAddress, validator.OperatorAddress)
-
 	// if the delegation is the operator of the validator and undelegating will decrease the validator's self delegation below their minimum
 	// trigger a jail validator
-	if isValidatorOperator && !validator.Jailed &&
-		validator.TokensFromShares(delegation.Shares).TruncateInt().LT(validator.MinSelfDelegation) {
+	if breachesMinSelfDelegation(validator, delegation.DelegatorAddress, delegation.Shares) {
+		k.Logger(ctx).Info(fmt.Sprintf(
+			"jailing validator %s: self-delegation %s below minimum %s",
+			validator.OperatorAddress, validator.TokensFromShares(delegation.Shares).TruncateInt(), validator.MinSelfDelegation,
+		))
 
 		k.jailValidator(ctx, validator)
 		validator = k.mustGetValidator(ctx, validator.OperatorAddress)
@@ -544,6 +546,62 @@ func (k Keeper) unbond(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValA
 	return amount, nil
 }
 
+// breachesMinSelfDelegation returns true if the delegator is the operator of a
+// non-jailed validator and the remaining shares are worth less than the
+// validator's minimum self delegation.
+func breachesMinSelfDelegation(validator types.Validator, delAddr sdk.AccAddress, remainingShares sdk.Dec) bool {
+	isValidatorOperator := bytes.Equal(delAddr, validator.OperatorAddress)
+	return isValidatorOperator && !validator.Jailed &&
+		validator.TokensFromShares(remainingShares).TruncateInt().LT(validator.MinSelfDelegation)
+}
+
+// SelfDelegationBreach returns the operator's self delegation before and after
+// removing the given shares, and whether the removal would push the self
+// delegation below the validator's minimum, which jails the validator.
+func (k Keeper) SelfDelegationBreach(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress,
+	shares sdk.Dec) (before, after sdk.Int, breach bool) {
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return sdk.ZeroInt(), sdk.ZeroInt(), false
+	}
+
+	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
+	if !found || delegation.Shares.LT(shares) {
+		return sdk.ZeroInt(), sdk.ZeroInt(), false
+	}
+
+	remaining := delegation.Shares.Sub(shares)
+	before = validator.TokensFromShares(delegation.Shares).TruncateInt()
+	after = validator.TokensFromShares(remaining).TruncateInt()
+
+	return before, after, breachesMinSelfDelegation(validator, delAddr, remaining)
+}
+
+// GetSelfDelegationTokens returns the amount of tokens the validator operator
+// has delegated to its own validator.
+func (k Keeper) GetSelfDelegationTokens(ctx sdk.Context, validator types.Validator) sdk.Int {
+	delegation, found := k.GetDelegation(ctx, sdk.AccAddress(validator.OperatorAddress), validator.OperatorAddress)
+	if !found {
+		return sdk.ZeroInt()
+	}
+
+	return validator.TokensFromShares(delegation.Shares).TruncateInt()
+}
+
+// GetValidatorsBelowMinSelfDelegation returns all validators whose operator
+// self delegation is currently below their declared minimum self delegation.
+func (k Keeper) GetValidatorsBelowMinSelfDelegation(ctx sdk.Context) (validators []types.Validator) {
+	validators = make([]types.Validator, 0)
+	for _, validator := range k.GetAllValidators(ctx) {
+		if k.GetSelfDelegationTokens(ctx, validator).LT(validator.MinSelfDelegation) {
+			validators = append(validators, validator)
+		}
+	}
+
+	return validators
+}
+
 // get info for begin functions: completionTime and CreationHeight
 func (k Keeper) getBeginInfo(ctx sdk.Context, valSrcAddr sdk.ValAddress) (
 	completionTime time.Time, height int64, completeNow bool) {
@@ -656,6 +714,21 @@ func (k Keeper) BeginRedelegation(ctx sdk.Context, delAddr sdk.AccAddress,
 		return time.Time{}, types.ErrSelfRedelegation(k.Codespace())
 	}
 
+	// a jailed validator is being slashed, redelegating away from it may be
+	// forbidden
+	if k.RestrictJailedRedelegations(ctx) {
+		srcValidator, found := k.GetValidator(ctx, valSrcAddr)
+		if found && srcValidator.IsJailed() {
+			return time.Time{}, types.ErrRedelegationFromJailed(k.Codespace())
+		}
+	}
+
+	if k.redelegationCheck != nil {
+		if err := k.redelegationCheck(ctx, delAddr, valSrcAddr, valDstAddr); err != nil {
+			return time.Time{}, err
+		}
+	}
+
 	// check if this is a transitive redelegation
 	if k.HasReceivingRedelegation(ctx, delAddr, valSrcAddr) {
 		return time.Time{}, types.ErrTransitiveRedelegation(k.Codespace())
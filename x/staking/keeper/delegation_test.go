@@ -664,6 +664,62 @@ func TestRedelegationMaxEntries(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestRedelegationRestrictions(t *testing.T) {
+	ctx, _, keeper := CreateTestInput(t, false, 0)
+	pool := keeper.GetPool(ctx)
+	startTokens := sdk.TokensFromTendermintPower(20)
+	pool.NotBondedTokens = startTokens
+
+	// create a validator with a self-delegation
+	validator := types.NewValidator(addrVals[0], PKs[0], types.Description{})
+	valTokens := sdk.TokensFromTendermintPower(10)
+	validator, pool, issuedShares := validator.AddTokensFromDel(pool, valTokens)
+	require.Equal(t, valTokens, issuedShares.RoundInt())
+	keeper.SetPool(ctx, pool)
+	validator = TestingUpdateValidator(keeper, ctx, validator, true)
+	pool = keeper.GetPool(ctx)
+	val0AccAddr := sdk.AccAddress(addrVals[0].Bytes())
+	selfDelegation := types.NewDelegation(val0AccAddr, addrVals[0], issuedShares)
+	keeper.SetDelegation(ctx, selfDelegation)
+
+	// create a second validator
+	validator2 := types.NewValidator(addrVals[1], PKs[1], types.Description{})
+	validator2, pool, issuedShares = validator2.AddTokensFromDel(pool, valTokens)
+	require.Equal(t, valTokens, issuedShares.RoundInt())
+	pool.BondedTokens = pool.BondedTokens.Add(valTokens)
+	keeper.SetPool(ctx, pool)
+	validator2 = TestingUpdateValidator(keeper, ctx, validator2, true)
+	require.Equal(t, sdk.Bonded, validator2.Status)
+
+	// jail the first validator
+	keeper.jailValidator(ctx, validator)
+	validator, _ = keeper.GetValidator(ctx, addrVals[0])
+	require.True(t, validator.IsJailed())
+
+	// redelegating away from a jailed validator is allowed unless restricted
+	params := keeper.GetParams(ctx)
+	params.RestrictJailedRedelegations = true
+	keeper.SetParams(ctx, params)
+	_, err := keeper.BeginRedelegation(ctx, val0AccAddr, addrVals[0], addrVals[1], sdk.NewDec(1))
+	require.Equal(t, types.CodeValidatorJailed, err.Code())
+
+	params.RestrictJailedRedelegations = false
+	keeper.SetParams(ctx, params)
+	_, err = keeper.BeginRedelegation(ctx, val0AccAddr, addrVals[0], addrVals[1], sdk.NewDec(1))
+	require.NoError(t, err)
+
+	// the redelegation restriction forbids the redelegation
+	restricted := keeper.SetRedelegationRestriction(func(_ sdk.Context, _ sdk.AccAddress, _, valDstAddr sdk.ValAddress) sdk.Error {
+		if valDstAddr.Equals(addrVals[1]) {
+			return types.ErrBadRedelegationDst(keeper.Codespace())
+		}
+		return nil
+	})
+	_, err = restricted.BeginRedelegation(ctx, val0AccAddr, addrVals[0], addrVals[1], sdk.NewDec(1))
+	require.Equal(t, types.CodeInvalidDelegation, err.Code())
+	require.Panics(t, func() { restricted.SetRedelegationRestriction(nil) })
+}
+
 func TestRedelegateSelfDelegation(t *testing.T) {
 	ctx, _, keeper := CreateTestInput(t, false, 0)
 	pool := keeper.GetPool(ctx)
@@ -20,6 +20,7 @@ type Keeper struct {
 	cdc                *codec.Codec
 	bankKeeper         types.BankKeeper
 	hooks              sdk.StakingHooks
+	redelegationCheck  types.RedelegationRestriction
 	paramstore         params.Subspace
 	validatorCache     map[string]cachedValidator
 	validatorCacheList *list.List
@@ -57,6 +58,15 @@ func (k *Keeper) SetHooks(sh sdk.StakingHooks) *Keeper {
 	return k
 }
 
+// Set the redelegation restriction
+func (k *Keeper) SetRedelegationRestriction(restriction types.RedelegationRestriction) *Keeper {
+	if k.redelegationCheck != nil {
+		panic("cannot set redelegation restriction twice")
+	}
+	k.redelegationCheck = restriction
+	return k
+}
+
 // return the codespace
 func (k Keeper) Codespace() sdk.CodespaceType {
 	return k.codespace
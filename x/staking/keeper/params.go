@@ -43,6 +43,13 @@ func (k Keeper) BondDenom(ctx sdk.Context) (res string) {
 	return
 }
 
+// RestrictJailedRedelegations - Whether redelegating away from a jailed
+// validator is forbidden
+func (k Keeper) RestrictJailedRedelegations(ctx sdk.Context) (res bool) {
+	k.paramstore.Get(ctx, types.KeyRestrictJailedRedelegations, &res)
+	return
+}
+
 // Get all parameteras as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
@@ -50,6 +57,7 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		k.MaxValidators(ctx),
 		k.MaxEntries(ctx),
 		k.BondDenom(ctx),
+		k.RestrictJailedRedelegations(ctx),
 	)
 }
 
@@ -10,8 +10,9 @@ import (
 )
 
 var (
-	_ sdk.AppModule      = AppModule{}
-	_ sdk.AppModuleBasic = AppModuleBasic{}
+	_ sdk.AppModule          = AppModule{}
+	_ sdk.AppModuleBasic     = AppModuleBasic{}
+	_ sdk.AppModuleDescriber = AppModule{}
 )
 
 // app module basics object
@@ -96,6 +97,37 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 	return NewQuerier(am.keeper)
 }
 
+// module message types
+func (AppModule) MsgTypes() []string {
+	return []string{
+		MsgCreateValidator{}.Type(),
+		MsgEditValidator{}.Type(),
+		MsgDelegate{}.Type(),
+		MsgBeginRedelegate{}.Type(),
+		MsgUndelegate{}.Type(),
+	}
+}
+
+// module query paths
+func (AppModule) QueryPaths() []string {
+	return []string{
+		QueryValidators,
+		QueryValidator,
+		QueryValidatorDelegations,
+		QueryValidatorUnbondingDelegations,
+		QueryDelegation,
+		QueryUnbondingDelegation,
+		QueryDelegatorDelegations,
+		QueryDelegatorUnbondingDelegations,
+		QueryRedelegations,
+		QueryDelegatorValidators,
+		QueryDelegatorValidator,
+		QueryPool,
+		QueryParameters,
+		QueryValidatorsBelowMinSelfDel,
+	}
+}
+
 // module init-genesis
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
 	var genesisState GenesisState
@@ -28,6 +28,7 @@ const (
 	QueryDelegatorValidator            = "delegatorValidator"
 	QueryPool                          = "pool"
 	QueryParameters                    = "parameters"
+	QueryValidatorsBelowMinSelfDel     = "validatorsBelowMinSelfDelegation"
 )
 
 // creates a querier for staking REST endpoints
@@ -60,6 +61,8 @@ func NewQuerier(k keep.Keeper) sdk.Querier {
 			return queryPool(ctx, k)
 		case QueryParameters:
 			return queryParameters(ctx, k)
+		case QueryValidatorsBelowMinSelfDel:
+			return queryValidatorsBelowMinSelfDelegation(ctx, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown staking query endpoint")
 		}
@@ -411,6 +414,16 @@ func queryParameters(ctx sdk.Context, k keep.Keeper) (res []byte, err sdk.Error)
 	return res, nil
 }
 
+func queryValidatorsBelowMinSelfDelegation(ctx sdk.Context, k keep.Keeper) (res []byte, err sdk.Error) {
+	validators := k.GetValidatorsBelowMinSelfDelegation(ctx)
+
+	res, errRes := codec.MarshalJSONIndent(types.ModuleCdc, validators)
+	if errRes != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", errRes.Error()))
+	}
+	return res, nil
+}
+
 // QueryValidatorsParams defines the params for the following queries:
 // - 'custom/staking/validators'
 type QueryValidatorsParams struct {
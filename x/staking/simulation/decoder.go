@@ -0,0 +1,83 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+// DecodeStore unmarshals the KVPair's Value to the corresponding staking type
+// and returns its human readable representation. It panics on unknown keys.
+func DecodeStore(cdc *codec.Codec, kvPair cmn.KVPair) string {
+	if len(kvPair.Key) == 0 {
+		panic("empty staking key")
+	}
+
+	switch prefix := kvPair.Key[:1]; {
+	case bytes.Equal(prefix, staking.PoolKey):
+		var pool staking.Pool
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvPair.Value, &pool)
+		return fmt.Sprintf("Pool: %v", pool)
+
+	case bytes.Equal(prefix, staking.LastTotalPowerKey):
+		var power sdk.Int
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvPair.Value, &power)
+		return fmt.Sprintf("LastTotalPower: %v", power)
+
+	case bytes.Equal(prefix, staking.LastValidatorPowerKey):
+		var power int64
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvPair.Value, &power)
+		return fmt.Sprintf("LastValidatorPower %s: %d", sdk.ValAddress(kvPair.Key[1:]), power)
+
+	case bytes.Equal(prefix, staking.ValidatorsKey):
+		validator := staking.MustUnmarshalValidator(cdc, kvPair.Value)
+		return fmt.Sprintf("Validator: %v", validator)
+
+	case bytes.Equal(prefix, staking.ValidatorsByConsAddrKey):
+		return fmt.Sprintf("ValidatorByConsAddr %s: %s",
+			sdk.ConsAddress(kvPair.Key[1:]), sdk.ValAddress(kvPair.Value))
+
+	case bytes.Equal(prefix, staking.ValidatorsByPowerIndexKey):
+		return fmt.Sprintf("ValidatorByPowerIndex %X: %s", kvPair.Key[1:], sdk.ValAddress(kvPair.Value))
+
+	case bytes.Equal(prefix, staking.DelegationKey):
+		delegation := staking.MustUnmarshalDelegation(cdc, kvPair.Value)
+		return fmt.Sprintf("Delegation: %v", delegation)
+
+	case bytes.Equal(prefix, staking.UnbondingDelegationKey):
+		ubd := staking.MustUnmarshalUBD(cdc, kvPair.Value)
+		return fmt.Sprintf("UnbondingDelegation: %v", ubd)
+
+	case bytes.Equal(prefix, staking.RedelegationKey):
+		red := staking.MustUnmarshalRED(cdc, kvPair.Value)
+		return fmt.Sprintf("Redelegation: %v", red)
+
+	case bytes.Equal(prefix, staking.UnbondingDelegationByValIndexKey),
+		bytes.Equal(prefix, staking.RedelegationByValSrcIndexKey),
+		bytes.Equal(prefix, staking.RedelegationByValDstIndexKey):
+		return fmt.Sprintf("Index %X", kvPair.Key)
+
+	case bytes.Equal(prefix, staking.UnbondingQueueKey):
+		var dvPairs []staking.DVPair
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvPair.Value, &dvPairs)
+		return fmt.Sprintf("UnbondingQueue %X: %v", kvPair.Key[1:], dvPairs)
+
+	case bytes.Equal(prefix, staking.RedelegationQueueKey):
+		var dvvTriplets []staking.DVVTriplet
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvPair.Value, &dvvTriplets)
+		return fmt.Sprintf("RedelegationQueue %X: %v", kvPair.Key[1:], dvvTriplets)
+
+	case bytes.Equal(prefix, staking.ValidatorQueueKey):
+		var valAddrs []sdk.ValAddress
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvPair.Value, &valAddrs)
+		return fmt.Sprintf("ValidatorQueue %X: %v", kvPair.Key[1:], valAddrs)
+
+	default:
+		panic(fmt.Sprintf("invalid staking key %X", kvPair.Key))
+	}
+}
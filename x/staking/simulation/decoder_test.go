@@ -0,0 +1,100 @@
+package simulation
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+var (
+	delPk1    = ed25519.GenPrivKey().PubKey()
+	delAddr1  = sdk.AccAddress(delPk1.Address())
+	valAddr1  = sdk.ValAddress(delPk1.Address())
+	valAddr2  = sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	consAddr1 = sdk.ConsAddress(delPk1.Address().Bytes())
+)
+
+func makeTestCodec() (cdc *codec.Codec) {
+	cdc = codec.New()
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	staking.RegisterCodec(cdc)
+	return
+}
+
+func TestDecodeStore(t *testing.T) {
+	cdc := makeTestCodec()
+
+	bondTime := time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	pool := staking.InitialPool()
+	val := staking.NewValidator(valAddr1, delPk1, staking.NewDescription("test", "test", "test", "test"))
+	del := staking.NewDelegation(delAddr1, valAddr1, sdk.OneDec())
+	ubd := staking.NewUnbondingDelegation(delAddr1, valAddr1, 15, bondTime, sdk.OneInt())
+	red := staking.NewRedelegation(delAddr1, valAddr1, valAddr2, 12, bondTime, sdk.OneInt(), sdk.OneDec())
+	dvPairs := []staking.DVPair{{DelegatorAddress: delAddr1, ValidatorAddress: valAddr1}}
+	dvvTriplets := []staking.DVVTriplet{{DelegatorAddress: delAddr1, ValidatorSrcAddress: valAddr1, ValidatorDstAddress: valAddr2}}
+	valAddrs := []sdk.ValAddress{valAddr1}
+
+	kvPairs := cmn.KVPairs{
+		cmn.KVPair{Key: staking.PoolKey, Value: cdc.MustMarshalBinaryLengthPrefixed(pool)},
+		cmn.KVPair{Key: staking.LastTotalPowerKey, Value: cdc.MustMarshalBinaryLengthPrefixed(sdk.OneInt())},
+		cmn.KVPair{Key: staking.GetLastValidatorPowerKey(valAddr1), Value: cdc.MustMarshalBinaryLengthPrefixed(int64(10))},
+		cmn.KVPair{Key: staking.GetValidatorKey(valAddr1), Value: staking.MustMarshalValidator(cdc, val)},
+		cmn.KVPair{Key: staking.GetValidatorByConsAddrKey(consAddr1), Value: valAddr1.Bytes()},
+		cmn.KVPair{Key: staking.GetValidatorsByPowerIndexKey(val), Value: valAddr1.Bytes()},
+		cmn.KVPair{Key: staking.GetDelegationKey(delAddr1, valAddr1), Value: staking.MustMarshalDelegation(cdc, del)},
+		cmn.KVPair{Key: staking.GetUBDKey(delAddr1, valAddr1), Value: staking.MustMarshalUBD(cdc, ubd)},
+		cmn.KVPair{Key: staking.GetREDKey(delAddr1, valAddr1, valAddr2), Value: staking.MustMarshalRED(cdc, red)},
+		cmn.KVPair{Key: staking.GetUBDByValIndexKey(delAddr1, valAddr1), Value: []byte{}},
+		cmn.KVPair{Key: staking.GetREDByValSrcIndexKey(delAddr1, valAddr1, valAddr2), Value: []byte{}},
+		cmn.KVPair{Key: staking.GetREDByValDstIndexKey(delAddr1, valAddr1, valAddr2), Value: []byte{}},
+		cmn.KVPair{Key: staking.GetUnbondingDelegationTimeKey(bondTime), Value: cdc.MustMarshalBinaryLengthPrefixed(dvPairs)},
+		cmn.KVPair{Key: staking.GetRedelegationTimeKey(bondTime), Value: cdc.MustMarshalBinaryLengthPrefixed(dvvTriplets)},
+		cmn.KVPair{Key: staking.GetValidatorQueueTimeKey(bondTime), Value: cdc.MustMarshalBinaryLengthPrefixed(valAddrs)},
+		cmn.KVPair{Key: []byte{0x99}, Value: []byte{0x99}},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Pool", fmt.Sprintf("Pool: %v", pool)},
+		{"LastTotalPower", fmt.Sprintf("LastTotalPower: %v", sdk.OneInt())},
+		{"LastValidatorPower", fmt.Sprintf("LastValidatorPower %s: 10", valAddr1)},
+		{"Validator", fmt.Sprintf("Validator: %v", val)},
+		{"ValidatorByConsAddr", fmt.Sprintf("ValidatorByConsAddr %s: %s", consAddr1, valAddr1)},
+		{"ValidatorByPowerIndex", fmt.Sprintf("ValidatorByPowerIndex %X: %s", kvPairs[5].Key[1:], valAddr1)},
+		{"Delegation", fmt.Sprintf("Delegation: %v", del)},
+		{"UnbondingDelegation", fmt.Sprintf("UnbondingDelegation: %v", ubd)},
+		{"Redelegation", fmt.Sprintf("Redelegation: %v", red)},
+		{"UnbondingDelegationByValIndex", fmt.Sprintf("Index %X", kvPairs[9].Key)},
+		{"RedelegationByValSrcIndex", fmt.Sprintf("Index %X", kvPairs[10].Key)},
+		{"RedelegationByValDstIndex", fmt.Sprintf("Index %X", kvPairs[11].Key)},
+		{"UnbondingQueue", fmt.Sprintf("UnbondingQueue %X: %v", kvPairs[12].Key[1:], dvPairs)},
+		{"RedelegationQueue", fmt.Sprintf("RedelegationQueue %X: %v", kvPairs[13].Key[1:], dvvTriplets)},
+		{"ValidatorQueue", fmt.Sprintf("ValidatorQueue %X: %v", kvPairs[14].Key[1:], valAddrs)},
+		{"other", ""},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { DecodeStore(cdc, kvPairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, DecodeStore(cdc, kvPairs[i]), tt.name)
+			}
+		})
+	}
+}
@@ -17,4 +17,9 @@ var (
 	DstValidator = sdk.TagDstValidator
 	Delegator    = sdk.TagDelegator
 	EndTime      = "end-time"
+
+	JailedValidator    = "jailed-validator"
+	PrevSelfDelegation = "previous-self-delegation"
+	NewSelfDelegation  = "new-self-delegation"
+	MinSelfDelegation  = "min-self-delegation"
 )
@@ -468,3 +468,11 @@ func (r RedelegationResponses) String() (out string) {
 	}
 	return strings.TrimSpace(out)
 }
+
+//________________________________________________________________________
+
+// RedelegationRestriction is run before a redelegation from the source to the
+// destination validator starts, and forbids it by returning an error. It lets
+// other modules, eg. slashing, restrict the validators one can redelegate to.
+type RedelegationRestriction func(ctx sdk.Context, delAddr sdk.AccAddress,
+	valSrcAddr, valDstAddr sdk.ValAddress) sdk.Error
@@ -196,6 +196,10 @@ func ErrMaxRedelegationEntries(codespace sdk.CodespaceType) sdk.Error {
 		"too many redelegation entries in this delegator/src-validator/dst-validator trio, please wait for some entries to mature")
 }
 
+func ErrRedelegationFromJailed(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeValidatorJailed, "cannot redelegate away from a jailed validator")
+}
+
 func ErrDelegatorShareExRateInvalid(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidDelegation,
 		"cannot delegate to validators with invalid (zero) ex-rate")
@@ -29,6 +29,8 @@ var (
 	KeyMaxValidators = []byte("MaxValidators")
 	KeyMaxEntries    = []byte("KeyMaxEntries")
 	KeyBondDenom     = []byte("BondDenom")
+
+	KeyRestrictJailedRedelegations = []byte("RestrictJailedRedelegations")
 )
 
 var _ params.ParamSet = (*Params)(nil)
@@ -40,16 +42,20 @@ type Params struct {
 	MaxEntries    uint16        `json:"max_entries"`    // max entries for either unbonding delegation or redelegation (per pair/trio)
 	// note: we need to be a bit careful about potential overflow here, since this is user-determined
 	BondDenom string `json:"bond_denom"` // bondable coin denomination
+
+	// forbids redelegating away from a jailed validator, which is being slashed
+	RestrictJailedRedelegations bool `json:"restrict_jailed_redelegations"`
 }
 
 func NewParams(unbondingTime time.Duration, maxValidators, maxEntries uint16,
-	bondDenom string) Params {
+	bondDenom string, restrictJailedRedelegations bool) Params {
 
 	return Params{
-		UnbondingTime: unbondingTime,
-		MaxValidators: maxValidators,
-		MaxEntries:    maxEntries,
-		BondDenom:     bondDenom,
+		UnbondingTime:               unbondingTime,
+		MaxValidators:               maxValidators,
+		MaxEntries:                  maxEntries,
+		BondDenom:                   bondDenom,
+		RestrictJailedRedelegations: restrictJailedRedelegations,
 	}
 }
 
@@ -60,6 +66,7 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{KeyMaxValidators, &p.MaxValidators},
 		{KeyMaxEntries, &p.MaxEntries},
 		{KeyBondDenom, &p.BondDenom},
+		{KeyRestrictJailedRedelegations, &p.RestrictJailedRedelegations},
 	}
 }
 
@@ -73,7 +80,7 @@ func (p Params) Equal(p2 Params) bool {
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams(DefaultUnbondingTime, DefaultMaxValidators, DefaultMaxEntries, sdk.DefaultBondDenom)
+	return NewParams(DefaultUnbondingTime, DefaultMaxValidators, DefaultMaxEntries, sdk.DefaultBondDenom, false)
 }
 
 // String returns a human readable string representation of the parameters.
@@ -82,8 +89,9 @@ func (p Params) String() string {
   Unbonding Time:    %s
   Max Validators:    %d
   Max Entries:       %d
-  Bonded Coin Denom: %s`, p.UnbondingTime,
-		p.MaxValidators, p.MaxEntries, p.BondDenom)
+  Bonded Coin Denom: %s
+  Restrict Jailed Redelegations: %t`, p.UnbondingTime,
+		p.MaxValidators, p.MaxEntries, p.BondDenom, p.RestrictJailedRedelegations)
 }
 
 // unmarshal the current staking params value from store key or panic
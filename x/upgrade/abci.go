@@ -0,0 +1,38 @@
+package upgrade
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker applies the scheduled upgrade once its height is reached. If
+// the running binary has no handler registered for the upgrade, the chain is
+// halted so that the node operators can switch to the new binary. A binary
+// registering the handler of an upgrade not yet due halts the chain too, as
+// it was started too early.
+func BeginBlocker(ctx sdk.Context, k Keeper) {
+	plan, found := k.GetUpgradePlan(ctx)
+	if !found {
+		return
+	}
+
+	if !plan.ShouldExecute(ctx) {
+		if k.HasUpgradeHandler(plan.Name) {
+			msg := fmt.Sprintf("BINARY UPDATED BEFORE TRIGGER! UPGRADE \"%s\" IS SCHEDULED AT HEIGHT %d",
+				plan.Name, plan.Height)
+			k.Logger(ctx).Error(msg)
+			panic(msg)
+		}
+		return
+	}
+
+	if !k.HasUpgradeHandler(plan.Name) {
+		msg := fmt.Sprintf("UPGRADE \"%s\" NEEDED at height %d: %s", plan.Name, ctx.BlockHeight(), plan.Info)
+		k.Logger(ctx).Error(msg)
+		panic(msg)
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("applying upgrade \"%s\" at height %d", plan.Name, ctx.BlockHeight()))
+	k.ApplyUpgrade(ctx, plan)
+}
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+)
+
+// GetCmdQueryPlan implements the query upgrade plan command.
+func GetCmdQueryPlan(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "plan",
+		Args:  cobra.NoArgs,
+		Short: "Query the scheduled upgrade plan",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, upgrade.QueryPlan), nil)
+			if err != nil {
+				return err
+			}
+
+			var plan upgrade.Plan
+			cdc.MustUnmarshalJSON(res, &plan)
+			return cliCtx.PrintOutput(plan)
+		},
+	}
+}
+
+// GetCmdQueryApplied implements the query applied upgrades command.
+func GetCmdQueryApplied(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "applied",
+		Args:  cobra.NoArgs,
+		Short: "Query the applied upgrades along with their heights",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, upgrade.QueryApplied), nil)
+			if err != nil {
+				return err
+			}
+
+			var doneUpgrades []upgrade.DoneUpgrade
+			cdc.MustUnmarshalJSON(res, &doneUpgrades)
+			return cliCtx.PrintOutput(doneUpgrades)
+		},
+	}
+}
@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	upgradecutils "github.com/cosmos/cosmos-sdk/x/upgrade/client/utils"
+)
+
+// GetCmdSubmitSoftwareUpgradeProposal implements the command to submit a
+// software upgrade proposal.
+func GetCmdSubmitSoftwareUpgradeProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "software-upgrade [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a software upgrade proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to upgrade the chain software at a given height, along
+with an initial deposit. Once the proposal passes, the chain halts at the
+upgrade height until the nodes run a binary registering the upgrade handler of
+the plan name. A passed proposal replaces any upgrade already scheduled. The
+proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal software-upgrade <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Upgrade to v2",
+  "description": "Upgrade the chain software to v2",
+  "plan": {
+    "name": "v2",
+    "height": "1000000",
+    "info": "https://example.com/binaries/v2"
+  },
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			proposal, err := upgradecutils.ParseSoftwareUpgradeProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := upgrade.NewSoftwareUpgradeProposal(proposal.Title, proposal.Description, proposal.Plan)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSubmitCancelSoftwareUpgradeProposal implements the command to submit
+// a cancel software upgrade proposal.
+func GetCmdSubmitCancelSoftwareUpgradeProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-software-upgrade [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a cancel software upgrade proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to cancel the scheduled software upgrade, along with an
+initial deposit. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal cancel-software-upgrade <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Cancel Upgrade to v2",
+  "description": "The v2 binary has a critical bug",
+  "deposit": [
+    {
+      "denom": "stake",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithAccountDecoder(cdc)
+
+			proposal, err := upgradecutils.ParseCancelSoftwareUpgradeProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := upgrade.NewCancelSoftwareUpgradeProposal(proposal.Title, proposal.Description)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
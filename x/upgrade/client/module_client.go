@@ -0,0 +1,44 @@
+package client
+
+import (
+	"github.com/spf13/cobra"
+	amino "github.com/tendermint/go-amino"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/client/cli"
+)
+
+// ModuleClient exports all client functionality from this module
+type ModuleClient struct {
+	storeKey string
+	cdc      *amino.Codec
+}
+
+// NewModuleClient creates a new ModuleClient object
+func NewModuleClient(storeKey string, cdc *amino.Codec) ModuleClient {
+	return ModuleClient{
+		storeKey: storeKey,
+		cdc:      cdc,
+	}
+}
+
+// GetQueryCmd returns the cli query commands for this module
+func (mc ModuleClient) GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:   upgrade.ModuleName,
+		Short: "Querying commands for the upgrade module",
+	}
+
+	queryCmd.AddCommand(client.GetCommands(
+		cli.GetCmdQueryPlan(mc.storeKey, mc.cdc),
+		cli.GetCmdQueryApplied(mc.storeKey, mc.cdc),
+	)...)
+	return queryCmd
+}
+
+// GetTxCmd returns the transaction commands for this module, upgrades are
+// only scheduled through governance proposals
+func (ModuleClient) GetTxCmd() *cobra.Command {
+	return nil
+}
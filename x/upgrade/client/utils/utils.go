@@ -0,0 +1,61 @@
+package utils
+
+import (
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+)
+
+// SoftwareUpgradeProposalJSON defines a SoftwareUpgradeProposal with a
+// deposit used to parse software upgrade proposals from a JSON file.
+type SoftwareUpgradeProposalJSON struct {
+	Title       string       `json:"title"`
+	Description string       `json:"description"`
+	Plan        upgrade.Plan `json:"plan"`
+	Deposit     sdk.Coins    `json:"deposit"`
+}
+
+// CancelSoftwareUpgradeProposalJSON defines a CancelSoftwareUpgradeProposal
+// with a deposit used to parse cancel software upgrade proposals from a JSON
+// file.
+type CancelSoftwareUpgradeProposalJSON struct {
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	Deposit     sdk.Coins `json:"deposit"`
+}
+
+// ParseSoftwareUpgradeProposalJSON reads and parses a
+// SoftwareUpgradeProposalJSON from file.
+func ParseSoftwareUpgradeProposalJSON(cdc *codec.Codec, proposalFile string) (SoftwareUpgradeProposalJSON, error) {
+	proposal := SoftwareUpgradeProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseCancelSoftwareUpgradeProposalJSON reads and parses a
+// CancelSoftwareUpgradeProposalJSON from file.
+func ParseCancelSoftwareUpgradeProposalJSON(cdc *codec.Codec, proposalFile string) (CancelSoftwareUpgradeProposalJSON, error) {
+	proposal := CancelSoftwareUpgradeProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
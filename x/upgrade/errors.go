@@ -0,0 +1,30 @@
+package upgrade
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeInvalidPlan   sdk.CodeType = 1
+	CodeUpgradeDone   sdk.CodeType = 2
+	CodeNoUpgradePlan sdk.CodeType = 3
+)
+
+// ErrInvalidPlan returns an error for an invalid upgrade plan
+func ErrInvalidPlan(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPlan, msg)
+}
+
+// ErrUpgradeDone returns an error for a plan of an already applied upgrade
+func ErrUpgradeDone(codespace sdk.CodespaceType, name string, height int64) sdk.Error {
+	return sdk.NewError(codespace, CodeUpgradeDone, fmt.Sprintf("upgrade %s was already applied at height %d", name, height))
+}
+
+// ErrNoUpgradePlan returns an error when no upgrade is scheduled
+func ErrNoUpgradePlan(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoUpgradePlan, "no upgrade scheduled")
+}
@@ -0,0 +1,86 @@
+package upgrade
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - upgrade genesis state
+type GenesisState struct {
+	Plan         *Plan         `json:"plan"`
+	DoneUpgrades []DoneUpgrade `json:"done_upgrades"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(plan *Plan, doneUpgrades []DoneUpgrade) GenesisState {
+	return GenesisState{
+		Plan:         plan,
+		DoneUpgrades: doneUpgrades,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object, with no upgrade
+// scheduled
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Plan:         nil,
+		DoneUpgrades: []DoneUpgrade{},
+	}
+}
+
+// InitGenesis sets the scheduled upgrade and the applied upgrades from a
+// genesis state
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+	if data.Plan != nil {
+		k.setUpgradePlan(ctx, *data.Plan)
+	}
+	for _, du := range data.DoneUpgrades {
+		k.SetDone(ctx, du.Name, du.Height)
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	var plan *Plan
+	if p, found := k.GetUpgradePlan(ctx); found {
+		plan = &p
+	}
+
+	doneUpgrades := []DoneUpgrade{}
+	k.IterateDoneUpgrades(ctx, func(du DoneUpgrade) bool {
+		doneUpgrades = append(doneUpgrades, du)
+		return false
+	})
+
+	return NewGenesisState(plan, doneUpgrades)
+}
+
+// ValidateGenesis performs basic validation of the upgrade genesis data
+// returning an error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	done := make(map[string]bool)
+	for _, du := range data.DoneUpgrades {
+		if du.Name == "" {
+			return fmt.Errorf("applied upgrade name cannot be empty")
+		}
+		if du.Height <= 0 {
+			return fmt.Errorf("applied upgrade %s must have a positive height, got %d", du.Name, du.Height)
+		}
+		if done[du.Name] {
+			return fmt.Errorf("duplicate applied upgrade %s", du.Name)
+		}
+		done[du.Name] = true
+	}
+
+	if data.Plan != nil {
+		if err := data.Plan.ValidateBasic(); err != nil {
+			return err
+		}
+		if done[data.Plan.Name] {
+			return fmt.Errorf("scheduled upgrade %s was already applied", data.Plan.Name)
+		}
+	}
+
+	return nil
+}
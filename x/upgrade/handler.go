@@ -0,0 +1,46 @@
+package upgrade
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+// NewSoftwareUpgradeProposalHandler returns a handler for the software
+// upgrade proposals
+func NewSoftwareUpgradeProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case SoftwareUpgradeProposal:
+			return handleSoftwareUpgradeProposal(ctx, k, c)
+
+		case CancelSoftwareUpgradeProposal:
+			return handleCancelSoftwareUpgradeProposal(ctx, k, c)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized software upgrade proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg)
+		}
+	}
+}
+
+func handleSoftwareUpgradeProposal(ctx sdk.Context, k Keeper, p SoftwareUpgradeProposal) sdk.Error {
+	if err := k.ScheduleUpgrade(ctx, p.Plan); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info(fmt.Sprintf("scheduled upgrade \"%s\" at height %d", p.Plan.Name, p.Plan.Height))
+	return nil
+}
+
+func handleCancelSoftwareUpgradeProposal(ctx sdk.Context, k Keeper, _ CancelSoftwareUpgradeProposal) sdk.Error {
+	plan, found := k.GetUpgradePlan(ctx)
+	if !found {
+		return ErrNoUpgradePlan(k.codespace)
+	}
+	k.ClearUpgradePlan(ctx)
+
+	k.Logger(ctx).Info(fmt.Sprintf("cancelled upgrade \"%s\" scheduled at height %d", plan.Name, plan.Height))
+	return nil
+}
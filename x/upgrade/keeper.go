@@ -0,0 +1,143 @@
+package upgrade
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UpgradeHandler applies the state migrations of an upgrade, it is run by the
+// new binary at the upgrade height
+type UpgradeHandler func(ctx sdk.Context, plan Plan)
+
+// Keeper of the upgrade store
+type Keeper struct {
+	storeKey        sdk.StoreKey
+	cdc             *codec.Codec
+	upgradeHandlers map[string]UpgradeHandler
+	codespace       sdk.CodespaceType
+}
+
+// NewKeeper creates a new upgrade Keeper instance
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:        key,
+		cdc:             cdc,
+		upgradeHandlers: make(map[string]UpgradeHandler),
+		codespace:       codespace,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+ModuleName)
+}
+
+// SetUpgradeHandler registers the handler of the upgrade with the given name.
+// As the keeper is passed by value, it must be called when the app is built,
+// and panics if a handler is already registered for the name.
+func (k Keeper) SetUpgradeHandler(name string, handler UpgradeHandler) {
+	if _, ok := k.upgradeHandlers[name]; ok {
+		panic(fmt.Sprintf("upgrade handler %s already registered", name))
+	}
+	k.upgradeHandlers[name] = handler
+}
+
+// HasUpgradeHandler returns true if a handler is registered for the upgrade
+// with the given name
+func (k Keeper) HasUpgradeHandler(name string) bool {
+	_, ok := k.upgradeHandlers[name]
+	return ok
+}
+
+// ScheduleUpgrade schedules an upgrade, replacing any previously scheduled
+// upgrade. The plan height must be in the future and the upgrade must not
+// have been applied already.
+func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan Plan) sdk.Error {
+	if err := plan.ValidateBasic(); err != nil {
+		return err
+	}
+	if plan.Height <= ctx.BlockHeight() {
+		return ErrInvalidPlan(k.codespace, fmt.Sprintf(
+			"upgrade height %d must be in the future, current height is %d", plan.Height, ctx.BlockHeight()))
+	}
+	if height, found := k.GetDoneHeight(ctx, plan.Name); found {
+		return ErrUpgradeDone(k.codespace, plan.Name, height)
+	}
+
+	k.setUpgradePlan(ctx, plan)
+	return nil
+}
+
+func (k Keeper) setUpgradePlan(ctx sdk.Context, plan Plan) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(PlanKey, k.cdc.MustMarshalBinaryLengthPrefixed(plan))
+}
+
+// GetUpgradePlan returns the scheduled upgrade, if any
+func (k Keeper) GetUpgradePlan(ctx sdk.Context) (plan Plan, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(PlanKey)
+	if bz == nil {
+		return plan, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &plan)
+	return plan, true
+}
+
+// ClearUpgradePlan clears the scheduled upgrade, if any
+func (k Keeper) ClearUpgradePlan(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(PlanKey)
+}
+
+// GetDoneHeight returns the height at which the upgrade with the given name
+// was applied, if it was
+func (k Keeper) GetDoneHeight(ctx sdk.Context, name string) (height int64, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetDoneUpgradeKey(name))
+	if bz == nil {
+		return 0, false
+	}
+	return heightFromBytes(bz), true
+}
+
+// SetDone records the height at which the upgrade with the given name was
+// applied
+func (k Keeper) SetDone(ctx sdk.Context, name string, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetDoneUpgradeKey(name), heightToBytes(height))
+}
+
+// IterateDoneUpgrades iterates over the applied upgrades and performs a
+// callback function until it returns true
+func (k Keeper) IterateDoneUpgrades(ctx sdk.Context, cb func(upgrade DoneUpgrade) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, DoneUpgradeKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		name := string(iterator.Key()[len(DoneUpgradeKey):])
+		height := heightFromBytes(iterator.Value())
+		if cb(NewDoneUpgrade(name, height)) {
+			break
+		}
+	}
+}
+
+// ApplyUpgrade runs the handler of the plan, records the upgrade as applied
+// and clears the plan
+func (k Keeper) ApplyUpgrade(ctx sdk.Context, plan Plan) {
+	handler, ok := k.upgradeHandlers[plan.Name]
+	if !ok {
+		panic(fmt.Sprintf("no upgrade handler registered for %s", plan.Name))
+	}
+
+	handler(ctx, plan)
+	k.SetDone(ctx, plan.Name, ctx.BlockHeight())
+	k.ClearUpgradePlan(ctx)
+}
@@ -0,0 +1,90 @@
+package upgrade
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSoftwareUpgradeProposals(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	proposalHandler := NewSoftwareUpgradeProposalHandler(keeper)
+
+	require.NotNil(t, NewSoftwareUpgradeProposal("title", "description", NewPlan("", 20, "")).ValidateBasic())
+	require.NotNil(t, NewSoftwareUpgradeProposal("title", "description", NewPlan("v2", 0, "")).ValidateBasic())
+
+	// the upgrade height must be in the future
+	require.NotNil(t, proposalHandler(ctx, NewSoftwareUpgradeProposal("title", "description", NewPlan("v2", 10, ""))))
+
+	proposal := NewSoftwareUpgradeProposal("title", "description", NewPlan("v2", 20, "info"))
+	require.Nil(t, proposal.ValidateBasic())
+	require.Nil(t, proposalHandler(ctx, proposal))
+
+	plan, found := keeper.GetUpgradePlan(ctx)
+	require.True(t, found)
+	require.Equal(t, proposal.Plan, plan)
+
+	// a new proposal replaces the scheduled upgrade
+	proposal = NewSoftwareUpgradeProposal("title", "description", NewPlan("v2.1", 30, "info"))
+	require.Nil(t, proposalHandler(ctx, proposal))
+	plan, _ = keeper.GetUpgradePlan(ctx)
+	require.Equal(t, "v2.1", plan.Name)
+
+	require.Nil(t, proposalHandler(ctx, NewCancelSoftwareUpgradeProposal("title", "description")))
+	_, found = keeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+
+	err := proposalHandler(ctx, NewCancelSoftwareUpgradeProposal("title", "description"))
+	require.NotNil(t, err)
+	require.Equal(t, CodeNoUpgradePlan, err.Code())
+}
+
+func TestBeginBlocker(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	plan := NewPlan("v2", 20, "info")
+	require.Nil(t, keeper.ScheduleUpgrade(ctx, plan))
+
+	// nothing happens before the upgrade height
+	require.NotPanics(t, func() { BeginBlocker(ctx, keeper) })
+
+	// the chain halts at the upgrade height without a handler
+	upgradeCtx := ctx.WithBlockHeight(20)
+	require.Panics(t, func() { BeginBlocker(upgradeCtx, keeper) })
+
+	// the new binary registers the handler, and halts if started too early
+	applied := false
+	keeper.SetUpgradeHandler("v2", func(_ sdk.Context, _ Plan) { applied = true })
+	require.Panics(t, func() { BeginBlocker(ctx, keeper) })
+
+	require.NotPanics(t, func() { BeginBlocker(upgradeCtx, keeper) })
+	require.True(t, applied)
+
+	_, found := keeper.GetUpgradePlan(upgradeCtx)
+	require.False(t, found)
+	height, found := keeper.GetDoneHeight(upgradeCtx, "v2")
+	require.True(t, found)
+	require.Equal(t, int64(20), height)
+
+	// an applied upgrade cannot be scheduled again
+	err := keeper.ScheduleUpgrade(upgradeCtx, NewPlan("v2", 30, ""))
+	require.NotNil(t, err)
+	require.Equal(t, CodeUpgradeDone, err.Code())
+}
+
+func TestGenesis(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	require.Nil(t, ValidateGenesis(DefaultGenesisState()))
+
+	plan := NewPlan("v3", 20, "info")
+	genesis := NewGenesisState(&plan, []DoneUpgrade{NewDoneUpgrade("v2", 5)})
+	require.Nil(t, ValidateGenesis(genesis))
+	InitGenesis(ctx, keeper, genesis)
+	require.Equal(t, genesis, ExportGenesis(ctx, keeper))
+
+	invalid := NewGenesisState(&plan, []DoneUpgrade{NewDoneUpgrade("v3", 5)})
+	require.NotNil(t, ValidateGenesis(invalid))
+	invalid = NewGenesisState(nil, []DoneUpgrade{NewDoneUpgrade("v2", 5), NewDoneUpgrade("v2", 6)})
+	require.NotNil(t, ValidateGenesis(invalid))
+}
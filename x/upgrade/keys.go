@@ -0,0 +1,47 @@
+package upgrade
+
+import (
+	"encoding/binary"
+)
+
+const (
+	// ModuleName is the name of the module
+	ModuleName = "upgrade"
+
+	// StoreKey is the store key string for upgrade
+	StoreKey = ModuleName
+
+	// RouterKey is the proposal route for upgrade
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for upgrade
+	QuerierRoute = ModuleName
+)
+
+// Keys for upgrade store
+// Items are stored with the following key: values
+//
+// - 0x00: Plan
+//
+// - 0x01<name_Bytes>: int64 height
+var (
+	PlanKey        = []byte{0x00} // key for the scheduled upgrade plan
+	DoneUpgradeKey = []byte{0x01} // prefix for each key to an applied upgrade
+)
+
+// GetDoneUpgradeKey returns the store key of an applied upgrade
+func GetDoneUpgradeKey(name string) []byte {
+	return append(DoneUpgradeKey, []byte(name)...)
+}
+
+// heightToBytes encodes the height an upgrade was applied at
+func heightToBytes(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	return bz
+}
+
+// heightFromBytes decodes the height an upgrade was applied at
+func heightFromBytes(bz []byte) int64 {
+	return int64(binary.BigEndian.Uint64(bz))
+}
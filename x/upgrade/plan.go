@@ -0,0 +1,76 @@
+package upgrade
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Plan specifies information about a planned upgrade and the height at which
+// it must be applied
+type Plan struct {
+	// Name of the upgrade, the new binary registers its upgrade handler under
+	// this name
+	Name string `json:"name"`
+
+	// Height at which the upgrade must be applied
+	Height int64 `json:"height"`
+
+	// Info is any application specific upgrade info, eg. the location of the
+	// new binary
+	Info string `json:"info"`
+}
+
+// NewPlan creates a new Plan object
+func NewPlan(name string, height int64, info string) Plan {
+	return Plan{
+		Name:   name,
+		Height: height,
+		Info:   info,
+	}
+}
+
+// ValidateBasic does basic validation of a plan
+func (p Plan) ValidateBasic() sdk.Error {
+	if len(strings.TrimSpace(p.Name)) == 0 {
+		return ErrInvalidPlan(DefaultCodespace, "plan name cannot be blank")
+	}
+	if p.Height <= 0 {
+		return ErrInvalidPlan(DefaultCodespace, fmt.Sprintf("plan height must be positive, got %d", p.Height))
+	}
+	return nil
+}
+
+// ShouldExecute returns true if the plan is due at the height of the context
+func (p Plan) ShouldExecute(ctx sdk.Context) bool {
+	return p.Height <= ctx.BlockHeight()
+}
+
+// String implements the Stringer interface.
+func (p Plan) String() string {
+	return fmt.Sprintf(`Upgrade Plan:
+  Name:   %s
+  Height: %d
+  Info:   %s
+`, p.Name, p.Height, p.Info)
+}
+
+// DoneUpgrade records the height at which an upgrade was applied
+type DoneUpgrade struct {
+	Name   string `json:"name"`
+	Height int64  `json:"height"`
+}
+
+// NewDoneUpgrade creates a new DoneUpgrade object
+func NewDoneUpgrade(name string, height int64) DoneUpgrade {
+	return DoneUpgrade{
+		Name:   name,
+		Height: height,
+	}
+}
+
+// String implements the Stringer interface.
+func (du DoneUpgrade) String() string {
+	return fmt.Sprintf("%s applied at height %d", du.Name, du.Height)
+}
@@ -0,0 +1,55 @@
+package upgrade
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// query endpoints supported by the upgrade Querier
+const (
+	QueryPlan    = "plan"
+	QueryApplied = "applied"
+)
+
+// NewQuerier creates a querier for upgrade REST endpoints
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case QueryPlan:
+			return queryPlan(ctx, k)
+		case QueryApplied:
+			return queryApplied(ctx, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown upgrade query endpoint")
+		}
+	}
+}
+
+func queryPlan(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	plan, found := k.GetUpgradePlan(ctx)
+	if !found {
+		return nil, ErrNoUpgradePlan(k.codespace)
+	}
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, plan)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryApplied(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	doneUpgrades := []DoneUpgrade{}
+	k.IterateDoneUpgrades(ctx, func(du DoneUpgrade) bool {
+		doneUpgrades = append(doneUpgrades, du)
+		return false
+	})
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, doneUpgrades)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -0,0 +1,34 @@
+// nolint
+package upgrade
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// createTestInput returns an upgrade keeper with a context at height 10
+func createTestInput(t *testing.T) (sdk.Context, Keeper) {
+	keyUpgrade := sdk.NewKVStoreKey(StoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyUpgrade, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "upgrade-chain", Height: 10}, false, log.NewNopLogger())
+
+	cdc := codec.New()
+	RegisterCodec(cdc)
+
+	keeper := NewKeeper(cdc, keyUpgrade, DefaultCodespace)
+	return ctx, keeper
+}